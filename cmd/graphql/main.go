@@ -1,21 +1,46 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/cache"
 	"gin-crud-api/internal/config"
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/graph"
+	"gin-crud-api/internal/health"
 	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/metrics"
 	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/ratelimit"
+	"gin-crud-api/internal/server"
+	"gin-crud-api/internal/tracing"
+	"gin-crud-api/internal/validation"
+	"gin-crud-api/internal/webhook"
+	"gin-crud-api/internal/workflow"
 
-	"github.com/99designs/gqlgen/graphql/handler"
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func main() {
+	// Registered first so it runs after every other deferred cleanup
+	// (closing the Ent client, flushing traces) when the server fails
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
 	// Determine environment (dev, prod, test)
 	env := os.Getenv("APP_ENV")
 	if env == "" {
@@ -39,44 +64,309 @@ func main() {
 		Bool("pretty", cfg.Logging.Pretty).
 		Msg("Application starting")
 
-	// Initialize EntGo client (PostgreSQL) with auto-migrations
+	// Initialize OpenTelemetry tracing (W3C traceparent propagation is always enabled)
+	shutdownTracing, err := tracing.Init(context.Background(), &cfg.Tracing)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to initialize tracing")
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to flush traces")
+		}
+	}()
+
+	log.Info().
+		Bool("enabled", cfg.Tracing.Enabled).
+		Str("exporter", cfg.Tracing.Exporter).
+		Float64("sample_ratio", cfg.Tracing.SampleRatio).
+		Msg("Tracing initialized")
+
+	// Open the storage driver selected by database.driver; the SQL drivers run auto-migrations
 	log.Info().
+		Str("driver", cfg.Database.Driver).
 		Str("host", cfg.Database.Host).
-		Int("port", cfg.Database.Port).
 		Str("database", cfg.Database.DBName).
-		Msg("Connecting to PostgreSQL database")
+		Str("sqlite_path", cfg.Database.SQLitePath).
+		Msg("Opening storage")
 
-	entClient, err := database.NewEntClient(&cfg.Database)
+	// Every SQL statement issued by Ent gets a span under the calling resolver
+	storage, err := database.NewStorage(&cfg.Database, func(drv dialect.Driver) dialect.Driver {
+		return tracing.NewDriver(drv)
+	})
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Msg("Failed to connect to database")
+			Msg("Failed to open storage")
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to close database connection")
+			return
+		}
+		log.Info().Msg("Storage closed")
+	}()
+
+	log.Info().
+		Str("driver", storage.Driver).
+		Msg("Storage opened successfully")
+
+	// Queries go to the read replicas when configured; writes and transactions stay on the primary
+	if storage.Replicas != nil {
+		replicaCtx, stopReplicaChecks := context.WithCancel(context.Background())
+		defer stopReplicaChecks()
+		go storage.Replicas.RunHealthChecks(replicaCtx, cfg.Database.ReplicaCheckInterval)
+
+		log.Info().
+			Int("replicas", len(cfg.Database.Replicas)).
+			Dur("check_interval", cfg.Database.ReplicaCheckInterval).
+			Msg("Read replica routing enabled")
+	}
+
+	// Create repositories; skills, webhooks and idempotency keys need an Ent client
+	deptRepo := storage.Departments
+	empRepo := storage.Employees
+	projRepo := storage.Projects
+	skillRepo := database.NewUnsupportedSkillRepo(storage.Driver)
+	webhookRepo := database.NewUnsupportedWebhookRepo(storage.Driver)
+	var entWebhookRepo *database.EntWebhookRepo
+	if storage.Client != nil {
+		skillRepo = database.NewEntSkillRepo(storage.Client)
+		entWebhookRepo = database.NewEntWebhookRepo(storage.Client)
+		webhookRepo = entWebhookRepo
+	} else {
+		// The privacy policies are Ent rules; the memory repositories would serve every caller everything
+		if cfg.Auth.Enabled {
+			log.Fatal().
+				Str("driver", storage.Driver).
+				Msg("auth.enabled requires the postgres or sqlite driver, the privacy policies cannot be enforced without it")
+		}
+		log.Warn().
+			Str("driver", storage.Driver).
+			Msg("Skills, webhooks and idempotency keys are not available without a SQL driver")
 	}
-	defer database.CloseEntClient(entClient)
 
-	log.Info().Msg("Database connection established successfully")
+	// Read-through cache of lookups, invalidated by every repository write
+	if cfg.Cache.Enabled {
+		store, closeStore, err := cache.NewStore(cfg.Cache, cfg.Redis)
+		if err != nil {
+			log.Fatal().
+				Err(err).
+				Msg("Failed to create cache store")
+		}
+		defer closeStore()
+		deptRepo = database.NewCachedDepartmentRepo(deptRepo, store, cfg.Cache.DepartmentTTL)
+		empRepo = database.NewCachedEmployeeRepo(empRepo, store, cfg.Cache.EmployeeTTL)
+		projRepo = database.NewCachedProjectRepo(projRepo, store, cfg.Cache.ProjectTTL)
 
-	// Create repositories
-	deptRepo := database.NewEntDepartmentRepo(entClient)
-	empRepo := database.NewEntEmployeeRepo(entClient)
-	projRepo := database.NewEntProjectRepo(entClient)
+		log.Info().
+			Str("store", cfg.Cache.Store).
+			Dur("department_ttl", cfg.Cache.DepartmentTTL).
+			Dur("employee_ttl", cfg.Cache.EmployeeTTL).
+			Dur("project_ttl", cfg.Cache.ProjectTTL).
+			Msg("Repository cache enabled")
+	}
 
 	log.Info().Msg("Repositories initialized")
 
+	// Readiness checks shared by /readyz and the health query
+	var checks []health.Check
+	if storage.DB != nil {
+		checks = append(checks,
+			health.NewDatabaseCheck(storage.DB),
+			health.NewPoolCheck(storage.DB, cfg.Health.PoolSaturationThreshold),
+		)
+	}
+	if cfg.Health.CheckMigrations && storage.Client != nil {
+		checks = append(checks, health.NewMigrationCheck(storage.Client))
+	}
+	checker := health.NewChecker(cfg.Health.CheckTimeout, checks...)
+
+	// Input rules from configuration, shared with the legacy REST API
+	validator, err := validation.New(cfg.Validation)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Invalid validation rules")
+	}
+
+	// Project status transitions from configuration
+	projectWorkflow, err := workflow.New(cfg.Workflow)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Invalid project workflow")
+	}
+
 	// Create GraphQL resolver with injected dependencies
 	resolver := graph.NewResolver(deptRepo, empRepo, projRepo)
+	resolver.SkillRepo = skillRepo
+	resolver.WebhookRepo = webhookRepo
+	resolver.AllowPrivateWebhookTargets = cfg.Webhooks.AllowPrivateTargets
+	resolver.HealthChecker = checker
+	resolver.Validator = validator
+	resolver.Workflow = projectWorkflow
+	resolver.WorkloadLimits = cfg.Workload
 
 	// Create GraphQL server with logging middleware
-	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
+	srv := graph.NewServer(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  resolver,
+		Complexity: graph.NewComplexityRoot(cfg.GraphQL.ListCost),
+	}), cfg.GraphQL.Introspection)
+	srv.Use(middleware.NewTracingMiddleware())
 	srv.AroundOperations(middleware.LoggingMiddleware())
+	srv.AroundResponses(middleware.RejectionLoggingMiddleware())
+	srv.Use(middleware.NewCacheControl())
+	srv.AroundOperations(middleware.ReadYourWritesMiddleware())
 
-	log.Info().Msg("GraphQL server configured with logging middleware")
+	// Prometheus metrics for operations, resolvers and the database pool
+	if cfg.Metrics.Enabled {
+		m := metrics.New()
+		if storage.DB != nil {
+			if err := m.RegisterDBStats(storage.DB, cfg.Database.DBName); err != nil {
+				log.Fatal().
+					Err(err).
+					Msg("Failed to register database pool metrics")
+			}
+		}
+		srv.Use(middleware.NewMetricsMiddleware(m))
+		http.Handle(cfg.Metrics.Path, m.Handler())
+
+		log.Info().
+			Str("path", cfg.Metrics.Path).
+			Msg("Prometheus metrics endpoint enabled")
+	}
+
+	// Token-bucket budgets per principal (or client IP), checked before any other limit
+	if cfg.RateLimit.Enabled {
+		var store ratelimit.Store
+		switch cfg.RateLimit.Store {
+		case "redis":
+			redisClient := redis.NewClient(&redis.Options{
+				Addr:     cfg.Redis.Addr,
+				Password: cfg.Redis.Password,
+				DB:       cfg.Redis.DB,
+			})
+			defer redisClient.Close()
+			store = ratelimit.NewRedisStore(redisClient, cfg.RateLimit.KeyPrefix)
+		case "memory", "":
+			store = ratelimit.NewMemoryStore()
+		default:
+			log.Fatal().
+				Str("store", cfg.RateLimit.Store).
+				Msg("Unknown rate limit store")
+		}
+		srv.Use(middleware.NewRateLimit(store, &cfg.RateLimit))
+
+		log.Info().
+			Str("store", cfg.RateLimit.Store).
+			Float64("query_rps", cfg.RateLimit.Query.RequestsPerSecond).
+			Float64("mutation_rps", cfg.RateLimit.Mutation.RequestsPerSecond).
+			Int("operation_overrides", len(cfg.RateLimit.Operations)).
+			Msg("Rate limiting enabled")
+	}
+
+	// Retried create/delete mutations replay the stored result instead of running twice
+	if cfg.Idempotency.Enabled && storage.Client != nil {
+		idempotency := middleware.NewIdempotency(database.NewEntIdempotencyRepo(storage.Client), &cfg.Idempotency)
+		srv.Use(idempotency)
+
+		cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+		defer stopCleanup()
+		go idempotency.RunCleanup(cleanupCtx, cfg.Idempotency.CleanupInterval)
+
+		log.Info().
+			Dur("ttl", cfg.Idempotency.TTL).
+			Dur("cleanup_interval", cfg.Idempotency.CleanupInterval).
+			Msg("Idempotency keys enabled")
+	}
+
+	// Queue webhook deliveries from the outbox and post them with retries until the server shuts down
+	if cfg.Webhooks.Enabled && entWebhookRepo != nil {
+		dispatcher := webhook.NewDispatcher(entWebhookRepo, database.NewEntOutboxRepo(storage.Client), cfg.Webhooks, cfg.Outbox)
+
+		dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+		defer stopDispatch()
+		go dispatcher.Run(dispatchCtx)
+
+		log.Info().
+			Str("consumer", cfg.Webhooks.Consumer).
+			Dur("poll_interval", cfg.Webhooks.PollInterval).
+			Int("max_attempts", cfg.Webhooks.MaxAttempts).
+			Msg("Webhook dispatcher enabled")
+	}
+
+	// Reject overly deep or expensive queries before they reach the database
+	if cfg.GraphQL.MaxDepth > 0 {
+		srv.Use(middleware.FixedDepthLimit(cfg.GraphQL.MaxDepth))
+	}
+	if cfg.GraphQL.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(cfg.GraphQL.MaxComplexity))
+	}
+
+	log.Info().
+		Int("max_depth", cfg.GraphQL.MaxDepth).
+		Int("max_complexity", cfg.GraphQL.MaxComplexity).
+		Int("list_cost", cfg.GraphQL.ListCost).
+		Msg("GraphQL server configured with logging middleware and query limits")
+
+	// Kubernetes probes: liveness never touches dependencies, readiness checks them all
+	http.Handle("/livez", health.LivenessHandler())
+	http.Handle("/readyz", health.ReadinessHandler(checker))
+
+	// Bearer authentication shared by /query and, optionally, the Playground
+	var verifier *auth.Verifier
+	if cfg.Auth.Enabled {
+		if cfg.Auth.JWTSecret == "" {
+			log.Fatal().Msg("auth.jwt_secret must be set when authentication is enabled")
+		}
+		verifier = auth.NewVerifier([]byte(cfg.Auth.JWTSecret), cfg.Auth.Issuer)
+	}
+
+	// The Playground page loads GraphiQL from a CDN, so it gets no Content-Security-Policy
+	playgroundHeaders := cfg.SecurityHeaders
+	playgroundHeaders.ContentSecurityPolicy = ""
 
 	// GraphQL Playground at root path "/"
-	http.Handle("/", playground.Handler("GraphQL Playground", "/query"))
+	if cfg.GraphQL.Playground {
+		var playgroundHandler http.Handler = playground.Handler("GraphQL Playground", "/query")
+		if cfg.GraphQL.PlaygroundAuth {
+			if verifier == nil {
+				log.Fatal().Msg("graphql.playground_auth requires auth.enabled")
+			}
+			playgroundHandler = auth.Middleware(verifier, true)(playgroundHandler)
+		}
+		http.Handle("/", middleware.SecurityHeadersMiddleware(playgroundHeaders)(playgroundHandler))
+	}
 
-	// GraphQL endpoint at "/query"
-	http.Handle("/query", srv)
+	// GraphQL endpoint at "/query": security headers and CORS, bearer authentication
+	// (or the system context when auth is disabled), then the tenant and client
+	// details for rate limiting. GET responses get Cache-Control and ETag
+	// headers; they differ per token and tenant.
+	var queryHandler http.Handler = middleware.HTTPCacheMiddleware("Authorization", cfg.Tenancy.Header)(srv)
+	queryHandler = middleware.TenantMiddleware(cfg.Tenancy)(queryHandler)
+	if verifier != nil {
+		queryHandler = auth.Middleware(verifier, cfg.Auth.Required)(queryHandler)
+	} else {
+		queryHandler = auth.Disabled()(queryHandler)
+	}
+	queryHandler = middleware.RequestInfoMiddleware(cfg.RateLimit.TrustProxyHeaders)(queryHandler)
+	queryHandler = middleware.RequestIDMiddleware()(queryHandler)
+	queryHandler = middleware.CORSMiddleware(cfg.CORS)(queryHandler)
+	queryHandler = middleware.SecurityHeadersMiddleware(cfg.SecurityHeaders)(queryHandler)
+	http.Handle("/query", queryHandler)
+
+	log.Info().
+		Bool("introspection", cfg.GraphQL.Introspection).
+		Bool("playground", cfg.GraphQL.Playground).
+		Bool("playground_auth", cfg.GraphQL.PlaygroundAuth).
+		Strs("cors_origins", cfg.CORS.AllowedOrigins).
+		Msg("HTTP routes configured")
 
 	// Get GraphQL port from configuration
 	graphqlPort := cfg.Server.GraphQLPort
@@ -87,22 +377,48 @@ func main() {
 		Str("playground_url", fmt.Sprintf("http://localhost:%s/", graphqlPort)).
 		Str("graphql_url", fmt.Sprintf("http://localhost:%s/query", graphqlPort)).
 		Str("schema", "internal/graph/schema.graphql").
-		Str("database", "PostgreSQL (via EntGo)").
+		Str("storage", storage.Driver).
 		Msg("╔════════════════════════════════════════════════════════╗")
 
 	log.Info().Msg("║  GraphQL Server is running!                        ║")
-	log.Info().Msgf("║  Playground:  http://localhost:%s/              ║", graphqlPort)
+	if cfg.GraphQL.Playground {
+		log.Info().Msgf("║  Playground:  http://localhost:%s/              ║", graphqlPort)
+	}
 	log.Info().Msgf("║  GraphQL API: http://localhost:%s/query         ║", graphqlPort)
+	log.Info().Msgf("║  Readiness:   http://localhost:%s/readyz        ║", graphqlPort)
 	log.Info().Msg("╚════════════════════════════════════════════════════════╝")
 
 	// Start server
 	log.Info().
 		Str("address", serverAddr).
+		Dur("read_timeout", cfg.Server.ReadTimeout).
+		Dur("write_timeout", cfg.Server.WriteTimeout).
+		Dur("shutdown_timeout", cfg.Server.ShutdownTimeout).
 		Msg("Starting HTTP server")
 
-	if err := http.ListenAndServe(serverAddr, nil); err != nil {
-		log.Fatal().
+	// Every HTTP request gets a server span continuing any incoming traceparent
+	// (scrapes and probes are skipped to keep traces meaningful)
+	httpHandler := otelhttp.NewHandler(http.DefaultServeMux, "http.server",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			switch r.URL.Path {
+			case cfg.Metrics.Path, "/livez", "/readyz":
+				return false
+			}
+			return true
+		}),
+	)
+
+	// Stop on SIGINT (Ctrl+C) or SIGTERM (docker stop, Kubernetes pod termination):
+	// stop accepting connections, drain in-flight operations and websocket
+	// subscriptions within server.shutdown_timeout, then run deferred cleanup
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	httpServer := server.New(serverAddr, &cfg.Server, httpHandler)
+	if err := httpServer.Run(ctx); err != nil {
+		log.Error().
 			Err(err).
-			Msg("Failed to start server")
+			Msg("Server stopped with error")
+		exitCode = 1
 	}
 }
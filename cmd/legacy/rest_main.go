@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/cache"
 	"gin-crud-api/internal/config"
 	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/health"
 	"gin-crud-api/internal/legacy/rest/department"
 	"gin-crud-api/internal/legacy/rest/employee"
 	"gin-crud-api/internal/legacy/rest/router"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/validation"
 	"log"
+	"net/http"
 	"os"
 )
 
@@ -25,29 +32,84 @@ func main() {
 
 	log.Printf("🚀 Starting Gin CRUD API with EntGo ORM")
 	log.Printf("🌍 Environment: %s", env)
-	log.Printf("📊 Database: PostgreSQL at %s:%d", cfg.Database.Host, cfg.Database.Port)
+	log.Printf("📊 Storage driver: %s", cfg.Database.Driver)
 
-	// Initialize EntGo client (automatically runs migrations)
-	entClient, err := database.NewEntClient(&cfg.Database)
+	// Open the storage selected by database.driver (SQL drivers run migrations automatically)
+	storage, err := database.NewStorage(&cfg.Database, nil)
 	if err != nil {
-		log.Fatalf("Failed to initialize EntGo client: %v", err)
+		log.Fatalf("Failed to open storage: %v", err)
 	}
 	defer func() {
-		if err := database.CloseEntClient(entClient); err != nil {
-			log.Printf("Error closing EntGo client: %v", err)
+		if err := storage.Close(); err != nil {
+			log.Printf("Error closing storage: %v", err)
 		}
 	}()
 
-	// Create repositories using EntGo
-	deptRepo := database.NewEntDepartmentRepo(entClient)
-	empRepo := database.NewEntEmployeeRepo(entClient)
+	// The privacy policies are Ent rules; the memory repositories would serve every caller everything
+	if storage.Client == nil && cfg.Auth.Enabled {
+		log.Fatalf("auth.enabled requires the postgres or sqlite driver; the %s driver cannot enforce the privacy policies", storage.Driver)
+	}
+
+	// Queries go to the read replicas when configured; failed replicas rejoin after their next successful check
+	if storage.Replicas != nil {
+		replicaCtx, stopReplicaChecks := context.WithCancel(context.Background())
+		defer stopReplicaChecks()
+		go storage.Replicas.RunHealthChecks(replicaCtx, cfg.Database.ReplicaCheckInterval)
+		log.Printf("📚 Read replica routing enabled (%d replicas)", len(cfg.Database.Replicas))
+	}
+
+	deptRepo := storage.Departments
+	empRepo := storage.Employees
+
+	// Share the GraphQL server's cache so writes here invalidate it too
+	if cfg.Cache.Enabled {
+		store, closeStore, err := cache.NewStore(cfg.Cache, cfg.Redis)
+		if err != nil {
+			log.Fatalf("Failed to create cache store: %v", err)
+		}
+		defer closeStore()
+		deptRepo = database.NewCachedDepartmentRepo(deptRepo, store, cfg.Cache.DepartmentTTL)
+		empRepo = database.NewCachedEmployeeRepo(empRepo, store, cfg.Cache.EmployeeTTL)
+	}
+
+	// Input rules shared with the GraphQL API
+	validator, err := validation.New(cfg.Validation)
+	if err != nil {
+		log.Fatalf("Invalid validation rules: %v", err)
+	}
 
 	// Initialize handlers
-	deptHandler := department.NewHandler(deptRepo, empRepo)
-	empHandler := employee.NewHandler(empRepo, deptRepo)
+	deptHandler := department.NewHandler(deptRepo, empRepo, validator)
+	empHandler := employee.NewHandler(empRepo, deptRepo, validator)
+
+	// Readiness checks for /health
+	var checks []health.Check
+	if storage.DB != nil {
+		checks = append(checks,
+			health.NewDatabaseCheck(storage.DB),
+			health.NewPoolCheck(storage.DB, cfg.Health.PoolSaturationThreshold),
+		)
+	}
+	checker := health.NewChecker(cfg.Health.CheckTimeout, checks...)
 
 	// Setup router
-	r := router.Setup(deptHandler, empHandler)
+	r := router.Setup(deptHandler, empHandler, checker)
+
+	// The API authenticates and resolves the tenant like /query, so the Ent
+	// privacy policies apply to REST callers as well; /health stays open
+	var api http.Handler = middleware.TenantMiddleware(cfg.Tenancy)(r)
+	if cfg.Auth.Enabled {
+		if cfg.Auth.JWTSecret == "" {
+			log.Fatalf("auth.jwt_secret must be set when authentication is enabled")
+		}
+		verifier := auth.NewVerifier([]byte(cfg.Auth.JWTSecret), cfg.Auth.Issuer)
+		api = auth.Middleware(verifier, cfg.Auth.Required)(api)
+	} else {
+		api = auth.Disabled()(api)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/api/", api)
+	mux.Handle("/", r)
 
 	// Start server
 	serverAddr := ":" + cfg.Server.RESTPort
@@ -55,7 +117,7 @@ func main() {
 	log.Printf("📝 API endpoints available at http://localhost%s/api/v1", serverAddr)
 	log.Printf("💚 Health check at http://localhost%s/health", serverAddr)
 
-	if err := r.Run(serverAddr); err != nil {
+	if err := http.ListenAndServe(serverAddr, mux); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
\ No newline at end of file
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
+)
+
+// The relay publishes the domain events that mutations append to the outbox
+// table, in order and at least once, committing its offset after every batch.
+// Events of transactions that commit after a later sequence was published
+// follow late, until outbox.gap_timeout.
+// Several relays can run side by side under different outbox.consumer names.
+func main() {
+	// Determine environment (dev, prod, test)
+	env := os.Getenv("APP_ENV")
+	if env == "" {
+		env = "dev" // Default to development
+	}
+
+	// Load configuration from YAML and environment variables
+	cfg, err := config.LoadConfig(env)
+	if err != nil {
+		// Can't use logger yet, use panic
+		panic(fmt.Sprintf("Failed to load configuration: %v", err))
+	}
+
+	// Events go to stdout, so logs are written to stderr
+	logger.InitWithWriter(cfg.Logging.Level, cfg.Logging.Pretty, os.Stderr)
+	log := logger.GetLogger()
+
+	entClient, err := database.NewEntClient(&cfg.Database)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Msg("Failed to connect to database")
+	}
+	defer func() {
+		if err := database.CloseEntClient(entClient); err != nil {
+			log.Error().
+				Err(err).
+				Msg("Failed to close database connection")
+		}
+	}()
+
+	// Stop on SIGINT or SIGTERM; an unfinished batch is published again on restart
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	sink, err := newSink(ctx, &cfg.Outbox)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("sink", cfg.Outbox.Sink).
+			Msg("Failed to open outbox sink")
+	}
+	defer sink.Close()
+
+	log.Info().
+		Str("environment", env).
+		Str("consumer", cfg.Outbox.Consumer).
+		Str("sink", cfg.Outbox.Sink).
+		Dur("poll_interval", cfg.Outbox.PollInterval).
+		Msg("Outbox relay starting")
+
+	outbox.NewRelay(database.NewEntOutboxRepo(entClient), sink, cfg.Outbox).Run(ctx)
+
+	log.Info().Msg("Outbox relay stopped")
+}
+
+// newSink opens the sink selected by outbox.sink
+func newSink(ctx context.Context, cfg *config.OutboxConfig) (outbox.Sink, error) {
+	switch cfg.Sink {
+	case "stdout", "":
+		return outbox.NewWriterSink(os.Stdout), nil
+	case "file":
+		return outbox.NewFileSink(cfg.FilePath)
+	case "nats":
+		conn, err := outbox.DialNATS(ctx, cfg.NATSURL, 10*time.Second)
+		if err != nil {
+			return nil, err
+		}
+		return outbox.NewNATSSink(conn, cfg.SubjectPrefix), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox sink %q", cfg.Sink)
+	}
+}
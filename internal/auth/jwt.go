@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Token validation errors
+var (
+	ErrMalformedToken   = errors.New("malformed token")
+	ErrUnsupportedAlg   = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrTokenNotYetValid = errors.New("token not valid yet")
+	ErrInvalidIssuer    = errors.New("invalid token issuer")
+	ErrMissingSubject   = errors.New("token has no subject")
+)
+
+// leeway tolerates small clock differences between issuer and API servers
+const leeway = 30 * time.Second
+
+// Claims are the JWT claims understood by the API
+type Claims struct {
+	Subject     string   `json:"sub"`
+	Issuer      string   `json:"iss,omitempty"`
+	ExpiresAt   int64    `json:"exp,omitempty"` // Unix seconds; 0 means no expiry
+	NotBefore   int64    `json:"nbf,omitempty"` // Unix seconds
+	IssuedAt    int64    `json:"iat,omitempty"` // Unix seconds
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Tenant      string   `json:"tenant,omitempty"`
+}
+
+// Principal converts validated claims into the request principal
+func (c *Claims) Principal() *Principal {
+	return &Principal{
+		ID:          c.Subject,
+		Roles:       c.Roles,
+		Permissions: c.Permissions,
+		TenantID:    c.Tenant,
+	}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// Sign creates an HS256 JWT for claims
+// The API only verifies tokens; Sign exists for tests and local tooling
+func Sign(claims Claims, secret []byte) (string, error) {
+	header, err := json.Marshal(jwtHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := encodeSegment(header) + "." + encodeSegment(payload)
+	return unsigned + "." + encodeSegment(signHS256(unsigned, secret)), nil
+}
+
+// Verifier validates HS256 JWTs issued by a trusted issuer
+type Verifier struct {
+	secret []byte
+	issuer string
+	now    func() time.Time
+}
+
+// NewVerifier creates a verifier for tokens signed with secret
+// If issuer is not empty, tokens must carry a matching iss claim
+func NewVerifier(secret []byte, issuer string) *Verifier {
+	return &Verifier{secret: secret, issuer: issuer, now: time.Now}
+}
+
+// Verify checks the token signature and time-based claims and returns its claims
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	headerJSON, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, ErrMalformedToken
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlg, header.Alg)
+	}
+
+	signature, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	if !hmac.Equal(signature, signHS256(parts[0]+"."+parts[1], v.secret)) {
+		return nil, ErrInvalidSignature
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	now := v.now()
+	if claims.ExpiresAt != 0 && now.After(time.Unix(claims.ExpiresAt, 0).Add(leeway)) {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotBefore != 0 && now.Add(leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return nil, ErrTokenNotYetValid
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return nil, ErrInvalidIssuer
+	}
+	if claims.Subject == "" {
+		return nil, ErrMissingSubject
+	}
+
+	return &claims, nil
+}
+
+func signHS256(unsigned string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
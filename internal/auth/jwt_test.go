@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("test-secret")
+
+func signToken(t *testing.T, claims Claims) string {
+	t.Helper()
+	return mustSign(t, claims, testSecret)
+}
+
+func TestVerify_ValidToken(t *testing.T) {
+	token := signToken(t, Claims{
+		Subject:     "user-1",
+		Issuer:      "issuer",
+		ExpiresAt:   time.Now().Add(time.Hour).Unix(),
+		Roles:       []string{"admin"},
+		Permissions: []string{"pii:read"},
+		Tenant:      "acme",
+	})
+
+	claims, err := NewVerifier(testSecret, "issuer").Verify(token)
+
+	require.NoError(t, err)
+	p := claims.Principal()
+	assert.Equal(t, "user-1", p.ID)
+	assert.True(t, p.HasRole("admin"))
+	assert.True(t, p.HasPermission("pii:read"))
+	assert.False(t, p.HasPermission("other"))
+	assert.Equal(t, "acme", p.TenantID)
+}
+
+func TestVerify_Rejects(t *testing.T) {
+	valid := Claims{Subject: "user-1", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+
+	tampered := signToken(t, valid)
+	parts := strings.Split(tampered, ".")
+	other := strings.Split(signToken(t, Claims{Subject: "admin"}), ".")
+	tampered = parts[0] + "." + other[1] + "." + parts[2]
+
+	tests := []struct {
+		name    string
+		token   string
+		issuer  string
+		wantErr error
+	}{
+		{"malformed", "not-a-jwt", "", ErrMalformedToken},
+		{"tampered payload", tampered, "", ErrInvalidSignature},
+		{"wrong secret", mustSign(t, valid, []byte("other")), "", ErrInvalidSignature},
+		{"expired", signToken(t, Claims{Subject: "u", ExpiresAt: time.Now().Add(-time.Hour).Unix()}), "", ErrTokenExpired},
+		{"not yet valid", signToken(t, Claims{Subject: "u", NotBefore: time.Now().Add(time.Hour).Unix()}), "", ErrTokenNotYetValid},
+		{"wrong issuer", signToken(t, Claims{Subject: "u", Issuer: "evil"}), "issuer", ErrInvalidIssuer},
+		{"no subject", signToken(t, Claims{}), "", ErrMissingSubject},
+		{"alg none", encodeSegment([]byte(`{"alg":"none"}`)) + "." + encodeSegment([]byte(`{"sub":"u"}`)) + ".", "", ErrUnsupportedAlg},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVerifier(testSecret, tt.issuer).Verify(tt.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestVerify_ClockLeeway(t *testing.T) {
+	token := signToken(t, Claims{Subject: "u", ExpiresAt: time.Now().Add(-10 * time.Second).Unix()})
+
+	_, err := NewVerifier(testSecret, "").Verify(token)
+	assert.NoError(t, err)
+}
+
+func mustSign(t *testing.T, claims Claims, secret []byte) string {
+	t.Helper()
+	token, err := Sign(claims, secret)
+	require.NoError(t, err)
+	return token
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ErrCodeUnauthenticated is the extensions.code returned for missing or invalid credentials
+const ErrCodeUnauthenticated = "UNAUTHENTICATED"
+
+// Middleware authenticates requests carrying "Authorization: Bearer <jwt>"
+// and stores the principal in the request context.
+// Invalid tokens are always rejected with 401; requests without a token are
+// rejected only when required is true, otherwise they continue anonymously.
+func Middleware(verifier *Verifier, required bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				if required {
+					unauthorized(w, "authentication required")
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := verifier.Verify(token)
+			if err != nil {
+				unauthorized(w, err.Error())
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), claims.Principal())))
+		})
+	}
+}
+
+// Disabled is the middleware of servers with authentication disabled: every
+// request acts as the service itself (WithSystem), as there is no principal
+// to restrict it by
+func Disabled() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(WithSystem(r.Context())))
+		})
+	}
+}
+
+// bearerToken extracts the token from the Authorization header
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// unauthorized writes a GraphQL-shaped error response so clients can handle it like any other error
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="gin-crud-api"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"message":    message,
+			"extensions": map[string]any{"code": ErrCodeUnauthenticated},
+		}},
+	})
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serve runs a request with the given Authorization header through Middleware
+// and returns the status code and the principal seen by the handler
+func serve(t *testing.T, required bool, authorization string) (int, *Principal) {
+	t.Helper()
+
+	var seen *Principal
+	handler := Middleware(NewVerifier(testSecret, ""), required)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen = FromContext(r.Context())
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec.Code, seen
+}
+
+func TestMiddleware_Anonymous(t *testing.T) {
+	code, p := serve(t, false, "")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Nil(t, p)
+}
+
+func TestMiddleware_Required(t *testing.T) {
+	code, p := serve(t, true, "")
+
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Nil(t, p)
+}
+
+func TestMiddleware_ValidToken(t *testing.T) {
+	code, p := serve(t, true, "Bearer "+signToken(t, Claims{Subject: "user-1"}))
+
+	assert.Equal(t, http.StatusOK, code)
+	if assert.NotNil(t, p) {
+		assert.Equal(t, "user-1", p.ID)
+	}
+}
+
+func TestMiddleware_InvalidTokenRejectedEvenWhenOptional(t *testing.T) {
+	code, p := serve(t, false, "Bearer garbage")
+
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Nil(t, p)
+}
+
+func TestDisabled_ActsAsSystem(t *testing.T) {
+	var system, anonymous bool
+	handler := Disabled()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		system = IsSystem(r.Context())
+		anonymous = FromContext(r.Context()) == nil
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/query", nil))
+
+	assert.True(t, system)
+	assert.True(t, anonymous)
+}
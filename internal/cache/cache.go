@@ -0,0 +1,45 @@
+// Package cache keeps serialized values under string keys for a limited time.
+// It backs the read-through repository cache: MemoryStore keeps entries in
+// process, RedisStore shares them across API instances through any server
+// speaking the Redis protocol (RESP).
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Store keeps cache entries. Entries may disappear before their TTL expires
+// (e.g. when evicted), so callers must treat every miss as "load again".
+type Store interface {
+	// Get returns the value stored under key; ok is false on a miss
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set stores value under key for ttl (0 keeps it until evicted or deleted)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes keys; missing keys are ignored
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// NewStore creates the store selected by cfg.Store, connecting to the
+// Redis-compatible server of redisCfg for the redis store. close releases
+// the connection.
+func NewStore(cfg config.CacheConfig, redisCfg config.RedisConfig) (store Store, close func() error, err error) {
+	switch cfg.Store {
+	case "redis":
+		client := redis.NewClient(&redis.Options{
+			Addr:     redisCfg.Addr,
+			Password: redisCfg.Password,
+			DB:       redisCfg.DB,
+		})
+		return NewRedisStore(client, cfg.KeyPrefix), client.Close, nil
+	case "memory", "":
+		return NewMemoryStore(cfg.MaxEntries), func() error { return nil }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown cache store %q", cfg.Store)
+	}
+}
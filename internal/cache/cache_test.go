@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRedisStore starts a local Redis stand-in and returns a store using it
+func newRedisStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisStore(client, "test:"), mr
+}
+
+// storeFactories runs the behavioral tests against both implementations
+func storeFactories() map[string]func(t *testing.T) Store {
+	return map[string]func(t *testing.T) Store{
+		"memory": func(t *testing.T) Store { return NewMemoryStore(100) },
+		"redis": func(t *testing.T) Store {
+			store, _ := newRedisStore(t)
+			return store
+		},
+	}
+}
+
+// TestStore_SetGetDelete tests the basic operations of every store
+func TestStore_SetGetDelete(t *testing.T) {
+	for name, newStore := range storeFactories() {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			store := newStore(t)
+			ctx := context.Background()
+
+			// Test & Assert
+			_, ok, err := store.Get(ctx, "a")
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, store.Set(ctx, "a", []byte("1"), time.Minute))
+			require.NoError(t, store.Set(ctx, "b", []byte("2"), 0))
+			value, ok, err := store.Get(ctx, "a")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("1"), value)
+
+			require.NoError(t, store.Set(ctx, "a", []byte("3"), time.Minute))
+			value, _, _ = store.Get(ctx, "a")
+			assert.Equal(t, []byte("3"), value)
+
+			require.NoError(t, store.Delete(ctx, "a", "b", "missing"))
+			_, ok, _ = store.Get(ctx, "a")
+			assert.False(t, ok)
+			_, ok, _ = store.Get(ctx, "b")
+			assert.False(t, ok)
+		})
+	}
+}
+
+// TestMemoryStore_Expires tests that entries expire after their TTL
+func TestMemoryStore_Expires(t *testing.T) {
+	// Setup
+	store := NewMemoryStore(10)
+	now := time.Unix(1_700_000_000, 0)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	require.NoError(t, store.Set(ctx, "short", []byte("1"), time.Second))
+	require.NoError(t, store.Set(ctx, "forever", []byte("2"), 0))
+
+	// Test
+	now = now.Add(time.Second)
+
+	// Assert
+	_, ok, _ := store.Get(ctx, "short")
+	assert.False(t, ok)
+	_, ok, _ = store.Get(ctx, "forever")
+	assert.True(t, ok)
+	assert.Equal(t, 1, store.Len())
+}
+
+// TestMemoryStore_EvictsLeastRecentlyUsed tests the capacity limit
+func TestMemoryStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	// Setup
+	store := NewMemoryStore(2)
+	ctx := context.Background()
+	require.NoError(t, store.Set(ctx, "a", []byte("1"), 0))
+	require.NoError(t, store.Set(ctx, "b", []byte("2"), 0))
+
+	// Test: reading a makes b the least recently used entry
+	_, _, _ = store.Get(ctx, "a")
+	require.NoError(t, store.Set(ctx, "c", []byte("3"), 0))
+
+	// Assert
+	_, ok, _ := store.Get(ctx, "b")
+	assert.False(t, ok)
+	_, ok, _ = store.Get(ctx, "a")
+	assert.True(t, ok)
+	_, ok, _ = store.Get(ctx, "c")
+	assert.True(t, ok)
+}
+
+// TestRedisStore_PrefixAndTTL tests that entries are prefixed and expire in Redis
+func TestRedisStore_PrefixAndTTL(t *testing.T) {
+	// Setup
+	store, mr := newRedisStore(t)
+	ctx := context.Background()
+
+	// Test
+	require.NoError(t, store.Set(ctx, "dept", []byte("x"), time.Minute))
+
+	// Assert
+	assert.True(t, mr.Exists("test:dept"))
+	assert.Equal(t, time.Minute, mr.TTL("test:dept"))
+	mr.FastForward(time.Minute)
+	_, ok, err := store.Get(ctx, "dept")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// TestRedisStore_Unavailable tests that server errors are reported
+func TestRedisStore_Unavailable(t *testing.T) {
+	// Setup
+	store, mr := newRedisStore(t)
+	mr.Close()
+
+	// Test
+	_, _, err := store.Get(context.Background(), "dept")
+
+	// Assert
+	assert.Error(t, err)
+}
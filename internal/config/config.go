@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -11,10 +12,19 @@ import (
 type ServerConfig struct {
 	GraphQLPort string `mapstructure:"graphql_port"` // GraphQL API server port
 	RESTPort    string `mapstructure:"rest_port"`    // Legacy REST API server port
+
+	ReadTimeout       time.Duration `mapstructure:"read_timeout"`        // Max time to read a request including the body (0 = none)
+	ReadHeaderTimeout time.Duration `mapstructure:"read_header_timeout"` // Max time to read request headers (0 = read_timeout)
+	WriteTimeout      time.Duration `mapstructure:"write_timeout"`       // Max time to write a response (0 = none)
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"`        // Keep-alive idle time (0 = read_timeout)
+	ShutdownTimeout   time.Duration `mapstructure:"shutdown_timeout"`    // Max time to drain requests and subscriptions on SIGTERM
 }
 
 // DatabaseConfig holds database connection configuration
 type DatabaseConfig struct {
+	Driver     string `mapstructure:"driver"`      // Storage driver: postgres (default), sqlite or memory
+	SQLitePath string `mapstructure:"sqlite_path"` // Database file of the sqlite driver
+
 	Host     string `mapstructure:"host"`      // Database host
 	Port     int    `mapstructure:"port"`      // Database port
 	User     string `mapstructure:"user"`      // Database username
@@ -23,6 +33,9 @@ type DatabaseConfig struct {
 	SSLMode  string `mapstructure:"sslmode"`   // SSL mode (disable, require, verify-full)
 	MaxConns int    `mapstructure:"max_conns"` // Maximum connection pool size
 	MinConns int    `mapstructure:"min_conns"` // Minimum idle connections
+
+	Replicas             []string      `mapstructure:"replicas"`               // Read replica DSNs (postgres:// URLs); queries are routed to them
+	ReplicaCheckInterval time.Duration `mapstructure:"replica_check_interval"` // How often replicas are checked and failed ones restored
 }
 
 // LoggingConfig holds logging configuration
@@ -31,11 +44,199 @@ type LoggingConfig struct {
 	Pretty bool   `mapstructure:"pretty"` // Pretty console output vs JSON
 }
 
+// GraphQLConfig holds limits applied to incoming GraphQL operations and
+// which developer tools (introspection, Playground) are exposed
+type GraphQLConfig struct {
+	MaxDepth      int `mapstructure:"max_depth"`      // Maximum selection depth (0 disables the check)
+	MaxComplexity int `mapstructure:"max_complexity"` // Maximum computed query complexity (0 disables the check)
+	ListCost      int `mapstructure:"list_cost"`      // Assumed number of items returned by list fields
+
+	Introspection  bool `mapstructure:"introspection"`   // Allow __schema/__type queries
+	Playground     bool `mapstructure:"playground"`      // Serve the GraphQL Playground at /
+	PlaygroundAuth bool `mapstructure:"playground_auth"` // Require the same bearer token as /query to load the Playground
+}
+
+// MetricsConfig holds Prometheus metrics configuration
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"` // Expose the metrics endpoint
+	Path    string `mapstructure:"path"`    // HTTP path of the metrics endpoint
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Enabled      bool    `mapstructure:"enabled"`       // Export spans (trace context is always propagated)
+	Exporter     string  `mapstructure:"exporter"`      // Span exporter: otlp or stdout
+	OTLPEndpoint string  `mapstructure:"otlp_endpoint"` // OTLP/HTTP collector endpoint (host:port)
+	Insecure     bool    `mapstructure:"insecure"`      // Use plain HTTP for the OTLP exporter
+	SampleRatio  float64 `mapstructure:"sample_ratio"`  // Fraction of new traces to sample (0.0-1.0)
+	ServiceName  string  `mapstructure:"service_name"`  // service.name resource attribute
+}
+
+// HealthConfig holds readiness check configuration
+type HealthConfig struct {
+	CheckTimeout            time.Duration `mapstructure:"check_timeout"`             // Timeout for each dependency check (e.g. 2s)
+	PoolSaturationThreshold float64       `mapstructure:"pool_saturation_threshold"` // In-use connection ratio reported as DEGRADED (0.0-1.0)
+	CheckMigrations         bool          `mapstructure:"check_migrations"`          // Report DOWN while Ent migrations are pending
+}
+
+// AuthConfig holds bearer token authentication configuration
+type AuthConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`    // Verify "Authorization: Bearer" JWTs on /query
+	Required  bool   `mapstructure:"required"`   // Reject requests without a token (otherwise they are anonymous)
+	JWTSecret string `mapstructure:"jwt_secret"` // HS256 signing secret shared with the token issuer
+	Issuer    string `mapstructure:"issuer"`     // Expected iss claim (empty accepts any issuer)
+}
+
+// RedisConfig holds the connection to a Redis-compatible server
+type RedisConfig struct {
+	Addr     string `mapstructure:"addr"`     // host:port
+	Password string `mapstructure:"password"` // AUTH password (empty for none)
+	DB       int    `mapstructure:"db"`       // Database number
+}
+
+// RateLimitRule is a token bucket: Burst requests at once, refilled at RequestsPerSecond
+type RateLimitRule struct {
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"` // Sustained rate
+	Burst             int     `mapstructure:"burst"`               // Bucket capacity
+}
+
+// RateLimitConfig holds per-client rate limiting configuration
+// Clients are identified by authenticated principal, or by IP when anonymous
+type RateLimitConfig struct {
+	Enabled           bool                     `mapstructure:"enabled"`             // Enforce rate limits on /query
+	Store             string                   `mapstructure:"store"`               // Bucket store: memory or redis
+	KeyPrefix         string                   `mapstructure:"key_prefix"`          // Prefix for bucket keys in shared stores
+	TrustProxyHeaders bool                     `mapstructure:"trust_proxy_headers"` // Take the client IP from X-Forwarded-For / X-Real-IP
+	Query             RateLimitRule            `mapstructure:"query"`               // Budget shared by all queries
+	Mutation          RateLimitRule            `mapstructure:"mutation"`            // Budget shared by all mutations
+	Operations        map[string]RateLimitRule `mapstructure:"operations"`          // Extra budgets per root field (e.g. createEmployee)
+}
+
+// CacheConfig holds the read-through cache in front of the repositories
+// A TTL of 0 disables caching of that entity type
+type CacheConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`        // Cache lookups by ID and the department list
+	Store         string        `mapstructure:"store"`          // Entry store: memory or redis
+	KeyPrefix     string        `mapstructure:"key_prefix"`     // Prefix for keys in shared stores
+	MaxEntries    int           `mapstructure:"max_entries"`    // Capacity of the memory store (least recently used entries are evicted)
+	DepartmentTTL time.Duration `mapstructure:"department_ttl"` // How long departments and the department list are kept
+	EmployeeTTL   time.Duration `mapstructure:"employee_ttl"`   // How long employees are kept
+	ProjectTTL    time.Duration `mapstructure:"project_ttl"`    // How long projects (with their team) are kept
+}
+
+// CORSConfig holds the cross-origin policy for /query
+type CORSConfig struct {
+	AllowedOrigins   []string      `mapstructure:"allowed_origins"`   // Exact origins, "*" or patterns like https://*.example.com
+	AllowedMethods   []string      `mapstructure:"allowed_methods"`   // Methods allowed in preflight requests
+	AllowedHeaders   []string      `mapstructure:"allowed_headers"`   // Request headers allowed in preflight requests
+	ExposedHeaders   []string      `mapstructure:"exposed_headers"`   // Response headers readable by browser scripts
+	AllowCredentials bool          `mapstructure:"allow_credentials"` // Allow cookies and HTTP auth on cross-origin requests
+	MaxAge           time.Duration `mapstructure:"max_age"`           // How long browsers may cache preflight results
+}
+
+// SecurityHeadersConfig holds standard response hardening headers
+type SecurityHeadersConfig struct {
+	Enabled               bool          `mapstructure:"enabled"`                 // Send the headers below plus nosniff and Referrer-Policy
+	ContentSecurityPolicy string        `mapstructure:"content_security_policy"` // CSP for API responses (the Playground is exempt)
+	FrameOptions          string        `mapstructure:"frame_options"`           // X-Frame-Options value (DENY, SAMEORIGIN)
+	HSTSMaxAge            time.Duration `mapstructure:"hsts_max_age"`            // Strict-Transport-Security max-age (0 disables)
+}
+
+// IdempotencyConfig holds idempotency key handling for create and delete mutations
+type IdempotencyConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`          // Honor Idempotency-Key and clientMutationId
+	TTL             time.Duration `mapstructure:"ttl"`              // How long a stored result is replayed (e.g. 24h)
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"` // How often expired keys are deleted
+}
+
+// ValidationRule holds declarative checks for one input field
+// String rules apply to text fields and Min/Max/Positive to numeric fields.
+type ValidationRule struct {
+	Required       bool     `mapstructure:"required"`        // Value must be present and non-empty
+	MinLength      int      `mapstructure:"min_length"`      // Minimum length in characters (0 disables)
+	MaxLength      int      `mapstructure:"max_length"`      // Maximum length in characters (0 disables)
+	Charset        string   `mapstructure:"charset"`         // Regexp character class every character must match (e.g. \p{L} '-)
+	Email          bool     `mapstructure:"email"`           // Value must be an email address
+	AllowedDomains []string `mapstructure:"allowed_domains"` // Email domains accepted (empty allows any)
+	Positive       bool     `mapstructure:"positive"`        // Number must be greater than zero
+	Min            *float64 `mapstructure:"min"`             // Inclusive lower bound
+	Max            *float64 `mapstructure:"max"`             // Inclusive upper bound
+}
+
+// ValidationConfig holds input validation rules shared by the GraphQL and REST APIs
+type ValidationConfig struct {
+	Rules map[string]map[string]ValidationRule `mapstructure:"rules"` // Input type (department, employee, project) -> field -> rule
+}
+
+// WorkflowConfig holds the project status state machine
+type WorkflowConfig struct {
+	ProjectTransitions map[string][]string `mapstructure:"project_transitions"` // From status -> statuses it may move to (empty uses the built-in workflow)
+	ReasonRequired     []string            `mapstructure:"reason_required"`     // Target statuses that need a reason
+	InitialStatuses    []string            `mapstructure:"initial_statuses"`    // Statuses createProject accepts, the first is the default (empty uses PLANNED, ACTIVE)
+}
+
+// WorkloadConfig holds the limits of the workload report
+type WorkloadConfig struct {
+	MaxProjects int     `mapstructure:"max_projects"` // ACTIVE projects per employee before TOO_MANY_PROJECTS (0 uses 3)
+	WeeklyHours float64 `mapstructure:"weekly_hours"` // Task hours per employee and week before TOO_MANY_TASK_HOURS (0 uses 40)
+}
+
+// WebhookConfig holds the background dispatcher of webhook deliveries
+type WebhookConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`         // Run the dispatcher (events wait in the outbox otherwise)
+	Consumer       string        `mapstructure:"consumer"`        // Outbox consumer name the dispatcher commits its offset under
+	PollInterval   time.Duration `mapstructure:"poll_interval"`   // How often due deliveries are picked up
+	BatchSize      int           `mapstructure:"batch_size"`      // Events queued and deliveries sent per batch
+	Timeout        time.Duration `mapstructure:"timeout"`         // HTTP timeout of one attempt
+	MaxAttempts    int           `mapstructure:"max_attempts"`    // Attempts before a delivery is dead-lettered
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"` // Delay before the first retry, doubled on every further retry
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`     // Upper bound of the retry delay
+
+	// AllowPrivateTargets lets subscriptions target loopback, private and
+	// link-local addresses; only for local receivers during development
+	AllowPrivateTargets bool `mapstructure:"allow_private_targets"`
+}
+
+// OutboxConfig holds the relay that publishes domain events from the outbox
+type OutboxConfig struct {
+	Consumer      string        `mapstructure:"consumer"`       // Name the relay commits its offset under
+	Sink          string        `mapstructure:"sink"`           // "stdout", "file" or "nats"
+	FilePath      string        `mapstructure:"file_path"`      // JSON lines file of the file sink
+	NATSURL       string        `mapstructure:"nats_url"`       // Server of the nats sink, e.g. nats://localhost:4222
+	SubjectPrefix string        `mapstructure:"subject_prefix"` // Subjects are <prefix>.<aggregate>.<type>
+	PollInterval  time.Duration `mapstructure:"poll_interval"`  // How often new events are read
+	BatchSize     int           `mapstructure:"batch_size"`     // Events published per offset commit
+	GapTimeout    time.Duration `mapstructure:"gap_timeout"`    // How long a skipped sequence is waited for before it counts as rolled back
+}
+
+// TenancyConfig holds how the tenant of a request is resolved
+type TenancyConfig struct {
+	Header      string `mapstructure:"header"`       // Header naming the tenant, e.g. X-Tenant-ID
+	AllowHeader bool   `mapstructure:"allow_header"` // Trust the header when the token carries no tenant claim
+}
+
 // Config is the top-level configuration structure
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`   // Server configuration
-	Database DatabaseConfig `mapstructure:"database"` // Database configuration
-	Logging  LoggingConfig  `mapstructure:"logging"`  // Logging configuration
+	Server          ServerConfig          `mapstructure:"server"`           // Server configuration
+	Database        DatabaseConfig        `mapstructure:"database"`         // Database configuration
+	Logging         LoggingConfig         `mapstructure:"logging"`          // Logging configuration
+	GraphQL         GraphQLConfig         `mapstructure:"graphql"`          // GraphQL limits and tooling
+	Metrics         MetricsConfig         `mapstructure:"metrics"`          // Prometheus metrics
+	Tracing         TracingConfig         `mapstructure:"tracing"`          // OpenTelemetry tracing
+	Health          HealthConfig          `mapstructure:"health"`           // Readiness checks
+	Auth            AuthConfig            `mapstructure:"auth"`             // Authentication
+	Redis           RedisConfig           `mapstructure:"redis"`            // Shared Redis-compatible server
+	RateLimit       RateLimitConfig       `mapstructure:"rate_limit"`       // Rate limiting
+	Cache           CacheConfig           `mapstructure:"cache"`            // Repository read-through cache
+	CORS            CORSConfig            `mapstructure:"cors"`             // Cross-origin policy
+	SecurityHeaders SecurityHeadersConfig `mapstructure:"security_headers"` // Response hardening headers
+	Idempotency     IdempotencyConfig     `mapstructure:"idempotency"`      // Safe mutation retries
+	Validation      ValidationConfig      `mapstructure:"validation"`       // Input validation rules
+	Workflow        WorkflowConfig        `mapstructure:"workflow"`         // Project status transitions
+	Workload        WorkloadConfig        `mapstructure:"workload"`         // Overallocation limits
+	Webhooks        WebhookConfig         `mapstructure:"webhooks"`         // Webhook delivery
+	Outbox          OutboxConfig          `mapstructure:"outbox"`           // Domain event relay
+	Tenancy         TenancyConfig         `mapstructure:"tenancy"`          // Tenant resolution
 }
 
 // LoadConfig loads configuration from YAML file and environment variables
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,8 @@ func TestLoadConfig_DevEnvironment(t *testing.T) {
 	assert.NotEmpty(t, cfg.Server.RESTPort)
 
 	// Verify database config
+	assert.Equal(t, "postgres", cfg.Database.Driver)
+	assert.NotEmpty(t, cfg.Database.SQLitePath)
 	assert.NotEmpty(t, cfg.Database.Host)
 	assert.Greater(t, cfg.Database.Port, 0)
 	assert.NotEmpty(t, cfg.Database.User)
@@ -35,6 +38,36 @@ func TestLoadConfig_DevEnvironment(t *testing.T) {
 
 	// Verify logging config
 	assert.NotEmpty(t, cfg.Logging.Level)
+
+	// Verify GraphQL query limits
+	assert.Greater(t, cfg.GraphQL.MaxDepth, 0)
+	assert.Greater(t, cfg.GraphQL.MaxComplexity, 0)
+	assert.Greater(t, cfg.GraphQL.ListCost, 0)
+
+	// Verify health check durations are parsed from strings like "2s"
+	assert.Equal(t, 2*time.Second, cfg.Health.CheckTimeout)
+	assert.Greater(t, cfg.Health.PoolSaturationThreshold, 0.0)
+
+	// Verify rate limit overrides (viper lower-cases map keys)
+	assert.Contains(t, cfg.RateLimit.Operations, "createemployee")
+	assert.Greater(t, cfg.RateLimit.Mutation.Burst, 0)
+
+	// Verify validation rules per input type and field
+	email := cfg.Validation.Rules["employee"]["email"]
+	assert.True(t, email.Required)
+	assert.True(t, email.Email)
+	assert.Equal(t, 254, email.MaxLength)
+	require.NotNil(t, cfg.Validation.Rules["project"]["budget"].Max)
+	assert.Nil(t, cfg.Validation.Rules["project"]["budget"].Min)
+
+	// Verify project workflow transitions (keys lower-cased, values as written)
+	assert.Equal(t, []string{"ACTIVE", "CANCELLED"}, cfg.Workflow.ProjectTransitions["on_hold"])
+	assert.NotContains(t, cfg.Workflow.ProjectTransitions, "completed")
+	assert.Contains(t, cfg.Workflow.ReasonRequired, "CANCELLED")
+
+	// Verify workload limits
+	assert.Equal(t, 3, cfg.Workload.MaxProjects)
+	assert.Equal(t, 40.0, cfg.Workload.WeeklyHours)
 }
 
 func TestLoadConfig_ProdEnvironment(t *testing.T) {
@@ -52,6 +85,31 @@ func TestLoadConfig_ProdEnvironment(t *testing.T) {
 	// Verify different values from dev
 	assert.NotEmpty(t, cfg.Server.GraphQLPort)
 	assert.NotEmpty(t, cfg.Database.Host)
+
+	// Developer tooling is not exposed in production
+	assert.False(t, cfg.GraphQL.Introspection)
+	assert.False(t, cfg.GraphQL.Playground)
+	assert.True(t, cfg.SecurityHeaders.Enabled)
+
+	assert.True(t, cfg.Idempotency.Enabled)
+	assert.Equal(t, 24*time.Hour, cfg.Idempotency.TTL)
+
+	// Webhook retries back off up to an hour
+	assert.True(t, cfg.Webhooks.Enabled)
+	assert.Equal(t, 8, cfg.Webhooks.MaxAttempts)
+	assert.Equal(t, time.Hour, cfg.Webhooks.MaxBackoff)
+
+	// Domain events are relayed to NATS
+	assert.Equal(t, "nats", cfg.Outbox.Sink)
+	assert.Equal(t, "hr.events", cfg.Outbox.SubjectPrefix)
+
+	// Only signed tokens select a tenant
+	assert.Equal(t, "X-Tenant-ID", cfg.Tenancy.Header)
+	assert.False(t, cfg.Tenancy.AllowHeader)
+
+	// Replicas share cache entries and invalidations
+	assert.Equal(t, "redis", cfg.Cache.Store)
+	assert.Equal(t, 10*time.Minute, cfg.Cache.DepartmentTTL)
 }
 
 func TestLoadConfig_TestEnvironment(t *testing.T) {
@@ -127,6 +185,19 @@ func TestLoadConfig_EnvironmentVariableOverride(t *testing.T) {
 	assert.Equal(t, customHost, cfg.Database.Host)
 }
 
+func TestLoadConfig_AllowedEmailDomainsOverride(t *testing.T) {
+	originalDir, _ := os.Getwd()
+	os.Chdir("../../")
+	defer os.Chdir(originalDir)
+
+	t.Setenv("GINAPI_VALIDATION_RULES_EMPLOYEE_EMAIL_ALLOWED_DOMAINS", "example.com,example.org")
+
+	cfg, err := LoadConfig("prod")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com", "example.org"}, cfg.Validation.Rules["employee"]["email"].AllowedDomains)
+}
+
 func TestLoadConfig_MultipleEnvVarOverrides(t *testing.T) {
 	originalDir, _ := os.Getwd()
 	os.Chdir("../../")
@@ -0,0 +1,305 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/cache"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/google/uuid"
+)
+
+// The cached repositories wrap the Ent repositories with a read-through cache
+// of lookups by ID (and the department list). Keys are scoped to the tenant
+// and to a generation of the entity type:
+//
+//	<tenant>:<scope>:<generation>:<id>
+//
+// Writes delete the entries they change. Writes that cascade to other rows
+// (deleting a department detaches its sub-departments, deleting or
+// terminating an employee changes project teams and leads) start a new
+// generation of the affected scopes instead, which orphans all of their
+// entries at once.
+
+// Cache scopes, one per entity type
+const (
+	departmentScope = "departments"
+	employeeScope   = "employees"
+	projectScope    = "projects"
+)
+
+// allKey is the key suffix of cached lists
+const allKey = "all"
+
+// repoCache reads and invalidates the entries of the cached repositories
+type repoCache struct {
+	store cache.Store
+}
+
+// cacheable reports whether the caller of ctx may share cache entries.
+// Managers see a privacy-filtered view of departments and employees (which
+// project teams embed), anonymous callers are denied by the privacy policies
+// and unscoped contexts span tenants, so all of them bypass the cache.
+func cacheable(ctx context.Context) bool {
+	p := auth.FromContext(ctx)
+	if p == nil && !auth.IsSystem(ctx) {
+		return false
+	}
+	return !tenant.IsUnscoped(ctx) && !p.HasRole(auth.RoleManager)
+}
+
+// generationKey returns the key holding the current generation of scope
+func generationKey(ctx context.Context, scope string) string {
+	return tenant.FromContext(ctx) + ":" + scope + ":generation"
+}
+
+// key returns the key of an entry of scope in its current generation,
+// starting a generation when the store has none (e.g. after eviction)
+func (c *repoCache) key(ctx context.Context, scope, suffix string) (string, error) {
+	genKey := generationKey(ctx, scope)
+	gen, ok, err := c.store.Get(ctx, genKey)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		gen = []byte(uuid.NewString())
+		if err := c.store.Set(ctx, genKey, gen, 0); err != nil {
+			return "", err
+		}
+	}
+	return tenant.FromContext(ctx) + ":" + scope + ":" + string(gen) + ":" + suffix, nil
+}
+
+// forget deletes the entries of scope with the given suffixes
+func (c *repoCache) forget(ctx context.Context, scope string, suffixes ...string) {
+	keys := make([]string, 0, len(suffixes))
+	for _, suffix := range suffixes {
+		key, err := c.key(ctx, scope, suffix)
+		if err != nil {
+			logInvalidationError(err, scope)
+			return
+		}
+		keys = append(keys, key)
+	}
+	if err := c.store.Delete(ctx, keys...); err != nil {
+		logInvalidationError(err, scope)
+	}
+}
+
+// reset starts a new generation of each scope, orphaning all of its entries
+func (c *repoCache) reset(ctx context.Context, scopes ...string) {
+	for _, scope := range scopes {
+		if err := c.store.Set(ctx, generationKey(ctx, scope), []byte(uuid.NewString()), 0); err != nil {
+			logInvalidationError(err, scope)
+		}
+	}
+}
+
+func logInvalidationError(err error, scope string) {
+	log := logger.WithComponent("RepoCache")
+	log.Error().
+		Err(err).
+		Str("scope", scope).
+		Msg("Failed to invalidate cache entries; they may be stale until they expire")
+}
+
+// readThrough returns the cached value of scope/suffix, calling load and
+// caching its result for ttl on a miss. Errors (including ErrNotFound) are
+// not cached, and a failing store falls back to load.
+func readThrough[T any](ctx context.Context, c *repoCache, scope, suffix string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if ttl <= 0 || !cacheable(ctx) {
+		return load()
+	}
+	log := logger.WithComponent("RepoCache")
+
+	key, err := c.key(ctx, scope, suffix)
+	if err != nil {
+		log.Warn().Err(err).Str("scope", scope).Msg("Cache unavailable, reading from database")
+		return load()
+	}
+	if data, ok, err := c.store.Get(ctx, key); err != nil {
+		log.Warn().Err(err).Str("key", key).Msg("Cache read failed, reading from database")
+	} else if ok {
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+		log.Warn().Err(err).Str("key", key).Msg("Discarding undecodable cache entry")
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		if err := c.store.Set(ctx, key, data, ttl); err != nil {
+			log.Warn().Err(err).Str("key", key).Msg("Cache write failed")
+		}
+	}
+	return value, nil
+}
+
+// CachedDepartmentRepo caches department lookups and the department list
+type CachedDepartmentRepo struct {
+	DepartmentRepository
+	cache repoCache
+	ttl   time.Duration
+}
+
+// NewCachedDepartmentRepo wraps repo with a cache in store keeping entries for ttl
+func NewCachedDepartmentRepo(repo DepartmentRepository, store cache.Store, ttl time.Duration) DepartmentRepository {
+	return &CachedDepartmentRepo{DepartmentRepository: repo, cache: repoCache{store: store}, ttl: ttl}
+}
+
+// FindByID reads through the cache
+func (r *CachedDepartmentRepo) FindByID(ctx context.Context, id string) (*model.Department, error) {
+	return readThrough(ctx, &r.cache, departmentScope, id, r.ttl, func() (*model.Department, error) {
+		return r.DepartmentRepository.FindByID(ctx, id)
+	})
+}
+
+// FindAll reads through the cache
+func (r *CachedDepartmentRepo) FindAll(ctx context.Context) ([]*model.Department, error) {
+	return readThrough(ctx, &r.cache, departmentScope, allKey, r.ttl, func() ([]*model.Department, error) {
+		return r.DepartmentRepository.FindAll(ctx)
+	})
+}
+
+// Save invalidates the department list
+func (r *CachedDepartmentRepo) Save(ctx context.Context, dept *model.Department) error {
+	err := r.DepartmentRepository.Save(ctx, dept)
+	r.cache.forget(ctx, departmentScope, allKey)
+	return err
+}
+
+// Update invalidates the department and the department list
+func (r *CachedDepartmentRepo) Update(ctx context.Context, dept *model.Department) error {
+	err := r.DepartmentRepository.Update(ctx, dept)
+	r.cache.forget(ctx, departmentScope, dept.ID, allKey)
+	return err
+}
+
+// Delete invalidates all departments, as sub-departments lose their parent.
+// The employees of the department are deleted before it, each through
+// EmployeeRepository.Delete.
+func (r *CachedDepartmentRepo) Delete(ctx context.Context, id string) error {
+	err := r.DepartmentRepository.Delete(ctx, id)
+	r.cache.reset(ctx, departmentScope)
+	return err
+}
+
+// CachedEmployeeRepo caches employee lookups
+type CachedEmployeeRepo struct {
+	EmployeeRepository
+	cache repoCache
+	ttl   time.Duration
+}
+
+// NewCachedEmployeeRepo wraps repo with a cache in store keeping entries for ttl
+func NewCachedEmployeeRepo(repo EmployeeRepository, store cache.Store, ttl time.Duration) EmployeeRepository {
+	return &CachedEmployeeRepo{EmployeeRepository: repo, cache: repoCache{store: store}, ttl: ttl}
+}
+
+// FindByID reads through the cache
+func (r *CachedEmployeeRepo) FindByID(ctx context.Context, id string) (*model.Employee, error) {
+	return readThrough(ctx, &r.cache, employeeScope, id, r.ttl, func() (*model.Employee, error) {
+		return r.EmployeeRepository.FindByID(ctx, id)
+	})
+}
+
+// Update invalidates the employee and the projects embedding it as a team member
+func (r *CachedEmployeeRepo) Update(ctx context.Context, emp *model.Employee) error {
+	err := r.EmployeeRepository.Update(ctx, emp)
+	r.cache.forget(ctx, employeeScope, emp.ID)
+	r.cache.reset(ctx, projectScope)
+	return err
+}
+
+// Delete invalidates the employee and the projects it was on or led
+func (r *CachedEmployeeRepo) Delete(ctx context.Context, id string) error {
+	err := r.EmployeeRepository.Delete(ctx, id)
+	r.cache.forget(ctx, employeeScope, id)
+	r.cache.reset(ctx, projectScope)
+	return err
+}
+
+// Terminate invalidates the employee and the projects it is removed from
+func (r *CachedEmployeeRepo) Terminate(ctx context.Context, id string, terminationDate time.Time) error {
+	err := r.EmployeeRepository.Terminate(ctx, id, terminationDate)
+	r.cache.forget(ctx, employeeScope, id)
+	r.cache.reset(ctx, projectScope)
+	return err
+}
+
+// Rehire invalidates the employee and the projects embedding it
+func (r *CachedEmployeeRepo) Rehire(ctx context.Context, id string, hireDate time.Time) error {
+	err := r.EmployeeRepository.Rehire(ctx, id, hireDate)
+	r.cache.forget(ctx, employeeScope, id)
+	r.cache.reset(ctx, projectScope)
+	return err
+}
+
+// CachedProjectRepo caches project lookups, including their team members.
+// Milestones, tasks and the status history are not part of a cached project.
+type CachedProjectRepo struct {
+	ProjectRepository
+	cache repoCache
+	ttl   time.Duration
+}
+
+// NewCachedProjectRepo wraps repo with a cache in store keeping entries for ttl
+func NewCachedProjectRepo(repo ProjectRepository, store cache.Store, ttl time.Duration) ProjectRepository {
+	return &CachedProjectRepo{ProjectRepository: repo, cache: repoCache{store: store}, ttl: ttl}
+}
+
+// FindByID reads through the cache
+func (r *CachedProjectRepo) FindByID(ctx context.Context, id string) (*model.Project, error) {
+	proj, err := readThrough(ctx, &r.cache, projectScope, id, r.ttl, func() (*model.Project, error) {
+		return r.ProjectRepository.FindByID(ctx, id)
+	})
+	// An empty team is omitted from the cached JSON; keep it a list, not null
+	if proj != nil && proj.TeamMembers == nil {
+		proj.TeamMembers = []*model.Employee{}
+	}
+	return proj, err
+}
+
+// Update invalidates the project
+func (r *CachedProjectRepo) Update(ctx context.Context, project *model.Project) error {
+	err := r.ProjectRepository.Update(ctx, project)
+	r.cache.forget(ctx, projectScope, project.ID)
+	return err
+}
+
+// Delete invalidates the project
+func (r *CachedProjectRepo) Delete(ctx context.Context, id string) error {
+	err := r.ProjectRepository.Delete(ctx, id)
+	r.cache.forget(ctx, projectScope, id)
+	return err
+}
+
+// AddTeamMember invalidates the project
+func (r *CachedProjectRepo) AddTeamMember(ctx context.Context, projectID string, employeeID string) error {
+	err := r.ProjectRepository.AddTeamMember(ctx, projectID, employeeID)
+	r.cache.forget(ctx, projectScope, projectID)
+	return err
+}
+
+// RemoveTeamMember invalidates the project
+func (r *CachedProjectRepo) RemoveTeamMember(ctx context.Context, projectID string, employeeID string) error {
+	err := r.ProjectRepository.RemoveTeamMember(ctx, projectID, employeeID)
+	r.cache.forget(ctx, projectScope, projectID)
+	return err
+}
+
+// TransitionStatus invalidates the project
+func (r *CachedProjectRepo) TransitionStatus(ctx context.Context, change *model.ProjectStatusChange) error {
+	err := r.ProjectRepository.TransitionStatus(ctx, change)
+	r.cache.forget(ctx, projectScope, change.ProjectID)
+	return err
+}
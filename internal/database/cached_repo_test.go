@@ -0,0 +1,189 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/cache"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/privacy"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/tenant"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cachedFixture is the cached repositories over an Ent client, with a
+// department, an employee and a project the employee works on
+type cachedFixture struct {
+	client   *ent.Client
+	deptRepo DepartmentRepository
+	empRepo  EmployeeRepository
+	projRepo ProjectRepository
+	dept     *model.Department
+	emp      *model.Employee
+	proj     *model.Project
+}
+
+func setupCachedFixture(t *testing.T) *cachedFixture {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+	store := cache.NewMemoryStore(100)
+	ctx := auth.WithSystem(context.Background())
+
+	f := &cachedFixture{
+		client:   client,
+		deptRepo: NewCachedDepartmentRepo(NewEntDepartmentRepo(client), store, time.Minute),
+		empRepo:  NewCachedEmployeeRepo(NewEntEmployeeRepo(client), store, time.Minute),
+		projRepo: NewCachedProjectRepo(NewEntProjectRepo(client), store, time.Minute),
+	}
+	f.dept = &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, f.deptRepo.Save(ctx, f.dept))
+	f.emp = &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: f.dept.ID}
+	require.NoError(t, f.empRepo.Save(ctx, f.emp))
+	f.proj = &model.Project{
+		ID: uuid.NewString(), Name: "Apollo", Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+		StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	}
+	require.NoError(t, f.projRepo.Save(ctx, f.proj))
+	require.NoError(t, f.projRepo.AddTeamMember(ctx, f.proj.ID, f.emp.ID))
+	return f
+}
+
+// renameBehindCache renames the department without going through the cache
+func (f *cachedFixture) renameBehindCache(t *testing.T, ctx context.Context, name string) {
+	require.NoError(t, f.client.Department.UpdateOneID(uuid.MustParse(f.dept.ID)).SetName(name).Exec(ctx))
+}
+
+func TestCachedDepartmentRepo_ReadsThroughAndInvalidatesOnUpdate(t *testing.T) {
+	// Setup: cache the department and the list
+	f := setupCachedFixture(t)
+	ctx := auth.WithSystem(context.Background())
+	_, err := f.deptRepo.FindByID(ctx, f.dept.ID)
+	require.NoError(t, err)
+	_, err = f.deptRepo.FindAll(ctx)
+	require.NoError(t, err)
+
+	// Test: a change behind the cache is not seen until the repo writes
+	f.renameBehindCache(t, ctx, "Stale")
+	cached, err := f.deptRepo.FindByID(ctx, f.dept.ID)
+	require.NoError(t, err)
+	f.dept.Name = "R&D"
+	require.NoError(t, f.deptRepo.Update(ctx, f.dept))
+
+	// Assert
+	assert.Equal(t, "Engineering", cached.Name)
+	found, err := f.deptRepo.FindByID(ctx, f.dept.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "R&D", found.Name)
+	all, err := f.deptRepo.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "R&D", all[0].Name)
+}
+
+func TestCachedDepartmentRepo_DeleteInvalidatesCascade(t *testing.T) {
+	// Setup: cache a sub-department, the employee and the project with its team
+	f := setupCachedFixture(t)
+	ctx := auth.WithSystem(context.Background())
+	sub := &model.Department{ID: uuid.NewString(), Name: "Platform", ParentID: &f.dept.ID}
+	require.NoError(t, f.deptRepo.Save(ctx, sub))
+	_, err := f.deptRepo.FindByID(ctx, sub.ID)
+	require.NoError(t, err)
+	_, err = f.empRepo.FindByID(ctx, f.emp.ID)
+	require.NoError(t, err)
+	proj, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	require.Len(t, proj.TeamMembers, 1)
+
+	// Test: delete the department the way deleteDepartment does
+	require.NoError(t, f.empRepo.Delete(ctx, f.emp.ID))
+	require.NoError(t, f.deptRepo.Delete(ctx, f.dept.ID))
+
+	// Assert: the sub-department is top-level and the employee left the team
+	found, err := f.deptRepo.FindByID(ctx, sub.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ParentID)
+	_, err = f.empRepo.FindByID(ctx, f.emp.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	proj, err = f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, proj.TeamMembers)
+	assert.Empty(t, proj.TeamMembers)
+}
+
+func TestCachedProjectRepo_TeamChangesInvalidate(t *testing.T) {
+	// Setup
+	f := setupCachedFixture(t)
+	ctx := auth.WithSystem(context.Background())
+	_, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+
+	// Test & Assert: removing and terminating are both seen
+	require.NoError(t, f.projRepo.RemoveTeamMember(ctx, f.proj.ID, f.emp.ID))
+	proj, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, proj.TeamMembers)
+
+	require.NoError(t, f.projRepo.AddTeamMember(ctx, f.proj.ID, f.emp.ID))
+	proj, err = f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Len(t, proj.TeamMembers, 1)
+
+	require.NoError(t, f.empRepo.Terminate(ctx, f.emp.ID, time.Now()))
+	proj, err = f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, proj.TeamMembers)
+	emp, err := f.empRepo.FindByID(ctx, f.emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusTerminated, emp.Status)
+}
+
+func TestCachedRepos_KeysAreScopedToTenantAndViewer(t *testing.T) {
+	// Setup: cache the department for the default tenant
+	f := setupCachedFixture(t)
+	ctx := auth.WithSystem(context.Background())
+	_, err := f.deptRepo.FindByID(ctx, f.dept.ID)
+	require.NoError(t, err)
+	f.renameBehindCache(t, ctx, "Renamed")
+
+	// Test
+	_, otherTenantErr := f.deptRepo.FindByID(tenant.NewContext(ctx, "globex"), f.dept.ID)
+	managerCtx := auth.WithPrincipal(ctx, &auth.Principal{ID: f.emp.ID, Roles: []string{auth.RoleManager}})
+	managed, managerErr := f.deptRepo.FindByID(managerCtx, f.dept.ID)
+
+	// Assert: other tenants never share entries; managers bypass the cache
+	assert.ErrorIs(t, otherTenantErr, ErrNotFound)
+	require.NoError(t, managerErr)
+	assert.Equal(t, "Renamed", managed.Name)
+}
+
+// TestCachedRepos_AnonymousCallersBypassTheCache tests that entries cached for
+// an authenticated viewer are not served to callers the privacy policies deny
+func TestCachedRepos_AnonymousCallersBypassTheCache(t *testing.T) {
+	// Setup: warm the cache as an admin
+	f := setupCachedFixture(t)
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{ID: uuid.NewString(), Roles: []string{auth.RoleAdmin}})
+	_, err := f.deptRepo.FindByID(admin, f.dept.ID)
+	require.NoError(t, err)
+	_, err = f.empRepo.FindByID(admin, f.emp.ID)
+	require.NoError(t, err)
+	_, err = f.projRepo.FindByID(admin, f.proj.ID)
+	require.NoError(t, err)
+
+	// Test
+	anonymous := context.Background()
+	_, deptErr := f.deptRepo.FindByID(anonymous, f.dept.ID)
+	_, empErr := f.empRepo.FindByID(anonymous, f.emp.ID)
+	_, projErr := f.projRepo.FindByID(anonymous, f.proj.ID)
+
+	// Assert
+	assert.ErrorIs(t, deptErr, privacy.Deny)
+	assert.ErrorIs(t, empErr, privacy.Deny)
+	assert.ErrorIs(t, projErr, privacy.Deny)
+}
@@ -0,0 +1,34 @@
+package database_test
+
+import (
+	"testing"
+
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/testutil/conformance"
+)
+
+// The conformance suite is run from an external test package because it
+// imports package database
+
+func TestConformance_EntSQLite(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.Repositories {
+		client := testutil.NewTestEntClientWithDriver(t, testutil.NewTestEntDriver(t))
+		return conformance.Repositories{
+			Departments: database.NewEntDepartmentRepo(client),
+			Employees:   database.NewEntEmployeeRepo(client),
+			Projects:    database.NewEntProjectRepo(client),
+		}
+	})
+}
+
+func TestConformance_Memory(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.Repositories {
+		store := database.NewMemoryStore()
+		return conformance.Repositories{
+			Departments: database.NewMemoryDepartmentRepo(store),
+			Employees:   database.NewMemoryEmployeeRepo(store),
+			Projects:    database.NewMemoryProjectRepo(store),
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package database
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"gin-crud-api/internal/graph/model"
 )
@@ -9,38 +11,169 @@ import (
 // Repository interfaces define the contract for data access
 // These interfaces use GraphQL-generated models as the single source of truth
 // This simplifies the codebase by eliminating conversion layers
+// Every method takes the caller's context so cancellation and tracing reach the database
 
 // ErrNotFound is returned when a record is not found in the database
 var ErrNotFound = fmt.Errorf("record not found")
 
+// ErrStatusConflict is returned when a status transition finds the record in
+// another status than expected (e.g. after a concurrent transition)
+var ErrStatusConflict = fmt.Errorf("status changed concurrently")
+
+// ErrAlreadyExists is returned when a record violates a uniqueness constraint
+var ErrAlreadyExists = fmt.Errorf("record already exists")
+
+// ErrEmployeeTerminated is returned when a terminated employee would join a
+// project team
+var ErrEmployeeTerminated = fmt.Errorf("terminated employees cannot join a project team")
+
+// ErrNotTeamMember is returned when a task is assigned to an employee that is
+// not on the team of the task's project
+var ErrNotTeamMember = fmt.Errorf("assignee is not a member of the project team")
+
 // DepartmentRepository defines all operations for managing departments
 type DepartmentRepository interface {
-	Save(dept *model.Department) error
-	FindByID(id string) (*model.Department, error)
-	FindAll() ([]*model.Department, error)
-	Update(dept *model.Department) error
-	Delete(id string) error
+	Save(ctx context.Context, dept *model.Department) error
+	FindByID(ctx context.Context, id string) (*model.Department, error)
+	FindAll(ctx context.Context) ([]*model.Department, error)
+	Update(ctx context.Context, dept *model.Department) error
+	Delete(ctx context.Context, id string) error
 }
 
 // EmployeeRepository defines all operations for managing employees
 type EmployeeRepository interface {
-	Save(emp *model.Employee) error
-	FindByID(id string) (*model.Employee, error)
-	FindAll() ([]*model.Employee, error)
-	Update(emp *model.Employee) error
-	Delete(id string) error
-	FindByDepartmentID(deptID string) ([]*model.Employee, error)
+	Save(ctx context.Context, emp *model.Employee) error
+	FindByID(ctx context.Context, id string) (*model.Employee, error)
+	FindAll(ctx context.Context) ([]*model.Employee, error)
+	Update(ctx context.Context, emp *model.Employee) error
+	Delete(ctx context.Context, id string) error
+	FindByDepartmentID(ctx context.Context, deptID string) ([]*model.Employee, error)
+	// Terminate sets the status to TERMINATED and removes the employee from
+	// every project that is not COMPLETED or CANCELLED
+	Terminate(ctx context.Context, id string, terminationDate time.Time) error
+	// Rehire sets the status back to ACTIVE with a new hire date
+	Rehire(ctx context.Context, id string, hireDate time.Time) error
 }
 
 // ProjectRepository defines all operations for managing projects
 type ProjectRepository interface {
-	Save(project *model.Project) error
-	FindByID(id string) (*model.Project, error)
-	FindAll() ([]*model.Project, error)
-	Update(project *model.Project) error
-	Delete(id string) error
-	FindByStatus(status model.ProjectStatus) ([]*model.Project, error)
-	FindByEmployeeID(employeeID string) ([]*model.Project, error)
-	AddTeamMember(projectID string, employeeID string) error
-	RemoveTeamMember(projectID string, employeeID string) error
-}
\ No newline at end of file
+	Save(ctx context.Context, project *model.Project) error
+	FindByID(ctx context.Context, id string) (*model.Project, error)
+	FindAll(ctx context.Context) ([]*model.Project, error)
+	Update(ctx context.Context, project *model.Project) error
+	Delete(ctx context.Context, id string) error
+	FindByStatus(ctx context.Context, status model.ProjectStatus) ([]*model.Project, error)
+	FindByEmployeeID(ctx context.Context, employeeID string) ([]*model.Project, error)
+	AddTeamMember(ctx context.Context, projectID string, employeeID string) error
+	RemoveTeamMember(ctx context.Context, projectID string, employeeID string) error
+	IsTeamMember(ctx context.Context, projectID string, employeeID string) (bool, error)
+
+	// Milestones and tasks belong to a project and are deleted with it
+	SaveMilestone(ctx context.Context, milestone *model.Milestone) error
+	FindMilestoneByID(ctx context.Context, id string) (*model.Milestone, error)
+	FindMilestonesByProjectID(ctx context.Context, projectID string) ([]*model.Milestone, error)
+	UpdateMilestone(ctx context.Context, milestone *model.Milestone) error
+	DeleteMilestone(ctx context.Context, id string) error
+	SaveTask(ctx context.Context, task *model.Task) error
+	FindTaskByID(ctx context.Context, id string) (*model.Task, error)
+	FindTasksByProjectID(ctx context.Context, projectID string) ([]*model.Task, error)
+	FindTasksByMilestoneID(ctx context.Context, milestoneID string) ([]*model.Task, error)
+	UpdateTask(ctx context.Context, task *model.Task) error
+	DeleteTask(ctx context.Context, id string) error
+	// Progress returns the share of the project's tasks that are DONE (0 when it has none)
+	Progress(ctx context.Context, projectID string) (float64, error)
+
+	// TransitionStatus moves a project from change.From to change.To and records
+	// the change, setting its ID and ChangedAt. Update never changes the status.
+	// It returns ErrStatusConflict when the project is no longer in change.From.
+	TransitionStatus(ctx context.Context, change *model.ProjectStatusChange) error
+	FindStatusChanges(ctx context.Context, projectID string) ([]*model.ProjectStatusChange, error)
+
+	// Workload returns every employee that is not TERMINATED with the ACTIVE
+	// projects overlapping from..to (inclusive) and the estimated hours of
+	// their open tasks due in it. The query count does not grow with employees.
+	Workload(ctx context.Context, from, to time.Time) ([]*EmployeeLoad, error)
+}
+
+// EmployeeLoad is an employee's assignments in a workload window
+type EmployeeLoad struct {
+	Employee      *model.Employee
+	Projects      []*model.Project // ACTIVE projects overlapping the window, ordered by name
+	ProjectCount  int              // Number of Projects
+	OpenTaskHours float64          // Estimates of assigned tasks of ACTIVE projects not DONE and due in the window
+}
+
+// SkillRepository defines all operations for managing the skill catalog,
+// employee proficiencies and project skill requirements
+type SkillRepository interface {
+	// Save returns ErrAlreadyExists when a skill with the same name exists
+	Save(ctx context.Context, skill *model.Skill) error
+	FindByID(ctx context.Context, id string) (*model.Skill, error)
+	FindAll(ctx context.Context) ([]*model.Skill, error)
+	// Update returns ErrAlreadyExists when another skill has the new name
+	Update(ctx context.Context, skill *model.Skill) error
+	// Delete also removes the skill from every employee and project
+	Delete(ctx context.Context, id string) error
+
+	// SetEmployeeSkill adds a skill to an employee or changes its level
+	SetEmployeeSkill(ctx context.Context, employeeID string, skillID string, level int) error
+	// RemoveEmployeeSkill returns ErrNotFound when the employee lacks the skill
+	RemoveEmployeeSkill(ctx context.Context, employeeID string, skillID string) error
+	FindEmployeeSkills(ctx context.Context, employeeID string) ([]*model.EmployeeSkill, error)
+
+	// SetProjectSkill requires a skill for a project or changes its minimum level
+	SetProjectSkill(ctx context.Context, projectID string, skillID string, minLevel int) error
+	// RemoveProjectSkill returns ErrNotFound when the project does not require the skill
+	RemoveProjectSkill(ctx context.Context, projectID string, skillID string) error
+	FindProjectSkills(ctx context.Context, projectID string) ([]*model.RequiredSkill, error)
+
+	// SuggestTeamMembers ranks employees that are not on the project's team
+	// and not TERMINATED by skill match, then by fewest current projects
+	SuggestTeamMembers(ctx context.Context, projectID string, limit int) ([]*model.TeamMemberSuggestion, error)
+}
+
+// WebhookRepository defines all operations for managing webhook subscriptions
+// and their delivery log. Events are queued by the repositories that change the
+// entities, in the same transaction as the change.
+type WebhookRepository interface {
+	// SaveSubscription creates a subscription and sets its CreatedAt
+	SaveSubscription(ctx context.Context, sub *model.WebhookSubscription, secret string) error
+	FindSubscriptionByID(ctx context.Context, id string) (*model.WebhookSubscription, error)
+	FindSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error)
+	// UpdateSubscription writes URL, event types and active; a non-nil secret replaces the secret
+	UpdateSubscription(ctx context.Context, sub *model.WebhookSubscription, secret *string) error
+	// DeleteSubscription also deletes the subscription's deliveries
+	DeleteSubscription(ctx context.Context, id string) error
+	// FindDeliveries returns up to limit deliveries of a subscription, newest first
+	FindDeliveries(ctx context.Context, subscriptionID string, status *model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error)
+	// Redeliver queues a DEAD delivery again with no attempts made.
+	// It returns ErrStatusConflict when the delivery is not DEAD.
+	Redeliver(ctx context.Context, id string) (*model.WebhookDelivery, error)
+}
+
+// IdempotencyRecord is a mutation executed under a client-supplied idempotency key
+type IdempotencyRecord struct {
+	ID          string
+	Principal   string    // Caller that supplied the key
+	Key         string    // Idempotency-Key header or clientMutationId
+	Operation   string    // Mutation field (alias) the key was used for
+	RequestHash string    // Fingerprint of field, arguments and selection
+	Completed   bool      // False while the mutation is executing
+	Response    []byte    // JSON result of the field, set once completed
+	CreatedAt   time.Time // When the key was first used
+	ExpiresAt   time.Time // The key may be reused after this time
+}
+
+// IdempotencyRepository stores idempotency records
+type IdempotencyRepository interface {
+	// Acquire inserts rec as in progress and sets rec.ID. When a record that
+	// has not expired by rec.CreatedAt already exists for the same principal,
+	// key and operation it is returned instead and nothing is inserted.
+	Acquire(ctx context.Context, rec *IdempotencyRecord) (*IdempotencyRecord, error)
+	// Complete stores the response of an acquired record
+	Complete(ctx context.Context, id string, response []byte) error
+	// Release deletes an acquired record so the key can be retried (e.g. after an error)
+	Release(ctx context.Context, id string) error
+	// DeleteExpired removes records that expired before now and returns how many were removed
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
@@ -8,14 +8,28 @@ import (
 
 	"gin-crud-api/internal/config"
 	"gin-crud-api/internal/ent"
+	_ "gin-crud-api/internal/ent/runtime" // Schema defaults and hooks
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
-	_ "github.com/lib/pq" // PostgreSQL driver
+	_ "github.com/lib/pq"           // PostgreSQL driver
+	_ "github.com/mattn/go-sqlite3" // SQLite driver (requires cgo)
 )
 
 // NewEntClient creates a new EntGo client with PostgreSQL connection
 // and automatically runs database migrations
 func NewEntClient(cfg *config.DatabaseConfig) (*ent.Client, error) {
+	drv, err := NewEntDriver(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewEntClientWithDriver(drv)
+}
+
+// NewEntDriver opens the PostgreSQL driver used by the EntGo client
+// and configures its connection pool. The driver is exposed separately so
+// callers can observe the underlying *sql.DB (e.g. pool statistics).
+func NewEntDriver(cfg *config.DatabaseConfig) (*entsql.Driver, error) {
 	// Build PostgreSQL connection string for lib/pq driver
 	// Format: "host=localhost port=5432 user=postgres password=postgres dbname=gin_crud_api sslmode=disable"
 	dsn := fmt.Sprintf(
@@ -40,7 +54,34 @@ func NewEntClient(cfg *config.DatabaseConfig) (*ent.Client, error) {
 	db.SetMaxIdleConns(cfg.MinConns)
 	db.SetConnMaxLifetime(time.Hour)
 
-	// Create EntGo client with PostgreSQL dialect
+	return drv, nil
+}
+
+// NewEntSQLiteDriver opens the SQLite database file cfg.SQLitePath, creating
+// it when missing. Foreign keys are enforced like in PostgreSQL, and WAL mode
+// with a busy timeout lets requests read while another one writes.
+func NewEntSQLiteDriver(cfg *config.DatabaseConfig) (*entsql.Driver, error) {
+	if cfg.SQLitePath == "" {
+		return nil, fmt.Errorf("database.sqlite_path is required for the sqlite driver")
+	}
+	dsn := fmt.Sprintf("file:%s?_fk=1&_busy_timeout=5000&_journal_mode=WAL", cfg.SQLitePath)
+
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database file: %w", err)
+	}
+
+	db := drv.DB()
+	db.SetMaxOpenConns(cfg.MaxConns)
+	db.SetMaxIdleConns(cfg.MinConns)
+
+	return drv, nil
+}
+
+// NewEntClientWithDriver creates an EntGo client on top of an opened driver
+// and automatically runs database migrations
+func NewEntClientWithDriver(drv dialect.Driver) (*ent.Client, error) {
+	// Create EntGo client with the given dialect driver
 	client := ent.NewClient(ent.Driver(drv))
 
 	// Run automatic migrations
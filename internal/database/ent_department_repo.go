@@ -7,6 +7,7 @@ import (
 	"gin-crud-api/internal/ent"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
 
 	"github.com/google/uuid"
 )
@@ -22,8 +23,7 @@ func NewEntDepartmentRepo(client *ent.Client) DepartmentRepository {
 }
 
 // Save creates a new department in the database
-func (r *EntDepartmentRepo) Save(dept *model.Department) error {
-	ctx := context.Background()
+func (r *EntDepartmentRepo) Save(ctx context.Context, dept *model.Department) error {
 	log := logger.WithComponent("DepartmentRepo")
 
 	log.Debug().
@@ -40,13 +40,24 @@ func (r *EntDepartmentRepo) Save(dept *model.Department) error {
 			Msg("Invalid department ID format")
 		return fmt.Errorf("invalid department ID: %w", err)
 	}
+	parentID, err := parseOptionalID(dept.ParentID)
+	if err != nil {
+		return fmt.Errorf("invalid parent department ID: %w", err)
+	}
 
-	// Create department using EntGo's type-safe builder
-	_, err = r.client.Department.
-		Create().
-		SetID(id).
-		SetName(dept.Name).
-		Save(ctx)
+	// Create the department and record DepartmentCreated in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDept, err := tx.Department.
+			Create().
+			SetID(id).
+			SetName(dept.Name).
+			SetNillableParentID(parentID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateDepartment, id, outbox.DepartmentCreated, outbox.NewDepartment(entDepartmentToModel(entDept)))
+	})
 
 	if err != nil {
 		log.Error().
@@ -65,8 +76,7 @@ func (r *EntDepartmentRepo) Save(dept *model.Department) error {
 }
 
 // FindByID retrieves a department by its ID
-func (r *EntDepartmentRepo) FindByID(id string) (*model.Department, error) {
-	ctx := context.Background()
+func (r *EntDepartmentRepo) FindByID(ctx context.Context, id string) (*model.Department, error) {
 	log := logger.WithComponent("DepartmentRepo")
 
 	log.Debug().
@@ -105,15 +115,11 @@ func (r *EntDepartmentRepo) FindByID(id string) (*model.Department, error) {
 		Msg("Department found successfully")
 
 	// Convert EntGo entity to GraphQL model
-	return &model.Department{
-		ID:   entDept.ID.String(),
-		Name: entDept.Name,
-	}, nil
+	return entDepartmentToModel(entDept), nil
 }
 
 // FindAll retrieves all departments from the database
-func (r *EntDepartmentRepo) FindAll() ([]*model.Department, error) {
-	ctx := context.Background()
+func (r *EntDepartmentRepo) FindAll(ctx context.Context) ([]*model.Department, error) {
 	log := logger.WithComponent("DepartmentRepo")
 
 	log.Debug().Msg("Finding all departments")
@@ -130,10 +136,7 @@ func (r *EntDepartmentRepo) FindAll() ([]*model.Department, error) {
 	// Convert EntGo entities to GraphQL models
 	departments := make([]*model.Department, len(entDepts))
 	for i, entDept := range entDepts {
-		departments[i] = &model.Department{
-			ID:   entDept.ID.String(),
-			Name: entDept.Name,
-		}
+		departments[i] = entDepartmentToModel(entDept)
 	}
 
 	log.Debug().
@@ -144,8 +147,7 @@ func (r *EntDepartmentRepo) FindAll() ([]*model.Department, error) {
 }
 
 // Update updates an existing department
-func (r *EntDepartmentRepo) Update(dept *model.Department) error {
-	ctx := context.Background()
+func (r *EntDepartmentRepo) Update(ctx context.Context, dept *model.Department) error {
 	log := logger.WithComponent("DepartmentRepo")
 
 	log.Debug().
@@ -162,12 +164,27 @@ func (r *EntDepartmentRepo) Update(dept *model.Department) error {
 			Msg("Invalid department ID format")
 		return fmt.Errorf("invalid department ID: %w", err)
 	}
+	parentID, err := parseOptionalID(dept.ParentID)
+	if err != nil {
+		return fmt.Errorf("invalid parent department ID: %w", err)
+	}
 
-	// Update department using EntGo's type-safe builder
-	err = r.client.Department.
-		UpdateOneID(id).
-		SetName(dept.Name).
-		Exec(ctx)
+	// Update the department and record DepartmentUpdated in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		update := tx.Department.
+			UpdateOneID(id).
+			SetName(dept.Name)
+		if parentID != nil {
+			update = update.SetParentID(*parentID)
+		} else {
+			update = update.ClearParent()
+		}
+		entDept, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateDepartment, id, outbox.DepartmentUpdated, outbox.NewDepartment(entDepartmentToModel(entDept)))
+	})
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -192,8 +209,7 @@ func (r *EntDepartmentRepo) Update(dept *model.Department) error {
 }
 
 // Delete removes a department from the database
-func (r *EntDepartmentRepo) Delete(id string) error {
-	ctx := context.Background()
+func (r *EntDepartmentRepo) Delete(ctx context.Context, id string) error {
 	log := logger.WithComponent("DepartmentRepo")
 
 	log.Debug().
@@ -210,8 +226,17 @@ func (r *EntDepartmentRepo) Delete(id string) error {
 		return fmt.Errorf("invalid department ID: %w", err)
 	}
 
-	// Delete department using EntGo
-	err = r.client.Department.DeleteOneID(uid).Exec(ctx)
+	// Delete the department and record DepartmentDeleted with its last state in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		entDept, err := tx.Department.Get(ctx, uid)
+		if err != nil {
+			return err
+		}
+		if err := tx.Department.DeleteOne(entDept).Exec(ctx); err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateDepartment, uid, outbox.DepartmentDeleted, outbox.NewDepartment(entDepartmentToModel(entDept)))
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			log.Debug().
@@ -232,3 +257,16 @@ func (r *EntDepartmentRepo) Delete(id string) error {
 
 	return nil
 }
+
+// entDepartmentToModel converts an EntGo department entity to a GraphQL model
+func entDepartmentToModel(entDept *ent.Department) *model.Department {
+	dept := &model.Department{
+		ID:   entDept.ID.String(),
+		Name: entDept.Name,
+	}
+	if entDept.ParentID != nil {
+		parentID := entDept.ParentID.String()
+		dept.ParentID = &parentID
+	}
+	return dept
+}
@@ -1,8 +1,10 @@
 package database
 
 import (
+	"context"
 	"testing"
 
+	"gin-crud-api/internal/auth"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/testutil"
 
@@ -24,13 +26,13 @@ func TestEntDepartmentRepo_Save(t *testing.T) {
 		Name: "Engineering",
 	}
 
-	err := repo.Save(dept)
+	err := repo.Save(auth.WithSystem(context.Background()), dept)
 
 	// Assert: No error and department was saved
 	require.NoError(t, err)
 
 	// Verify: Department can be retrieved
-	saved, err := repo.FindByID(dept.ID)
+	saved, err := repo.FindByID(auth.WithSystem(context.Background()), dept.ID)
 	require.NoError(t, err)
 	assert.Equal(t, dept.ID, saved.ID)
 	assert.Equal(t, dept.Name, saved.Name)
@@ -48,7 +50,7 @@ func TestEntDepartmentRepo_Save_InvalidID(t *testing.T) {
 		Name: "Engineering",
 	}
 
-	err := repo.Save(dept)
+	err := repo.Save(auth.WithSystem(context.Background()), dept)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -64,7 +66,7 @@ func TestEntDepartmentRepo_FindByID(t *testing.T) {
 	dept := testutil.SeedTestDepartment(t, client, "Engineering")
 
 	// Test: Find department by ID
-	found, err := repo.FindByID(dept.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), dept.ID.String())
 
 	// Assert: Department found with correct data
 	require.NoError(t, err)
@@ -80,7 +82,7 @@ func TestEntDepartmentRepo_FindByID_NotFound(t *testing.T) {
 
 	// Test: Find non-existent department
 	nonExistentID := uuid.New().String()
-	found, err := repo.FindByID(nonExistentID)
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), nonExistentID)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -95,7 +97,7 @@ func TestEntDepartmentRepo_FindByID_InvalidID(t *testing.T) {
 	repo := NewEntDepartmentRepo(client)
 
 	// Test: Find with invalid UUID
-	found, err := repo.FindByID("invalid-uuid")
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), "invalid-uuid")
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -116,7 +118,7 @@ func TestEntDepartmentRepo_FindAll(t *testing.T) {
 	})
 
 	// Test: Find all departments
-	found, err := repo.FindAll()
+	found, err := repo.FindAll(auth.WithSystem(context.Background()))
 
 	// Assert: All departments found
 	require.NoError(t, err)
@@ -148,7 +150,7 @@ func TestEntDepartmentRepo_FindAll_Empty(t *testing.T) {
 	repo := NewEntDepartmentRepo(client)
 
 	// Test: Find all in empty database
-	found, err := repo.FindAll()
+	found, err := repo.FindAll(auth.WithSystem(context.Background()))
 
 	// Assert: Empty slice returned
 	require.NoError(t, err)
@@ -169,13 +171,13 @@ func TestEntDepartmentRepo_Update(t *testing.T) {
 		ID:   dept.ID.String(),
 		Name: "Engineering & Technology",
 	}
-	err := repo.Update(updated)
+	err := repo.Update(auth.WithSystem(context.Background()), updated)
 
 	// Assert: No error
 	require.NoError(t, err)
 
 	// Verify: Name was updated
-	found, err := repo.FindByID(dept.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), dept.ID.String())
 	require.NoError(t, err)
 	assert.Equal(t, "Engineering & Technology", found.Name)
 }
@@ -191,7 +193,7 @@ func TestEntDepartmentRepo_Update_NotFound(t *testing.T) {
 		ID:   uuid.New().String(),
 		Name: "Non-existent",
 	}
-	err := repo.Update(nonExistent)
+	err := repo.Update(auth.WithSystem(context.Background()), nonExistent)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -209,7 +211,7 @@ func TestEntDepartmentRepo_Update_InvalidID(t *testing.T) {
 		ID:   "invalid-uuid",
 		Name: "Invalid",
 	}
-	err := repo.Update(invalid)
+	err := repo.Update(auth.WithSystem(context.Background()), invalid)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -225,13 +227,13 @@ func TestEntDepartmentRepo_Delete(t *testing.T) {
 	dept := testutil.SeedTestDepartment(t, client, "Engineering")
 
 	// Test: Delete department
-	err := repo.Delete(dept.ID.String())
+	err := repo.Delete(auth.WithSystem(context.Background()), dept.ID.String())
 
 	// Assert: No error
 	require.NoError(t, err)
 
 	// Verify: Department no longer exists
-	found, err := repo.FindByID(dept.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), dept.ID.String())
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrNotFound)
 	assert.Nil(t, found)
@@ -245,7 +247,7 @@ func TestEntDepartmentRepo_Delete_NotFound(t *testing.T) {
 
 	// Test: Delete non-existent department
 	nonExistentID := uuid.New().String()
-	err := repo.Delete(nonExistentID)
+	err := repo.Delete(auth.WithSystem(context.Background()), nonExistentID)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -259,7 +261,7 @@ func TestEntDepartmentRepo_Delete_InvalidID(t *testing.T) {
 	repo := NewEntDepartmentRepo(client)
 
 	// Test: Delete with invalid UUID
-	err := repo.Delete("invalid-uuid")
+	err := repo.Delete(auth.WithSystem(context.Background()), "invalid-uuid")
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -3,11 +3,14 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gin-crud-api/internal/ent"
 	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/project"
 	"gin-crud-api/internal/logger"
 	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/outbox"
 
 	"github.com/google/uuid"
 )
@@ -23,14 +26,12 @@ func NewEntEmployeeRepo(client *ent.Client) EmployeeRepository {
 }
 
 // Save creates a new employee in the database
-func (r *EntEmployeeRepo) Save(emp *model.Employee) error {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) Save(ctx context.Context, emp *model.Employee) error {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().
 		Str("employee_id", emp.ID).
 		Str("name", emp.Name).
-		Str("email", emp.Email).
 		Str("department_id", emp.DepartmentID).
 		Msg("Saving employee to database")
 
@@ -53,14 +54,43 @@ func (r *EntEmployeeRepo) Save(emp *model.Employee) error {
 		return fmt.Errorf("invalid department ID: %w", err)
 	}
 
-	// Create employee using EntGo's type-safe builder
-	_, err = r.client.Employee.
-		Create().
-		SetID(empID).
-		SetName(emp.Name).
-		SetEmail(emp.Email).
-		SetDepartmentID(deptID).
-		Save(ctx)
+	hireDate, err := parseOptionalDate(emp.HireDate)
+	if err != nil {
+		return fmt.Errorf("invalid hire date: %w", err)
+	}
+	terminationDate, err := parseOptionalDate(emp.TerminationDate)
+	if err != nil {
+		return fmt.Errorf("invalid termination date: %w", err)
+	}
+
+	// Create the employee and record EmployeeHired in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		create := tx.Employee.
+			Create().
+			SetID(empID).
+			SetName(emp.Name).
+			SetEmail(emp.Email).
+			SetDepartmentID(deptID).
+			SetNillableJobTitle(emp.JobTitle).
+			SetNillablePhone(emp.Phone).
+			SetNillableLocation(emp.Location).
+			SetNillableHireDate(hireDate).
+			SetNillableTerminationDate(terminationDate)
+
+		// Unset enums fall back to the schema defaults (FULL_TIME, ACTIVE)
+		if emp.EmploymentType != "" {
+			create = create.SetEmploymentType(employee.EmploymentType(emp.EmploymentType))
+		}
+		if emp.Status != "" {
+			create = create.SetStatus(employee.Status(emp.Status))
+		}
+
+		entEmp, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, empID, outbox.EmployeeHired, outbox.NewEmployee(entEmployeeToModel(entEmp)))
+	})
 
 	if err != nil {
 		log.Error().
@@ -79,8 +109,7 @@ func (r *EntEmployeeRepo) Save(emp *model.Employee) error {
 }
 
 // FindByID retrieves an employee by their ID
-func (r *EntEmployeeRepo) FindByID(id string) (*model.Employee, error) {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) FindByID(ctx context.Context, id string) (*model.Employee, error) {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().
@@ -119,17 +148,11 @@ func (r *EntEmployeeRepo) FindByID(id string) (*model.Employee, error) {
 		Msg("Employee found successfully")
 
 	// Convert EntGo entity to GraphQL model
-	return &model.Employee{
-		ID:           entEmp.ID.String(),
-		Name:         entEmp.Name,
-		Email:        entEmp.Email,
-		DepartmentID: entEmp.DepartmentID.String(),
-	}, nil
+	return entEmployeeToModel(entEmp), nil
 }
 
 // FindAll retrieves all employees from the database
-func (r *EntEmployeeRepo) FindAll() ([]*model.Employee, error) {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) FindAll(ctx context.Context) ([]*model.Employee, error) {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().Msg("Finding all employees")
@@ -146,12 +169,7 @@ func (r *EntEmployeeRepo) FindAll() ([]*model.Employee, error) {
 	// Convert EntGo entity to GraphQL models
 	employees := make([]*model.Employee, len(entEmps))
 	for i, entEmp := range entEmps {
-		employees[i] = &model.Employee{
-			ID:           entEmp.ID.String(),
-			Name:         entEmp.Name,
-			Email:        entEmp.Email,
-			DepartmentID: entEmp.DepartmentID.String(),
-		}
+		employees[i] = entEmployeeToModel(entEmp)
 	}
 
 	log.Debug().
@@ -162,14 +180,12 @@ func (r *EntEmployeeRepo) FindAll() ([]*model.Employee, error) {
 }
 
 // Update updates an existing employee
-func (r *EntEmployeeRepo) Update(emp *model.Employee) error {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) Update(ctx context.Context, emp *model.Employee) error {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().
 		Str("employee_id", emp.ID).
 		Str("name", emp.Name).
-		Str("email", emp.Email).
 		Str("department_id", emp.DepartmentID).
 		Msg("Updating employee")
 
@@ -192,13 +208,63 @@ func (r *EntEmployeeRepo) Update(emp *model.Employee) error {
 		return fmt.Errorf("invalid department ID: %w", err)
 	}
 
-	// Update employee using EntGo's type-safe builder
-	err = r.client.Employee.
-		UpdateOneID(empID).
-		SetName(emp.Name).
-		SetEmail(emp.Email).
-		SetDepartmentID(deptID).
-		Exec(ctx)
+	hireDate, err := parseOptionalDate(emp.HireDate)
+	if err != nil {
+		return fmt.Errorf("invalid hire date: %w", err)
+	}
+	terminationDate, err := parseOptionalDate(emp.TerminationDate)
+	if err != nil {
+		return fmt.Errorf("invalid termination date: %w", err)
+	}
+
+	// Update the employee and record EmployeeUpdated (EmployeeTransferred on a
+	// department change) in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		previous, err := tx.Employee.Get(ctx, empID)
+		if err != nil {
+			return err
+		}
+
+		// Profile fields are written as given, so nil clears them
+		update := tx.Employee.
+			UpdateOneID(empID).
+			SetName(emp.Name).
+			SetEmail(emp.Email).
+			SetDepartmentID(deptID).
+			SetJobTitle(stringValue(emp.JobTitle)).
+			SetPhone(stringValue(emp.Phone)).
+			SetLocation(stringValue(emp.Location))
+
+		if hireDate != nil {
+			update = update.SetHireDate(*hireDate)
+		} else {
+			update = update.ClearHireDate()
+		}
+		if terminationDate != nil {
+			update = update.SetTerminationDate(*terminationDate)
+		} else {
+			update = update.ClearTerminationDate()
+		}
+		if emp.EmploymentType != "" {
+			update = update.SetEmploymentType(employee.EmploymentType(emp.EmploymentType))
+		}
+		if emp.Status != "" {
+			update = update.SetStatus(employee.Status(emp.Status))
+		}
+
+		entEmp, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		updated := entEmployeeToModel(entEmp)
+		if previous.DepartmentID == deptID {
+			return recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, empID, outbox.EmployeeUpdated, outbox.NewEmployee(updated))
+		}
+
+		event := outbox.NewEmployee(updated)
+		event.PreviousDepartmentID = previous.DepartmentID.String()
+		return recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, empID, outbox.EmployeeTransferred, event)
+	})
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -223,8 +289,7 @@ func (r *EntEmployeeRepo) Update(emp *model.Employee) error {
 }
 
 // Delete removes an employee from the database
-func (r *EntEmployeeRepo) Delete(id string) error {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) Delete(ctx context.Context, id string) error {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().
@@ -241,8 +306,17 @@ func (r *EntEmployeeRepo) Delete(id string) error {
 		return fmt.Errorf("invalid employee ID: %w", err)
 	}
 
-	// Delete employee using EntGo
-	err = r.client.Employee.DeleteOneID(uid).Exec(ctx)
+	// Delete the employee and record EmployeeDeleted with its last state in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		entEmp, err := tx.Employee.Get(ctx, uid)
+		if err != nil {
+			return err
+		}
+		if err := tx.Employee.DeleteOne(entEmp).Exec(ctx); err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, uid, outbox.EmployeeDeleted, outbox.NewEmployee(entEmployeeToModel(entEmp)))
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			log.Debug().
@@ -265,8 +339,7 @@ func (r *EntEmployeeRepo) Delete(id string) error {
 }
 
 // FindByDepartmentID retrieves all employees in a specific department
-func (r *EntEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Employee, error) {
-	ctx := context.Background()
+func (r *EntEmployeeRepo) FindByDepartmentID(ctx context.Context, deptID string) ([]*model.Employee, error) {
 	log := logger.WithComponent("EmployeeRepo")
 
 	log.Debug().
@@ -300,12 +373,7 @@ func (r *EntEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Employee,
 	// Convert EntGo entity to GraphQL models
 	employees := make([]*model.Employee, len(entEmps))
 	for i, entEmp := range entEmps {
-		employees[i] = &model.Employee{
-			ID:           entEmp.ID.String(),
-			Name:         entEmp.Name,
-			Email:        entEmp.Email,
-			DepartmentID: entEmp.DepartmentID.String(),
-		}
+		employees[i] = entEmployeeToModel(entEmp)
 	}
 
 	log.Debug().
@@ -315,3 +383,206 @@ func (r *EntEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Employee,
 
 	return employees, nil
 }
+
+// Terminate marks an employee as terminated and removes them from every project
+// that is not completed or cancelled (unassigning their open tasks there), in a
+// single transaction
+func (r *EntEmployeeRepo) Terminate(ctx context.Context, id string, terminationDate time.Time) error {
+	log := logger.WithComponent("EmployeeRepo")
+
+	log.Debug().
+		Str("employee_id", id).
+		Time("termination_date", terminationDate).
+		Msg("Terminating employee")
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("employee_id", id).
+			Msg("Invalid employee ID format")
+		return fmt.Errorf("invalid employee ID: %w", err)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Completed and cancelled projects keep the employee for historical reporting
+	activeIDs, err := tx.Project.
+		Query().
+		Where(
+			project.HasTeamMembersWith(employee.ID(uid)),
+			project.StatusNotIn(project.StatusCOMPLETED, project.StatusCANCELLED),
+		).
+		IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to find active projects: %w", err)
+	}
+
+	entEmp, err := tx.Employee.
+		UpdateOneID(uid).
+		SetStatus(employee.StatusTERMINATED).
+		SetTerminationDate(terminationDate).
+		RemoveProjectIDs(activeIDs...).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		log.Error().
+			Err(err).
+			Str("employee_id", id).
+			Msg("Database error while terminating employee")
+		return fmt.Errorf("failed to terminate employee: %w", err)
+	}
+
+	// Their open tasks in the projects they left are unassigned
+	if err := unassignNonMembers(ctx, tx, activeIDs...); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// Record the termination and each project the employee left
+	event := outbox.NewEmployee(entEmployeeToModel(entEmp))
+	for _, projID := range activeIDs {
+		event.LeftProjectIDs = append(event.LeftProjectIDs, projID.String())
+		membership := &outbox.Membership{ProjectID: projID.String(), EmployeeID: id}
+		if err := recordOutboxEvent(ctx, tx, outbox.AggregateProject, projID, outbox.ProjectMemberRemoved, membership); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, uid, outbox.EmployeeTerminated, event); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit termination: %w", err)
+	}
+
+	log.Debug().
+		Str("employee_id", id).
+		Int("projects_left", len(activeIDs)).
+		Msg("Employee terminated successfully")
+
+	return nil
+}
+
+// Rehire reactivates a terminated employee with a new hire date
+func (r *EntEmployeeRepo) Rehire(ctx context.Context, id string, hireDate time.Time) error {
+	log := logger.WithComponent("EmployeeRepo")
+
+	log.Debug().
+		Str("employee_id", id).
+		Time("hire_date", hireDate).
+		Msg("Rehiring employee")
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("employee_id", id).
+			Msg("Invalid employee ID format")
+		return fmt.Errorf("invalid employee ID: %w", err)
+	}
+
+	// Reactivate the employee and record EmployeeRehired in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		entEmp, err := tx.Employee.
+			UpdateOneID(uid).
+			SetStatus(employee.StatusACTIVE).
+			SetHireDate(hireDate).
+			ClearTerminationDate().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateEmployee, uid, outbox.EmployeeRehired, outbox.NewEmployee(entEmployeeToModel(entEmp)))
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		log.Error().
+			Err(err).
+			Str("employee_id", id).
+			Msg("Database error while rehiring employee")
+		return fmt.Errorf("failed to rehire employee: %w", err)
+	}
+
+	log.Debug().
+		Str("employee_id", id).
+		Msg("Employee rehired successfully")
+
+	return nil
+}
+
+// entEmployeeToModel converts an EntGo employee entity to a GraphQL model
+func entEmployeeToModel(entEmp *ent.Employee) *model.Employee {
+	emp := &model.Employee{
+		ID:             entEmp.ID.String(),
+		Name:           entEmp.Name,
+		Email:          entEmp.Email,
+		DepartmentID:   entEmp.DepartmentID.String(),
+		EmploymentType: model.EmploymentType(entEmp.EmploymentType),
+		Status:         model.EmploymentStatus(entEmp.Status),
+	}
+
+	// Set optional profile fields
+	if entEmp.JobTitle != "" {
+		emp.JobTitle = &entEmp.JobTitle
+	}
+	if entEmp.Phone != "" {
+		emp.Phone = &entEmp.Phone
+	}
+	if entEmp.Location != "" {
+		emp.Location = &entEmp.Location
+	}
+	if entEmp.HireDate != nil {
+		hireDate := entEmp.HireDate.Format("2006-01-02")
+		emp.HireDate = &hireDate
+	}
+	if entEmp.TerminationDate != nil {
+		terminationDate := entEmp.TerminationDate.Format("2006-01-02")
+		emp.TerminationDate = &terminationDate
+	}
+
+	return emp
+}
+
+// parseOptionalDate parses a YYYY-MM-DD date, returning nil for nil input
+func parseOptionalDate(s *string) (*time.Time, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseOptionalID parses an optional UUID, returning nil for nil input
+func parseOptionalID(s *string) (*uuid.UUID, error) {
+	if s == nil {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// stringValue dereferences an optional string, returning "" for nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
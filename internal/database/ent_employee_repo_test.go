@@ -1,8 +1,12 @@
 package database
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent/project"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/testutil"
 
@@ -28,13 +32,13 @@ func TestEntEmployeeRepo_Save(t *testing.T) {
 	}
 
 	// Test: Save employee
-	err := repo.Save(emp)
+	err := repo.Save(auth.WithSystem(context.Background()), emp)
 
 	// Assert: No error
 	require.NoError(t, err)
 
 	// Verify: Employee can be retrieved
-	saved, err := repo.FindByID(emp.ID)
+	saved, err := repo.FindByID(auth.WithSystem(context.Background()), emp.ID)
 	require.NoError(t, err)
 	assert.Equal(t, emp.ID, saved.ID)
 	assert.Equal(t, emp.Name, saved.Name)
@@ -58,7 +62,7 @@ func TestEntEmployeeRepo_Save_InvalidEmployeeID(t *testing.T) {
 	}
 
 	// Test: Save with invalid employee ID
-	err := repo.Save(emp)
+	err := repo.Save(auth.WithSystem(context.Background()), emp)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -79,7 +83,7 @@ func TestEntEmployeeRepo_Save_InvalidDepartmentID(t *testing.T) {
 	}
 
 	// Test: Save with invalid department ID
-	err := repo.Save(emp)
+	err := repo.Save(auth.WithSystem(context.Background()), emp)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -96,7 +100,7 @@ func TestEntEmployeeRepo_FindByID(t *testing.T) {
 	emp := testutil.SeedTestEmployee(t, client, "John Doe", "john.doe@example.com", dept.ID)
 
 	// Test: Find employee by ID
-	found, err := repo.FindByID(emp.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), emp.ID.String())
 
 	// Assert: Employee found with correct data
 	require.NoError(t, err)
@@ -114,7 +118,7 @@ func TestEntEmployeeRepo_FindByID_NotFound(t *testing.T) {
 
 	// Test: Find non-existent employee
 	nonExistentID := uuid.New().String()
-	found, err := repo.FindByID(nonExistentID)
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), nonExistentID)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -129,7 +133,7 @@ func TestEntEmployeeRepo_FindByID_InvalidID(t *testing.T) {
 	repo := NewEntEmployeeRepo(client)
 
 	// Test: Find with invalid UUID
-	found, err := repo.FindByID("invalid-uuid")
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), "invalid-uuid")
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -147,7 +151,7 @@ func TestEntEmployeeRepo_FindAll(t *testing.T) {
 	employees := testutil.SeedMultipleEmployees(t, client, dept.ID, 3)
 
 	// Test: Find all employees
-	found, err := repo.FindAll()
+	found, err := repo.FindAll(auth.WithSystem(context.Background()))
 
 	// Assert: All employees found
 	require.NoError(t, err)
@@ -170,7 +174,7 @@ func TestEntEmployeeRepo_FindAll_Empty(t *testing.T) {
 	repo := NewEntEmployeeRepo(client)
 
 	// Test: Find all in empty database
-	found, err := repo.FindAll()
+	found, err := repo.FindAll(auth.WithSystem(context.Background()))
 
 	// Assert: Empty slice returned
 	require.NoError(t, err)
@@ -195,13 +199,13 @@ func TestEntEmployeeRepo_Update(t *testing.T) {
 		Email:        "john.smith@example.com",
 		DepartmentID: dept2.ID.String(),
 	}
-	err := repo.Update(updated)
+	err := repo.Update(auth.WithSystem(context.Background()), updated)
 
 	// Assert: No error
 	require.NoError(t, err)
 
 	// Verify: Employee was updated
-	found, err := repo.FindByID(emp.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), emp.ID.String())
 	require.NoError(t, err)
 	assert.Equal(t, "John Smith", found.Name)
 	assert.Equal(t, "john.smith@example.com", found.Email)
@@ -223,7 +227,7 @@ func TestEntEmployeeRepo_Update_NotFound(t *testing.T) {
 		Email:        "non@example.com",
 		DepartmentID: dept.ID.String(),
 	}
-	err := repo.Update(nonExistent)
+	err := repo.Update(auth.WithSystem(context.Background()), nonExistent)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -245,7 +249,7 @@ func TestEntEmployeeRepo_Update_InvalidEmployeeID(t *testing.T) {
 		Email:        "invalid@example.com",
 		DepartmentID: dept.ID.String(),
 	}
-	err := repo.Update(invalid)
+	err := repo.Update(auth.WithSystem(context.Background()), invalid)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -268,7 +272,7 @@ func TestEntEmployeeRepo_Update_InvalidDepartmentID(t *testing.T) {
 		Email:        "john.doe@example.com",
 		DepartmentID: "invalid-uuid",
 	}
-	err := repo.Update(invalid)
+	err := repo.Update(auth.WithSystem(context.Background()), invalid)
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -285,13 +289,13 @@ func TestEntEmployeeRepo_Delete(t *testing.T) {
 	emp := testutil.SeedTestEmployee(t, client, "John Doe", "john.doe@example.com", dept.ID)
 
 	// Test: Delete employee
-	err := repo.Delete(emp.ID.String())
+	err := repo.Delete(auth.WithSystem(context.Background()), emp.ID.String())
 
 	// Assert: No error
 	require.NoError(t, err)
 
 	// Verify: Employee no longer exists
-	found, err := repo.FindByID(emp.ID.String())
+	found, err := repo.FindByID(auth.WithSystem(context.Background()), emp.ID.String())
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrNotFound)
 	assert.Nil(t, found)
@@ -305,7 +309,7 @@ func TestEntEmployeeRepo_Delete_NotFound(t *testing.T) {
 
 	// Test: Delete non-existent employee
 	nonExistentID := uuid.New().String()
-	err := repo.Delete(nonExistentID)
+	err := repo.Delete(auth.WithSystem(context.Background()), nonExistentID)
 
 	// Assert: Should return ErrNotFound
 	require.Error(t, err)
@@ -319,7 +323,7 @@ func TestEntEmployeeRepo_Delete_InvalidID(t *testing.T) {
 	repo := NewEntEmployeeRepo(client)
 
 	// Test: Delete with invalid UUID
-	err := repo.Delete("invalid-uuid")
+	err := repo.Delete(auth.WithSystem(context.Background()), "invalid-uuid")
 
 	// Assert: Should return error
 	require.Error(t, err)
@@ -344,7 +348,7 @@ func TestEntEmployeeRepo_FindByDepartmentID(t *testing.T) {
 	_ = testutil.SeedTestEmployee(t, client, "Alice Johnson", "alice@example.com", dept2.ID)
 
 	// Test: Find employees in dept1
-	found, err := repo.FindByDepartmentID(dept1.ID.String())
+	found, err := repo.FindByDepartmentID(auth.WithSystem(context.Background()), dept1.ID.String())
 
 	// Assert: 3 employees found
 	require.NoError(t, err)
@@ -374,7 +378,7 @@ func TestEntEmployeeRepo_FindByDepartmentID_Empty(t *testing.T) {
 	dept := testutil.SeedTestDepartment(t, client, "Engineering")
 
 	// Test: Find employees in empty department
-	found, err := repo.FindByDepartmentID(dept.ID.String())
+	found, err := repo.FindByDepartmentID(auth.WithSystem(context.Background()), dept.ID.String())
 
 	// Assert: Empty slice returned
 	require.NoError(t, err)
@@ -389,10 +393,115 @@ func TestEntEmployeeRepo_FindByDepartmentID_InvalidID(t *testing.T) {
 	repo := NewEntEmployeeRepo(client)
 
 	// Test: Find with invalid department UUID
-	found, err := repo.FindByDepartmentID("invalid-uuid")
+	found, err := repo.FindByDepartmentID(auth.WithSystem(context.Background()), "invalid-uuid")
 
 	// Assert: Should return error
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid department ID")
 	assert.Nil(t, found)
 }
+
+func TestEntEmployeeRepo_Save_ProfileFields(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntEmployeeRepo(client)
+
+	dept := testutil.SeedTestDepartment(t, client, "Engineering")
+
+	jobTitle, hireDate, phone := "Staff Engineer", "2021-03-15", "+1 555-0100"
+	emp := &model.Employee{
+		ID:             uuid.New().String(),
+		Name:           "John Doe",
+		Email:          "john.doe@example.com",
+		DepartmentID:   dept.ID.String(),
+		JobTitle:       &jobTitle,
+		EmploymentType: model.EmploymentTypeContractor,
+		HireDate:       &hireDate,
+		Phone:          &phone,
+	}
+	require.NoError(t, repo.Save(auth.WithSystem(context.Background()), emp))
+
+	saved, err := repo.FindByID(auth.WithSystem(context.Background()), emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &jobTitle, saved.JobTitle)
+	assert.Equal(t, model.EmploymentTypeContractor, saved.EmploymentType)
+	assert.Equal(t, model.EmploymentStatusActive, saved.Status) // Schema default
+	assert.Equal(t, &hireDate, saved.HireDate)
+	assert.Equal(t, &phone, saved.Phone)
+	assert.Nil(t, saved.Location)
+	assert.Nil(t, saved.TerminationDate)
+}
+
+func TestEntEmployeeRepo_Terminate(t *testing.T) {
+	// Setup: employee on one active and one completed project
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntEmployeeRepo(client)
+	ctx := auth.WithSystem(context.Background())
+
+	dept := testutil.SeedTestDepartment(t, client, "Engineering")
+	emp := testutil.SeedTestEmployee(t, client, "John Doe", "john@example.com", dept.ID)
+
+	start, end := time.Now(), time.Now().AddDate(0, 1, 0)
+	active := client.Project.Create().
+		SetName("Active").SetStartDate(start).SetEndDate(end).SetBudget(1000).
+		AddTeamMemberIDs(emp.ID).
+		SaveX(ctx)
+	completed := client.Project.Create().
+		SetName("Done").SetStatus(project.StatusCOMPLETED).SetStartDate(start).SetEndDate(end).SetBudget(1000).
+		AddTeamMemberIDs(emp.ID).
+		SaveX(ctx)
+
+	// Test: Terminate
+	err := repo.Terminate(ctx, emp.ID.String(), time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// Assert: status and date set, only completed project membership kept
+	found, err := repo.FindByID(ctx, emp.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusTerminated, found.Status)
+	require.NotNil(t, found.TerminationDate)
+	assert.Equal(t, "2025-06-30", *found.TerminationDate)
+
+	projectIDs := client.Employee.GetX(ctx, emp.ID).QueryProjects().IDsX(ctx)
+	assert.Equal(t, []uuid.UUID{completed.ID}, projectIDs)
+	assert.Zero(t, client.Project.GetX(ctx, active.ID).QueryTeamMembers().CountX(ctx))
+}
+
+func TestEntEmployeeRepo_Terminate_NotFound(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntEmployeeRepo(client)
+
+	// Test: Terminate non-existent employee
+	err := repo.Terminate(auth.WithSystem(context.Background()), uuid.New().String(), time.Now())
+
+	// Assert: Should return ErrNotFound
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEntEmployeeRepo_Rehire(t *testing.T) {
+	// Setup: terminated employee
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntEmployeeRepo(client)
+	ctx := auth.WithSystem(context.Background())
+
+	dept := testutil.SeedTestDepartment(t, client, "Engineering")
+	emp := testutil.SeedTestEmployee(t, client, "John Doe", "john@example.com", dept.ID)
+	require.NoError(t, repo.Terminate(ctx, emp.ID.String(), time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+	// Test: Rehire
+	err := repo.Rehire(ctx, emp.ID.String(), time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// Assert: active again with the new hire date
+	found, err := repo.FindByID(ctx, emp.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusActive, found.Status)
+	require.NotNil(t, found.HireDate)
+	assert.Equal(t, "2026-01-05", *found.HireDate)
+	assert.Nil(t, found.TerminationDate)
+}
@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/idempotencykey"
+	"gin-crud-api/internal/logger"
+
+	"github.com/google/uuid"
+)
+
+// errIdempotencyContention is returned when a key keeps changing hands while acquiring it
+var errIdempotencyContention = errors.New("idempotency key changed concurrently")
+
+// EntIdempotencyRepo implements IdempotencyRepository using EntGo
+type EntIdempotencyRepo struct {
+	client *ent.Client
+}
+
+// NewEntIdempotencyRepo creates a new idempotency repository using EntGo
+func NewEntIdempotencyRepo(client *ent.Client) IdempotencyRepository {
+	return &EntIdempotencyRepo{client: client}
+}
+
+// Acquire inserts rec, relying on the unique (principal, key, operation) index
+// so concurrent requests with the same key cannot both execute
+func (r *EntIdempotencyRepo) Acquire(ctx context.Context, rec *IdempotencyRecord) (*IdempotencyRecord, error) {
+	log := logger.WithComponent("IdempotencyRepo")
+
+	// Two rounds: an expired record found in the first one is deleted before retrying
+	for range 2 {
+		created, err := r.client.IdempotencyKey.
+			Create().
+			SetPrincipal(rec.Principal).
+			SetKey(rec.Key).
+			SetOperation(rec.Operation).
+			SetRequestHash(rec.RequestHash).
+			SetCreatedAt(rec.CreatedAt).
+			SetExpiresAt(rec.ExpiresAt).
+			Save(ctx)
+		if err == nil {
+			rec.ID = created.ID.String()
+			log.Debug().
+				Str("idempotency_id", rec.ID).
+				Str("operation", rec.Operation).
+				Msg("Idempotency key acquired")
+			return nil, nil
+		}
+		if !ent.IsConstraintError(err) {
+			log.Error().
+				Err(err).
+				Str("operation", rec.Operation).
+				Msg("Failed to save idempotency key")
+			return nil, fmt.Errorf("failed to save idempotency key: %w", err)
+		}
+
+		existing, err := r.client.IdempotencyKey.
+			Query().
+			Where(
+				idempotencykey.Principal(rec.Principal),
+				idempotencykey.Key(rec.Key),
+				idempotencykey.Operation(rec.Operation),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			// Released between our insert and query
+			continue
+		}
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("operation", rec.Operation).
+				Msg("Database error while finding idempotency key")
+			return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+		}
+
+		if existing.ExpiresAt.After(rec.CreatedAt) {
+			return toIdempotencyRecord(existing), nil
+		}
+
+		log.Debug().
+			Str("idempotency_id", existing.ID.String()).
+			Str("operation", rec.Operation).
+			Msg("Replacing expired idempotency key")
+		if err := r.client.IdempotencyKey.DeleteOneID(existing.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete expired idempotency key: %w", err)
+		}
+	}
+
+	return nil, errIdempotencyContention
+}
+
+// Complete marks an acquired record as completed with its response
+func (r *EntIdempotencyRepo) Complete(ctx context.Context, id string, response []byte) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid idempotency ID: %w", err)
+	}
+
+	err = r.client.IdempotencyKey.
+		UpdateOneID(uid).
+		SetStatus(idempotencykey.StatusCOMPLETED).
+		SetResponse(response).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release deletes an acquired record
+func (r *EntIdempotencyRepo) Release(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid idempotency ID: %w", err)
+	}
+
+	if err := r.client.IdempotencyKey.DeleteOneID(uid).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes every record that expired before now
+func (r *EntIdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	n, err := r.client.IdempotencyKey.
+		Delete().
+		Where(idempotencykey.ExpiresAtLTE(now)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return n, nil
+}
+
+// toIdempotencyRecord converts an EntGo entity to an IdempotencyRecord
+func toIdempotencyRecord(k *ent.IdempotencyKey) *IdempotencyRecord {
+	rec := &IdempotencyRecord{
+		ID:          k.ID.String(),
+		Principal:   k.Principal,
+		Key:         k.Key,
+		Operation:   k.Operation,
+		RequestHash: k.RequestHash,
+		Completed:   k.Status == idempotencykey.StatusCOMPLETED,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+	if k.Response != nil {
+		rec.Response = *k.Response
+	}
+	return rec
+}
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotencyRecord(key string, now time.Time) *IdempotencyRecord {
+	return &IdempotencyRecord{
+		Principal:   "principal:alice",
+		Key:         key,
+		Operation:   "createProject",
+		RequestHash: "hash",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+}
+
+func TestEntIdempotencyRepo_AcquireAndComplete(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntIdempotencyRepo(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	// First use of the key is acquired
+	rec := newIdempotencyRecord("key-1", now)
+	existing, err := repo.Acquire(ctx, rec)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	require.NotEmpty(t, rec.ID)
+
+	// A second use sees the in-progress record
+	existing, err = repo.Acquire(ctx, newIdempotencyRecord("key-1", now))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, rec.ID, existing.ID)
+	assert.False(t, existing.Completed)
+
+	// Once completed the stored response is returned
+	require.NoError(t, repo.Complete(ctx, rec.ID, []byte(`{"id":"1"}`)))
+	existing, err = repo.Acquire(ctx, newIdempotencyRecord("key-1", now))
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.True(t, existing.Completed)
+	assert.JSONEq(t, `{"id":"1"}`, string(existing.Response))
+	assert.Equal(t, "hash", existing.RequestHash)
+}
+
+func TestEntIdempotencyRepo_KeyedByPrincipalAndOperation(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntIdempotencyRepo(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := repo.Acquire(ctx, newIdempotencyRecord("key-1", now))
+	require.NoError(t, err)
+
+	other := newIdempotencyRecord("key-1", now)
+	other.Principal = "principal:bob"
+	existing, err := repo.Acquire(ctx, other)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	other = newIdempotencyRecord("key-1", now)
+	other.Operation = "deleteProject"
+	existing, err = repo.Acquire(ctx, other)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+}
+
+func TestEntIdempotencyRepo_Release(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntIdempotencyRepo(client)
+	ctx := context.Background()
+
+	rec := newIdempotencyRecord("key-1", time.Now())
+	_, err := repo.Acquire(ctx, rec)
+	require.NoError(t, err)
+	require.NoError(t, repo.Release(ctx, rec.ID))
+
+	// The key can be used again
+	existing, err := repo.Acquire(ctx, newIdempotencyRecord("key-1", time.Now()))
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	assert.ErrorIs(t, repo.Release(ctx, rec.ID), ErrNotFound)
+}
+
+func TestEntIdempotencyRepo_ExpiredKeyIsReplaced(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntIdempotencyRepo(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	rec := newIdempotencyRecord("key-1", now)
+	_, err := repo.Acquire(ctx, rec)
+	require.NoError(t, err)
+	require.NoError(t, repo.Complete(ctx, rec.ID, []byte(`true`)))
+
+	// Two hours later the record has expired and the key starts over
+	later := newIdempotencyRecord("key-1", now.Add(2*time.Hour))
+	existing, err := repo.Acquire(ctx, later)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.NotEqual(t, rec.ID, later.ID)
+}
+
+func TestEntIdempotencyRepo_DeleteExpired(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntIdempotencyRepo(client)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := repo.Acquire(ctx, newIdempotencyRecord("old", now.Add(-2*time.Hour)))
+	require.NoError(t, err)
+	_, err = repo.Acquire(ctx, newIdempotencyRecord("fresh", now))
+	require.NoError(t, err)
+
+	n, err := repo.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, client.IdempotencyKey.Query().CountX(ctx))
+}
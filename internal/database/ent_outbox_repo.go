@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/outboxevent"
+	"gin-crud-api/internal/ent/outboxoffset"
+	"gin-crud-api/internal/outbox"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/google/uuid"
+)
+
+// EntOutboxRepo reads the domain event outbox for the relay (outbox.Store)
+type EntOutboxRepo struct {
+	client *ent.Client
+}
+
+// NewEntOutboxRepo creates a new outbox repository using EntGo
+func NewEntOutboxRepo(client *ent.Client) *EntOutboxRepo {
+	return &EntOutboxRepo{client: client}
+}
+
+// Fetch returns up to limit events after position
+func (r *EntOutboxRepo) Fetch(ctx context.Context, after int64, limit int) ([]*outbox.Event, error) {
+	entEvents, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.IDGT(after)).
+		Order(outboxevent.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+	return entOutboxEventsToModel(entEvents), nil
+}
+
+// FetchSequences returns the events among sequences that were committed
+func (r *EntOutboxRepo) FetchSequences(ctx context.Context, sequences []int64) ([]*outbox.Event, error) {
+	entEvents, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.IDIn(sequences...)).
+		Order(outboxevent.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox events: %w", err)
+	}
+	return entOutboxEventsToModel(entEvents), nil
+}
+
+// Offset returns the last published position of consumer, 0 for a new consumer
+func (r *EntOutboxRepo) Offset(ctx context.Context, consumer string) (int64, error) {
+	entOffset, err := r.client.OutboxOffset.Get(ctx, consumer)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read offset: %w", err)
+	}
+	return entOffset.Position, nil
+}
+
+// CommitOffset stores the last published position of consumer
+func (r *EntOutboxRepo) CommitOffset(ctx context.Context, consumer string, position int64) error {
+	n, err := r.client.OutboxOffset.
+		Update().
+		Where(outboxoffset.ID(consumer)).
+		SetPosition(position).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit offset: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.OutboxOffset.
+		Create().
+		SetID(consumer).
+		SetPosition(position).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create offset: %w", err)
+	}
+	return nil
+}
+
+// recordOutboxEvent appends a domain event of the context's tenant inside the
+// transaction of the change it describes
+func recordOutboxEvent(ctx context.Context, tx *ent.Tx, aggregateType string, aggregateID uuid.UUID, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	err = tx.OutboxEvent.
+		Create().
+		SetType(eventType).
+		SetTenantID(tenant.FromContext(ctx)).
+		SetAggregateType(outboxevent.AggregateType(aggregateType)).
+		SetAggregateID(aggregateID).
+		SetPayload(payload).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// entOutboxEventsToModel converts EntGo outbox events to their published form
+func entOutboxEventsToModel(entEvents []*ent.OutboxEvent) []*outbox.Event {
+	events := make([]*outbox.Event, len(entEvents))
+	for i, entEvent := range entEvents {
+		events[i] = entOutboxEventToModel(entEvent)
+	}
+	return events
+}
+
+// entOutboxEventToModel converts an EntGo outbox event to its published form
+func entOutboxEventToModel(entEvent *ent.OutboxEvent) *outbox.Event {
+	return &outbox.Event{
+		Sequence:      entEvent.ID,
+		ID:            entEvent.EventID.String(),
+		Type:          entEvent.Type,
+		TenantID:      entEvent.TenantID,
+		AggregateType: string(entEvent.AggregateType),
+		AggregateID:   entEvent.AggregateID.String(),
+		OccurredAt:    entEvent.OccurredAt.UTC(),
+		Data:          entEvent.Payload,
+	}
+}
@@ -0,0 +1,184 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/outbox"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fetchOutbox returns every recorded event in order
+func fetchOutbox(t *testing.T, repo *EntOutboxRepo) []*outbox.Event {
+	events, err := repo.Fetch(auth.WithSystem(context.Background()), 0, 1000)
+	require.NoError(t, err)
+	return events
+}
+
+func eventTypes(events []*outbox.Event) []string {
+	types := make([]string, len(events))
+	for i, e := range events {
+		types[i] = e.Type
+	}
+	return types
+}
+
+func TestEntOutboxRepo_RecordsEventsInOrder(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntOutboxRepo(client)
+	deptRepo := NewEntDepartmentRepo(client)
+	empRepo := NewEntEmployeeRepo(client)
+	projRepo := NewEntProjectRepo(client)
+	ctx := auth.WithSystem(context.Background())
+
+	engineering := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	sales := &model.Department{ID: uuid.NewString(), Name: "Sales"}
+	emp := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: engineering.ID}
+	proj := &model.Project{
+		ID: uuid.NewString(), Name: "Apollo", Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+		StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	}
+
+	// Test
+	require.NoError(t, deptRepo.Save(ctx, engineering))
+	require.NoError(t, deptRepo.Save(ctx, sales))
+	require.NoError(t, empRepo.Save(ctx, emp))
+	require.NoError(t, projRepo.Save(ctx, proj))
+	require.NoError(t, projRepo.AddTeamMember(ctx, proj.ID, emp.ID))
+	require.NoError(t, projRepo.TransitionStatus(ctx, &model.ProjectStatusChange{
+		ProjectID: proj.ID, From: model.ProjectStatusPlanned, To: model.ProjectStatusActive,
+	}))
+	emp.DepartmentID = sales.ID
+	require.NoError(t, empRepo.Update(ctx, emp))
+	require.NoError(t, empRepo.Terminate(ctx, emp.ID, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+	// Assert
+	events := fetchOutbox(t, repo)
+	assert.Equal(t, []string{
+		outbox.DepartmentCreated,
+		outbox.DepartmentCreated,
+		outbox.EmployeeHired,
+		outbox.ProjectCreated,
+		outbox.ProjectMemberAdded,
+		outbox.ProjectStatusChanged,
+		outbox.EmployeeTransferred,
+		outbox.ProjectMemberRemoved,
+		outbox.EmployeeTerminated,
+	}, eventTypes(events))
+
+	transferred := events[6]
+	assert.Equal(t, outbox.AggregateEmployee, transferred.AggregateType)
+	assert.Equal(t, emp.ID, transferred.AggregateID)
+	var data outbox.Employee
+	require.NoError(t, json.Unmarshal(transferred.Data, &data))
+	assert.Equal(t, engineering.ID, data.PreviousDepartmentID)
+	assert.Equal(t, sales.ID, data.DepartmentID)
+	assert.Equal(t, "a***@example.com", data.Email, "events leave the service with PII masked")
+
+	var terminated outbox.Employee
+	require.NoError(t, json.Unmarshal(events[8].Data, &terminated))
+	assert.Equal(t, []string{proj.ID}, terminated.LeftProjectIDs)
+}
+
+func TestEntOutboxRepo_DeletesRecordLastState(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntOutboxRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	emp := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID}
+	require.NoError(t, NewEntDepartmentRepo(client).Save(ctx, dept))
+	require.NoError(t, NewEntEmployeeRepo(client).Save(ctx, emp))
+
+	// Test: employees go first, as in the deleteDepartment cascade
+	require.NoError(t, NewEntEmployeeRepo(client).Delete(ctx, emp.ID))
+	require.NoError(t, NewEntDepartmentRepo(client).Delete(ctx, dept.ID))
+
+	// Assert
+	events := fetchOutbox(t, repo)
+	assert.Equal(t, []string{
+		outbox.DepartmentCreated,
+		outbox.EmployeeHired,
+		outbox.EmployeeDeleted,
+		outbox.DepartmentDeleted,
+	}, eventTypes(events))
+
+	var deleted outbox.Department
+	require.NoError(t, json.Unmarshal(events[3].Data, &deleted))
+	assert.Equal(t, "Engineering", deleted.Name)
+}
+
+func TestEntOutboxRepo_FailedWriteRecordsNothing(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntOutboxRepo(client)
+
+	// Test: the department does not exist, so the insert fails
+	err := NewEntEmployeeRepo(client).Save(auth.WithSystem(context.Background()), &model.Employee{
+		ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: uuid.NewString(),
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.Empty(t, fetchOutbox(t, repo))
+}
+
+func TestEntOutboxRepo_FetchSequences(t *testing.T) {
+	// Setup: three events
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntOutboxRepo(client)
+	deptRepo := NewEntDepartmentRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	for _, name := range []string{"Engineering", "Sales", "Support"} {
+		require.NoError(t, deptRepo.Save(ctx, &model.Department{ID: uuid.NewString(), Name: name}))
+	}
+	all := fetchOutbox(t, repo)
+	require.Len(t, all, 3)
+
+	// Test: a missing sequence is left out
+	events, err := repo.FetchSequences(ctx, []int64{all[2].Sequence, all[0].Sequence, all[2].Sequence + 100})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, all[0].ID, events[0].ID)
+	assert.Equal(t, all[2].ID, events[1].ID)
+}
+
+func TestEntOutboxRepo_Offsets(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntOutboxRepo(client)
+	ctx := auth.WithSystem(context.Background())
+
+	// Test
+	position, err := repo.Offset(ctx, "audit")
+	require.NoError(t, err)
+	assert.Zero(t, position)
+
+	require.NoError(t, repo.CommitOffset(ctx, "audit", 3))
+	require.NoError(t, repo.CommitOffset(ctx, "audit", 7))
+	require.NoError(t, repo.CommitOffset(ctx, "search", 1))
+
+	// Assert
+	position, err = repo.Offset(ctx, "audit")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), position)
+	position, err = repo.Offset(ctx, "search")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), position)
+}
@@ -0,0 +1,248 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent/privacy"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// privacyFixture is Engineering > Platform plus an unrelated Sales department,
+// with a manager of Engineering and one employee in each department
+type privacyFixture struct {
+	deptRepo                     DepartmentRepository
+	empRepo                      EmployeeRepository
+	projRepo                     ProjectRepository
+	engineering, platform, sales *model.Department
+	manager, ann, bob            *model.Employee
+}
+
+func setupPrivacyFixture(t *testing.T) *privacyFixture {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+	ctx := auth.WithSystem(context.Background())
+
+	f := &privacyFixture{
+		deptRepo: NewEntDepartmentRepo(client),
+		empRepo:  NewEntEmployeeRepo(client),
+		projRepo: NewEntProjectRepo(client),
+	}
+	f.engineering = &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	f.platform = &model.Department{ID: uuid.NewString(), Name: "Platform", ParentID: &f.engineering.ID}
+	f.sales = &model.Department{ID: uuid.NewString(), Name: "Sales"}
+	for _, dept := range []*model.Department{f.engineering, f.platform, f.sales} {
+		require.NoError(t, f.deptRepo.Save(ctx, dept))
+	}
+
+	f.manager = &model.Employee{ID: uuid.NewString(), Name: "Mia", Email: "mia@example.com", DepartmentID: f.engineering.ID}
+	f.ann = &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: f.platform.ID}
+	f.bob = &model.Employee{ID: uuid.NewString(), Name: "Bob", Email: "bob@example.com", DepartmentID: f.sales.ID}
+	for _, emp := range []*model.Employee{f.manager, f.ann, f.bob} {
+		require.NoError(t, f.empRepo.Save(ctx, emp))
+	}
+	return f
+}
+
+// viewerContext returns a context whose viewer is the employee with roles
+func viewerContext(id string, roles ...string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{ID: id, Roles: roles})
+}
+
+func TestEntPrivacy_ManagerSeesDepartmentSubtree(t *testing.T) {
+	// Setup
+	f := setupPrivacyFixture(t)
+	ctx := viewerContext(f.manager.ID, auth.RoleManager)
+
+	// Test
+	emps, empErr := f.empRepo.FindAll(ctx)
+	depts, deptErr := f.deptRepo.FindAll(ctx)
+	_, findErr := f.empRepo.FindByID(ctx, f.bob.ID)
+
+	// Assert: Engineering and Platform, not Sales
+	require.NoError(t, empErr)
+	var names []string
+	for _, emp := range emps {
+		names = append(names, emp.Name)
+	}
+	assert.ElementsMatch(t, []string{"Mia", "Ann"}, names)
+
+	require.NoError(t, deptErr)
+	assert.Len(t, depts, 2)
+	assert.ErrorIs(t, findErr, ErrNotFound)
+
+	// Admins and the system see everyone
+	emps, err := f.empRepo.FindAll(viewerContext(uuid.NewString(), auth.RoleAdmin))
+	require.NoError(t, err)
+	assert.Len(t, emps, 3)
+	emps, err = f.empRepo.FindAll(auth.WithSystem(context.Background()))
+	require.NoError(t, err)
+	assert.Len(t, emps, 3)
+}
+
+func TestEntPrivacy_AnonymousIsDenied(t *testing.T) {
+	// Setup
+	f := setupPrivacyFixture(t)
+	ctx := context.Background()
+
+	// Test
+	_, findErr := f.empRepo.FindAll(ctx)
+	_, deptErr := f.deptRepo.FindAll(ctx)
+	_, projErr := f.projRepo.FindAll(ctx)
+	saveErr := f.deptRepo.Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Marketing"})
+	deleteErr := f.empRepo.Delete(ctx, f.bob.ID)
+
+	// Assert
+	assert.ErrorIs(t, findErr, privacy.Deny)
+	assert.ErrorIs(t, deptErr, privacy.Deny)
+	assert.ErrorIs(t, projErr, privacy.Deny)
+	assert.ErrorIs(t, saveErr, privacy.Deny)
+	assert.Error(t, deleteErr)
+
+	_, err := f.empRepo.FindByID(auth.WithSystem(context.Background()), f.bob.ID)
+	assert.NoError(t, err)
+}
+
+func TestEntPrivacy_ManagerChangesOnlyDepartmentSubtree(t *testing.T) {
+	// Setup
+	f := setupPrivacyFixture(t)
+	ctx := viewerContext(f.manager.ID, auth.RoleManager)
+
+	// Test
+	f.ann.Name = "Ann B"
+	updateErr := f.empRepo.Update(ctx, f.ann)
+	bobUpdateErr := f.empRepo.Update(ctx, &model.Employee{ID: f.bob.ID, Name: "Bob B", Email: f.bob.Email, DepartmentID: f.sales.ID})
+	bobDeleteErr := f.empRepo.Delete(ctx, f.bob.ID)
+	hireErr := f.empRepo.Save(ctx, &model.Employee{ID: uuid.NewString(), Name: "Cy", Email: "cy@example.com", DepartmentID: f.sales.ID})
+	f.ann.DepartmentID = f.sales.ID
+	transferErr := f.empRepo.Update(ctx, f.ann)
+	topLevelErr := f.deptRepo.Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Marketing"})
+	subDeptErr := f.deptRepo.Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Data", ParentID: &f.platform.ID})
+
+	// Assert
+	assert.NoError(t, updateErr)
+	assert.ErrorIs(t, bobUpdateErr, ErrNotFound)
+	assert.ErrorIs(t, bobDeleteErr, ErrNotFound)
+	assert.ErrorIs(t, hireErr, privacy.Deny)
+	assert.ErrorIs(t, transferErr, privacy.Deny)
+	assert.ErrorIs(t, topLevelErr, privacy.Deny)
+	assert.NoError(t, subDeptErr)
+
+	bob, err := f.empRepo.FindByID(auth.WithSystem(context.Background()), f.bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", bob.Name)
+}
+
+func TestEntPrivacy_ManagerMustBeEmployee(t *testing.T) {
+	// Setup
+	f := setupPrivacyFixture(t)
+
+	// Test
+	_, err := f.empRepo.FindAll(viewerContext("service-account", auth.RoleManager))
+
+	// Assert
+	assert.ErrorIs(t, err, privacy.Deny)
+}
+
+func TestEntPrivacy_ProjectLeadEditsOwnProjects(t *testing.T) {
+	// Setup: Ann leads Apollo, Bob leads Zeus
+	f := setupPrivacyFixture(t)
+	newProject := func(name string, leadID string) *model.Project {
+		proj := &model.Project{
+			ID: uuid.NewString(), Name: name, Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+			StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000, LeadID: &leadID,
+		}
+		require.NoError(t, f.projRepo.Save(auth.WithSystem(context.Background()), proj))
+		return proj
+	}
+	apollo := newProject("Apollo", f.ann.ID)
+	zeus := newProject("Zeus", f.bob.ID)
+	ctx := viewerContext(f.ann.ID, auth.RoleProjectLead)
+
+	// Test
+	apollo.Budget = 2000
+	ownErr := f.projRepo.Update(ctx, apollo)
+	memberErr := f.projRepo.AddTeamMember(ctx, apollo.ID, f.bob.ID)
+	zeus.Budget = 2000
+	otherErr := f.projRepo.Update(ctx, zeus)
+	otherMemberErr := f.projRepo.AddTeamMember(ctx, zeus.ID, f.ann.ID)
+	otherDeleteErr := f.projRepo.Delete(ctx, zeus.ID)
+	projects, findErr := f.projRepo.FindAll(ctx)
+
+	// Assert: leads still see every project
+	assert.NoError(t, ownErr)
+	assert.NoError(t, memberErr)
+	assert.ErrorIs(t, otherErr, ErrNotFound)
+	assert.Error(t, otherMemberErr)
+	assert.ErrorIs(t, otherDeleteErr, ErrNotFound)
+	require.NoError(t, findErr)
+	assert.Len(t, projects, 2)
+
+	found, err := f.projRepo.FindByID(auth.WithSystem(context.Background()), zeus.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1000.0, found.Budget)
+	assert.Empty(t, found.TeamMembers)
+}
+
+func TestEntPrivacy_ProjectLeadPlansOwnProjects(t *testing.T) {
+	// Setup: Ann leads Apollo, Bob leads Zeus, each with a milestone and a task
+	f := setupPrivacyFixture(t)
+	system := auth.WithSystem(context.Background())
+	plan := func(name string, leadID string) (*model.Project, *model.Milestone, *model.Task) {
+		proj := &model.Project{
+			ID: uuid.NewString(), Name: name, Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+			StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000, LeadID: &leadID,
+		}
+		require.NoError(t, f.projRepo.Save(system, proj))
+		ms := &model.Milestone{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Launch", Status: model.MilestoneStatusOpen}
+		require.NoError(t, f.projRepo.SaveMilestone(system, ms))
+		task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Design", Status: model.TaskStatusTodo}
+		require.NoError(t, f.projRepo.SaveTask(system, task))
+		return proj, ms, task
+	}
+	apollo, apolloMilestone, apolloTask := plan("Apollo", f.ann.ID)
+	zeus, zeusMilestone, zeusTask := plan("Zeus", f.bob.ID)
+	ctx := viewerContext(f.ann.ID, auth.RoleProjectLead)
+
+	// Test
+	apolloMilestone.Title = "Beta"
+	ownMilestoneErr := f.projRepo.UpdateMilestone(ctx, apolloMilestone)
+	apolloTask.Title = "Build"
+	ownTaskErr := f.projRepo.UpdateTask(ctx, apolloTask)
+	ownCreateErr := f.projRepo.SaveTask(ctx, &model.Task{ID: uuid.NewString(), ProjectID: apollo.ID, Title: "Test", Status: model.TaskStatusTodo})
+	zeusMilestone.Title = "Beta"
+	otherMilestoneErr := f.projRepo.UpdateMilestone(ctx, zeusMilestone)
+	zeusTask.Title = "Build"
+	otherTaskErr := f.projRepo.UpdateTask(ctx, zeusTask)
+	otherDeleteErr := f.projRepo.DeleteMilestone(ctx, zeusMilestone.ID)
+	otherCreateErr := f.projRepo.SaveMilestone(ctx, &model.Milestone{ID: uuid.NewString(), ProjectID: zeus.ID, Title: "GA", Status: model.MilestoneStatusOpen})
+	tasks, findErr := f.projRepo.FindTasksByProjectID(ctx, zeus.ID)
+	_, anonymousErr := f.projRepo.FindTasksByProjectID(context.Background(), apollo.ID)
+	anonymousCreateErr := f.projRepo.SaveMilestone(context.Background(), &model.Milestone{ID: uuid.NewString(), ProjectID: apollo.ID, Title: "GA", Status: model.MilestoneStatusOpen})
+
+	// Assert: leads still see the plans of every project
+	assert.NoError(t, ownMilestoneErr)
+	assert.NoError(t, ownTaskErr)
+	assert.NoError(t, ownCreateErr)
+	assert.ErrorIs(t, otherMilestoneErr, ErrNotFound)
+	assert.ErrorIs(t, otherTaskErr, ErrNotFound)
+	assert.ErrorIs(t, otherDeleteErr, ErrNotFound)
+	assert.ErrorIs(t, otherCreateErr, privacy.Deny)
+	require.NoError(t, findErr)
+	assert.Len(t, tasks, 1)
+	assert.ErrorIs(t, anonymousErr, privacy.Deny)
+	assert.ErrorIs(t, anonymousCreateErr, privacy.Deny)
+
+	found, err := f.projRepo.FindMilestoneByID(system, zeusMilestone.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Launch", found.Title)
+	task, err := f.projRepo.FindTaskByID(system, zeusTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Design", task.Title)
+}
@@ -0,0 +1,510 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/milestone"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+
+	"github.com/google/uuid"
+)
+
+// Milestone and task operations of EntProjectRepo
+
+// IsTeamMember reports whether an employee is on a project's team
+func (r *EntProjectRepo) IsTeamMember(ctx context.Context, projectID string, employeeID string) (bool, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return false, fmt.Errorf("invalid project ID: %w", err)
+	}
+	empID, err := uuid.Parse(employeeID)
+	if err != nil {
+		return false, fmt.Errorf("invalid employee ID: %w", err)
+	}
+
+	ok, err := r.client.Project.
+		Query().
+		Where(
+			project.ID(projID),
+			project.HasTeamMembersWith(employee.ID(empID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team membership: %w", err)
+	}
+	return ok, nil
+}
+
+// SaveMilestone creates a new milestone in its project
+func (r *EntProjectRepo) SaveMilestone(ctx context.Context, m *model.Milestone) error {
+	log := logger.WithComponent("ProjectRepo")
+
+	log.Debug().
+		Str("milestone_id", m.ID).
+		Str("project_id", m.ProjectID).
+		Msg("Saving milestone to database")
+
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return fmt.Errorf("invalid milestone ID: %w", err)
+	}
+	projID, err := uuid.Parse(m.ProjectID)
+	if err != nil {
+		return fmt.Errorf("invalid project ID: %w", err)
+	}
+	dueDate, err := parseOptionalDate(m.DueDate)
+	if err != nil {
+		return fmt.Errorf("invalid due date: %w", err)
+	}
+
+	create := r.client.Milestone.
+		Create().
+		SetID(id).
+		SetProjectID(projID).
+		SetTitle(m.Title).
+		SetNillableDueDate(dueDate)
+	if m.Status != "" {
+		create = create.SetStatus(milestone.Status(m.Status))
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		log.Error().
+			Err(err).
+			Str("milestone_id", m.ID).
+			Msg("Failed to save milestone to database")
+		return fmt.Errorf("failed to save milestone: %w", err)
+	}
+
+	return nil
+}
+
+// FindMilestoneByID retrieves a milestone by its ID
+func (r *EntProjectRepo) FindMilestoneByID(ctx context.Context, id string) (*model.Milestone, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid milestone ID: %w", err)
+	}
+
+	entMilestone, err := r.client.Milestone.Get(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find milestone: %w", err)
+	}
+
+	return entMilestoneToModel(entMilestone), nil
+}
+
+// FindMilestonesByProjectID retrieves a project's milestones ordered by due date
+func (r *EntProjectRepo) FindMilestonesByProjectID(ctx context.Context, projectID string) ([]*model.Milestone, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	entMilestones, err := r.client.Milestone.
+		Query().
+		Where(milestone.ProjectID(projID)).
+		Order(
+			milestone.ByDueDate(),
+			milestone.ByCreatedAt(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find milestones: %w", err)
+	}
+
+	milestones := make([]*model.Milestone, len(entMilestones))
+	for i, entMilestone := range entMilestones {
+		milestones[i] = entMilestoneToModel(entMilestone)
+	}
+	return milestones, nil
+}
+
+// UpdateMilestone updates an existing milestone; a nil due date clears it
+func (r *EntProjectRepo) UpdateMilestone(ctx context.Context, m *model.Milestone) error {
+	id, err := uuid.Parse(m.ID)
+	if err != nil {
+		return fmt.Errorf("invalid milestone ID: %w", err)
+	}
+	dueDate, err := parseOptionalDate(m.DueDate)
+	if err != nil {
+		return fmt.Errorf("invalid due date: %w", err)
+	}
+
+	update := r.client.Milestone.
+		UpdateOneID(id).
+		SetTitle(m.Title).
+		SetStatus(milestone.Status(m.Status))
+	if dueDate != nil {
+		update = update.SetDueDate(*dueDate)
+	} else {
+		update = update.ClearDueDate()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to update milestone: %w", err)
+	}
+	return nil
+}
+
+// DeleteMilestone deletes a milestone; its tasks are kept without a milestone
+func (r *EntProjectRepo) DeleteMilestone(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid milestone ID: %w", err)
+	}
+
+	if err := r.client.Milestone.DeleteOneID(uid).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete milestone: %w", err)
+	}
+	return nil
+}
+
+// SaveTask creates a new task in its project
+func (r *EntProjectRepo) SaveTask(ctx context.Context, t *model.Task) error {
+	log := logger.WithComponent("ProjectRepo")
+
+	log.Debug().
+		Str("task_id", t.ID).
+		Str("project_id", t.ProjectID).
+		Msg("Saving task to database")
+
+	id, err := uuid.Parse(t.ID)
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %w", err)
+	}
+	projID, err := uuid.Parse(t.ProjectID)
+	if err != nil {
+		return fmt.Errorf("invalid project ID: %w", err)
+	}
+	milestoneID, assigneeID, err := parseTaskRefs(t)
+	if err != nil {
+		return err
+	}
+	dueDate, err := parseOptionalDate(t.DueDate)
+	if err != nil {
+		return fmt.Errorf("invalid due date: %w", err)
+	}
+
+	// The assignee is checked in the transaction writing the task, so a
+	// concurrent removal from the team cannot slip in between
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkAssignee(ctx, tx, projID, assigneeID); err != nil {
+			return err
+		}
+		create := tx.Task.
+			Create().
+			SetID(id).
+			SetProjectID(projID).
+			SetTitle(t.Title).
+			SetNillableDueDate(dueDate).
+			SetNillableEstimateHours(t.EstimateHours).
+			SetNillableMilestoneID(milestoneID).
+			SetNillableAssigneeID(assigneeID)
+		if t.Status != "" {
+			create = create.SetStatus(task.Status(t.Status))
+		}
+		return create.Exec(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotTeamMember) {
+			return err
+		}
+		log.Error().
+			Err(err).
+			Str("task_id", t.ID).
+			Msg("Failed to save task to database")
+		return fmt.Errorf("failed to save task: %w", err)
+	}
+
+	return nil
+}
+
+// FindTaskByID retrieves a task by its ID
+func (r *EntProjectRepo) FindTaskByID(ctx context.Context, id string) (*model.Task, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid task ID: %w", err)
+	}
+
+	entTask, err := r.client.Task.Get(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	return entTaskToModel(entTask), nil
+}
+
+// FindTasksByProjectID retrieves all tasks of a project
+func (r *EntProjectRepo) FindTasksByProjectID(ctx context.Context, projectID string) ([]*model.Task, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+	return r.findTasks(ctx, task.ProjectID(projID))
+}
+
+// FindTasksByMilestoneID retrieves the tasks scheduled for a milestone
+func (r *EntProjectRepo) FindTasksByMilestoneID(ctx context.Context, milestoneID string) ([]*model.Task, error) {
+	uid, err := uuid.Parse(milestoneID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid milestone ID: %w", err)
+	}
+	return r.findTasks(ctx, task.MilestoneID(uid))
+}
+
+func (r *EntProjectRepo) findTasks(ctx context.Context, where ...predicate.Task) ([]*model.Task, error) {
+	entTasks, err := r.client.Task.
+		Query().
+		Where(where...).
+		Order(
+			task.ByDueDate(),
+			task.ByCreatedAt(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tasks: %w", err)
+	}
+
+	tasks := make([]*model.Task, len(entTasks))
+	for i, entTask := range entTasks {
+		tasks[i] = entTaskToModel(entTask)
+	}
+	return tasks, nil
+}
+
+// UpdateTask updates an existing task; nil optional fields are cleared
+func (r *EntProjectRepo) UpdateTask(ctx context.Context, t *model.Task) error {
+	id, err := uuid.Parse(t.ID)
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %w", err)
+	}
+	milestoneID, assigneeID, err := parseTaskRefs(t)
+	if err != nil {
+		return err
+	}
+	dueDate, err := parseOptionalDate(t.DueDate)
+	if err != nil {
+		return fmt.Errorf("invalid due date: %w", err)
+	}
+
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		current, err := tx.Task.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		// Only a new assignee is checked: done tasks keep assignees that left the team
+		if assigneeID != nil && (current.AssigneeID == nil || *current.AssigneeID != *assigneeID) {
+			if err := checkAssignee(ctx, tx, current.ProjectID, assigneeID); err != nil {
+				return err
+			}
+		}
+
+		update := tx.Task.
+			UpdateOneID(id).
+			SetTitle(t.Title).
+			SetStatus(task.Status(t.Status))
+		if dueDate != nil {
+			update = update.SetDueDate(*dueDate)
+		} else {
+			update = update.ClearDueDate()
+		}
+		if t.EstimateHours != nil {
+			update = update.SetEstimateHours(*t.EstimateHours)
+		} else {
+			update = update.ClearEstimateHours()
+		}
+		if milestoneID != nil {
+			update = update.SetMilestoneID(*milestoneID)
+		} else {
+			update = update.ClearMilestone()
+		}
+		if assigneeID != nil {
+			update = update.SetAssigneeID(*assigneeID)
+		} else {
+			update = update.ClearAssignee()
+		}
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		if errors.Is(err, ErrNotTeamMember) {
+			return err
+		}
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	return nil
+}
+
+// DeleteTask deletes a task by its ID
+func (r *EntProjectRepo) DeleteTask(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %w", err)
+	}
+
+	if err := r.client.Task.DeleteOneID(uid).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	return nil
+}
+
+// Progress returns the share of the project's tasks that are DONE
+func (r *EntProjectRepo) Progress(ctx context.Context, projectID string) (float64, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	// Count tasks per status in one query
+	var counts []struct {
+		Status task.Status `json:"status"`
+		Count  int         `json:"count"`
+	}
+	err = r.client.Task.
+		Query().
+		Where(task.ProjectID(projID)).
+		GroupBy(task.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	var done, total int
+	for _, c := range counts {
+		total += c.Count
+		if c.Status == task.StatusDONE {
+			done = c.Count
+		}
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return float64(done) / float64(total), nil
+}
+
+// checkAssignee returns ErrNotTeamMember unless the assignee (if any) is on the project's team
+func checkAssignee(ctx context.Context, tx *ent.Tx, projID uuid.UUID, assigneeID *uuid.UUID) error {
+	if assigneeID == nil {
+		return nil
+	}
+	ok, err := tx.Project.
+		Query().
+		Where(
+			project.ID(projID),
+			project.HasTeamMembersWith(employee.ID(*assigneeID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check team membership: %w", err)
+	}
+	if !ok {
+		return ErrNotTeamMember
+	}
+	return nil
+}
+
+// unassignNonMembers clears the assignee of the open tasks of the projects
+// whose assignee is no longer on the project's team. Done tasks keep their
+// assignee, like completed projects keep their team.
+func unassignNonMembers(ctx context.Context, tx *ent.Tx, projIDs ...uuid.UUID) error {
+	for _, projID := range projIDs {
+		_, err := tx.Task.
+			Update().
+			Where(
+				task.ProjectID(projID),
+				task.StatusNEQ(task.StatusDONE),
+				task.HasAssignee(),
+				task.Not(task.HasAssigneeWith(employee.HasProjectsWith(project.ID(projID)))),
+			).
+			ClearAssignee().
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to unassign tasks: %w", err)
+		}
+	}
+	return nil
+}
+
+// parseTaskRefs parses the optional milestone and assignee IDs of a task
+func parseTaskRefs(t *model.Task) (milestoneID, assigneeID *uuid.UUID, err error) {
+	if t.MilestoneID != nil {
+		id, err := uuid.Parse(*t.MilestoneID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid milestone ID: %w", err)
+		}
+		milestoneID = &id
+	}
+	if t.AssigneeID != nil {
+		id, err := uuid.Parse(*t.AssigneeID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid assignee ID: %w", err)
+		}
+		assigneeID = &id
+	}
+	return milestoneID, assigneeID, nil
+}
+
+// entMilestoneToModel converts an EntGo milestone entity to a GraphQL model
+func entMilestoneToModel(entMilestone *ent.Milestone) *model.Milestone {
+	m := &model.Milestone{
+		ID:        entMilestone.ID.String(),
+		ProjectID: entMilestone.ProjectID.String(),
+		Title:     entMilestone.Title,
+		Status:    model.MilestoneStatus(entMilestone.Status),
+	}
+	if entMilestone.DueDate != nil {
+		dueDate := entMilestone.DueDate.Format("2006-01-02")
+		m.DueDate = &dueDate
+	}
+	return m
+}
+
+// entTaskToModel converts an EntGo task entity to a GraphQL model
+func entTaskToModel(entTask *ent.Task) *model.Task {
+	t := &model.Task{
+		ID:            entTask.ID.String(),
+		ProjectID:     entTask.ProjectID.String(),
+		Title:         entTask.Title,
+		Status:        model.TaskStatus(entTask.Status),
+		EstimateHours: entTask.EstimateHours,
+	}
+	if entTask.DueDate != nil {
+		dueDate := entTask.DueDate.Format("2006-01-02")
+		t.DueDate = &dueDate
+	}
+	if entTask.MilestoneID != nil {
+		milestoneID := entTask.MilestoneID.String()
+		t.MilestoneID = &milestoneID
+	}
+	if entTask.AssigneeID != nil {
+		assigneeID := entTask.AssigneeID.String()
+		t.AssigneeID = &assigneeID
+	}
+	return t
+}
@@ -2,14 +2,17 @@ package database
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"gin-crud-api/internal/ent"
 	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/predicate"
 	"gin-crud-api/internal/ent/project"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
 
 	"github.com/google/uuid"
 )
@@ -25,8 +28,7 @@ func NewEntProjectRepo(client *ent.Client) ProjectRepository {
 }
 
 // Save creates a new project in the database
-func (r *EntProjectRepo) Save(proj *model.Project) error {
-	ctx := context.Background()
+func (r *EntProjectRepo) Save(ctx context.Context, proj *model.Project) error {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -63,37 +65,53 @@ func (r *EntProjectRepo) Save(proj *model.Project) error {
 		return fmt.Errorf("invalid end date format: %w", err)
 	}
 
-	// Create project using EntGo's type-safe builder
-	create := r.client.Project.
-		Create().
-		SetID(id).
-		SetName(proj.Name).
-		SetStatus(project.Status(proj.Status)).
-		SetPriority(project.Priority(proj.Priority)).
-		SetStartDate(startDate).
-		SetEndDate(endDate).
-		SetBudget(proj.Budget)
-
-	// Set optional description
-	if proj.Description != nil {
-		create = create.SetDescription(*proj.Description)
+	// Parse team member IDs if provided
+	teamMemberIDs := make([]uuid.UUID, len(proj.TeamMembers))
+	for i, member := range proj.TeamMembers {
+		memberID, err := uuid.Parse(member.ID)
+		if err != nil {
+			return fmt.Errorf("invalid team member ID %s: %w", member.ID, err)
+		}
+		teamMemberIDs[i] = memberID
+	}
+	leadID, err := parseOptionalID(proj.LeadID)
+	if err != nil {
+		return fmt.Errorf("invalid lead ID: %w", err)
 	}
 
-	// Add team members if provided
-	if len(proj.TeamMembers) > 0 {
-		teamMemberIDs := make([]uuid.UUID, len(proj.TeamMembers))
-		for i, member := range proj.TeamMembers {
-			memberID, err := uuid.Parse(member.ID)
-			if err != nil {
-				return fmt.Errorf("invalid team member ID %s: %w", member.ID, err)
-			}
-			teamMemberIDs[i] = memberID
+	// Create the project and record ProjectCreated in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkJoiningMembers(ctx, tx, nil, teamMemberIDs); err != nil {
+			return err
+		}
+
+		// Create project using EntGo's type-safe builder
+		create := tx.Project.
+			Create().
+			SetID(id).
+			SetName(proj.Name).
+			SetStatus(project.Status(proj.Status)).
+			SetPriority(project.Priority(proj.Priority)).
+			SetStartDate(startDate).
+			SetEndDate(endDate).
+			SetBudget(proj.Budget).
+			SetNillableLeadID(leadID).
+			AddTeamMemberIDs(teamMemberIDs...)
+
+		// Set optional description
+		if proj.Description != nil {
+			create = create.SetDescription(*proj.Description)
 		}
-		create = create.AddTeamMemberIDs(teamMemberIDs...)
-	}
 
-	_, err = create.Save(ctx)
+		if _, err := create.Save(ctx); err != nil {
+			return err
+		}
+		return recordProjectEvent(ctx, tx, id, outbox.ProjectCreated)
+	})
 	if err != nil {
+		if errors.Is(err, ErrEmployeeTerminated) {
+			return err
+		}
 		log.Error().
 			Err(err).
 			Str("project_id", proj.ID).
@@ -110,8 +128,7 @@ func (r *EntProjectRepo) Save(proj *model.Project) error {
 }
 
 // FindByID retrieves a project by its ID with team members
-func (r *EntProjectRepo) FindByID(id string) (*model.Project, error) {
-	ctx := context.Background()
+func (r *EntProjectRepo) FindByID(ctx context.Context, id string) (*model.Project, error) {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -159,8 +176,7 @@ func (r *EntProjectRepo) FindByID(id string) (*model.Project, error) {
 }
 
 // FindAll retrieves all projects from the database with team members
-func (r *EntProjectRepo) FindAll() ([]*model.Project, error) {
-	ctx := context.Background()
+func (r *EntProjectRepo) FindAll(ctx context.Context) ([]*model.Project, error) {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().Msg("Finding all projects")
@@ -192,8 +208,7 @@ func (r *EntProjectRepo) FindAll() ([]*model.Project, error) {
 }
 
 // Update updates an existing project
-func (r *EntProjectRepo) Update(proj *model.Project) error {
-	ctx := context.Background()
+func (r *EntProjectRepo) Update(ctx context.Context, proj *model.Project) error {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -221,43 +236,61 @@ func (r *EntProjectRepo) Update(proj *model.Project) error {
 		return fmt.Errorf("invalid end date format: %w", err)
 	}
 
-	// Update project using EntGo
-	update := r.client.Project.
-		UpdateOneID(id).
-		SetName(proj.Name).
-		SetStatus(project.Status(proj.Status)).
-		SetPriority(project.Priority(proj.Priority)).
-		SetStartDate(startDate).
-		SetEndDate(endDate).
-		SetBudget(proj.Budget)
+	// Parse team member IDs if provided
+	var teamMemberIDs []uuid.UUID
+	for _, member := range proj.TeamMembers {
+		memberID, err := uuid.Parse(member.ID)
+		if err != nil {
+			return fmt.Errorf("invalid team member ID %s: %w", member.ID, err)
+		}
+		teamMemberIDs = append(teamMemberIDs, memberID)
+	}
+	leadID, err := parseOptionalID(proj.LeadID)
+	if err != nil {
+		return fmt.Errorf("invalid lead ID: %w", err)
+	}
+
+	// Update the project and record ProjectUpdated in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		// Status is left alone; it only changes through TransitionStatus
+		update := tx.Project.
+			UpdateOneID(id).
+			SetName(proj.Name).
+			SetPriority(project.Priority(proj.Priority)).
+			SetStartDate(startDate).
+			SetEndDate(endDate).
+			SetBudget(proj.Budget)
+
+		// Set optional description
+		if proj.Description != nil {
+			update = update.SetDescription(*proj.Description)
+		} else {
+			update = update.ClearDescription()
+		}
+		if leadID != nil {
+			update = update.SetLeadID(*leadID)
+		} else {
+			update = update.ClearLead()
+		}
 
-	// Set optional description
-	if proj.Description != nil {
-		update = update.SetDescription(*proj.Description)
-	} else {
-		update = update.ClearDescription()
-	}
-
-	// Update team members if provided
-	if proj.TeamMembers != nil {
-		// Clear existing team members first
-		update = update.ClearTeamMembers()
-
-		// Add new team members
-		if len(proj.TeamMembers) > 0 {
-			teamMemberIDs := make([]uuid.UUID, len(proj.TeamMembers))
-			for i, member := range proj.TeamMembers {
-				memberID, err := uuid.Parse(member.ID)
-				if err != nil {
-					return fmt.Errorf("invalid team member ID %s: %w", member.ID, err)
-				}
-				teamMemberIDs[i] = memberID
+		// Replace team members if provided
+		if proj.TeamMembers != nil {
+			if err := checkJoiningMembers(ctx, tx, &id, teamMemberIDs); err != nil {
+				return err
 			}
-			update = update.AddTeamMemberIDs(teamMemberIDs...)
+			update = update.ClearTeamMembers().AddTeamMemberIDs(teamMemberIDs...)
 		}
-	}
 
-	_, err = update.Save(ctx)
+		if _, err := update.Save(ctx); err != nil {
+			return err
+		}
+		if proj.TeamMembers != nil {
+			if err := unassignNonMembers(ctx, tx, id); err != nil {
+				return err
+			}
+		}
+		return recordProjectEvent(ctx, tx, id, outbox.ProjectUpdated)
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			log.Debug().
@@ -265,6 +298,9 @@ func (r *EntProjectRepo) Update(proj *model.Project) error {
 				Msg("Project not found for update")
 			return ErrNotFound
 		}
+		if errors.Is(err, ErrEmployeeTerminated) {
+			return err
+		}
 		log.Error().
 			Err(err).
 			Str("project_id", proj.ID).
@@ -280,8 +316,7 @@ func (r *EntProjectRepo) Update(proj *model.Project) error {
 }
 
 // Delete deletes a project by its ID
-func (r *EntProjectRepo) Delete(id string) error {
-	ctx := context.Background()
+func (r *EntProjectRepo) Delete(ctx context.Context, id string) error {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -298,8 +333,17 @@ func (r *EntProjectRepo) Delete(id string) error {
 		return fmt.Errorf("invalid project ID: %w", err)
 	}
 
-	// Delete project using EntGo
-	err = r.client.Project.DeleteOneID(uid).Exec(ctx)
+	// Delete the project and record ProjectDeleted with its last state in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		entProj, err := tx.Project.Query().Where(project.ID(uid)).WithTeamMembers().Only(ctx)
+		if err != nil {
+			return err
+		}
+		if err := tx.Project.DeleteOne(entProj).Exec(ctx); err != nil {
+			return err
+		}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateProject, uid, outbox.ProjectDeleted, outbox.NewProject(entProjectToModel(entProj)))
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			log.Debug().
@@ -322,8 +366,7 @@ func (r *EntProjectRepo) Delete(id string) error {
 }
 
 // FindByStatus retrieves all projects with a specific status
-func (r *EntProjectRepo) FindByStatus(status model.ProjectStatus) ([]*model.Project, error) {
-	ctx := context.Background()
+func (r *EntProjectRepo) FindByStatus(ctx context.Context, status model.ProjectStatus) ([]*model.Project, error) {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -360,8 +403,7 @@ func (r *EntProjectRepo) FindByStatus(status model.ProjectStatus) ([]*model.Proj
 }
 
 // FindByEmployeeID retrieves all projects that an employee is working on
-func (r *EntProjectRepo) FindByEmployeeID(employeeID string) ([]*model.Project, error) {
-	ctx := context.Background()
+func (r *EntProjectRepo) FindByEmployeeID(ctx context.Context, employeeID string) ([]*model.Project, error) {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -410,8 +452,7 @@ func (r *EntProjectRepo) FindByEmployeeID(employeeID string) ([]*model.Project,
 }
 
 // AddTeamMember adds an employee to a project's team
-func (r *EntProjectRepo) AddTeamMember(projectID string, employeeID string) error {
-	ctx := context.Background()
+func (r *EntProjectRepo) AddTeamMember(ctx context.Context, projectID string, employeeID string) error {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -430,16 +471,29 @@ func (r *EntProjectRepo) AddTeamMember(projectID string, employeeID string) erro
 		return fmt.Errorf("invalid employee ID: %w", err)
 	}
 
-	// Add team member using EntGo
-	_, err = r.client.Project.
-		UpdateOneID(projID).
-		AddTeamMemberIDs(empID).
-		Save(ctx)
+	// Add the team member and record ProjectMemberAdded in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := checkJoiningMembers(ctx, tx, &projID, []uuid.UUID{empID}); err != nil {
+			return err
+		}
+		err := tx.Project.
+			UpdateOneID(projID).
+			AddTeamMemberIDs(empID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		membership := &outbox.Membership{ProjectID: projectID, EmployeeID: employeeID}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateProject, projID, outbox.ProjectMemberAdded, membership)
+	})
 
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ErrNotFound
 		}
+		if errors.Is(err, ErrEmployeeTerminated) {
+			return err
+		}
 		log.Error().
 			Err(err).
 			Str("project_id", projectID).
@@ -457,8 +511,7 @@ func (r *EntProjectRepo) AddTeamMember(projectID string, employeeID string) erro
 }
 
 // RemoveTeamMember removes an employee from a project's team
-func (r *EntProjectRepo) RemoveTeamMember(projectID string, employeeID string) error {
-	ctx := context.Background()
+func (r *EntProjectRepo) RemoveTeamMember(ctx context.Context, projectID string, employeeID string) error {
 	log := logger.WithComponent("ProjectRepo")
 
 	log.Debug().
@@ -477,11 +530,22 @@ func (r *EntProjectRepo) RemoveTeamMember(projectID string, employeeID string) e
 		return fmt.Errorf("invalid employee ID: %w", err)
 	}
 
-	// Remove team member using EntGo
-	_, err = r.client.Project.
-		UpdateOneID(projID).
-		RemoveTeamMemberIDs(empID).
-		Save(ctx)
+	// Remove the team member, unassign their open tasks of the project and
+	// record ProjectMemberRemoved in one transaction
+	err = withTx(ctx, r.client, func(tx *ent.Tx) error {
+		err := tx.Project.
+			UpdateOneID(projID).
+			RemoveTeamMemberIDs(empID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := unassignNonMembers(ctx, tx, projID); err != nil {
+			return err
+		}
+		membership := &outbox.Membership{ProjectID: projectID, EmployeeID: employeeID}
+		return recordOutboxEvent(ctx, tx, outbox.AggregateProject, projID, outbox.ProjectMemberRemoved, membership)
+	})
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -503,6 +567,39 @@ func (r *EntProjectRepo) RemoveTeamMember(projectID string, employeeID string) e
 	return nil
 }
 
+// checkJoiningMembers returns ErrEmployeeTerminated when one of the employees
+// joining the team of projID (nil for a new project) is terminated. Members
+// already on the team may stay, as completed projects keep their team.
+func checkJoiningMembers(ctx context.Context, tx *ent.Tx, projID *uuid.UUID, empIDs []uuid.UUID) error {
+	if len(empIDs) == 0 {
+		return nil
+	}
+	where := []predicate.Employee{
+		employee.IDIn(empIDs...),
+		employee.StatusEQ(employee.StatusTERMINATED),
+	}
+	if projID != nil {
+		where = append(where, employee.Not(employee.HasProjectsWith(project.ID(*projID))))
+	}
+	terminated, err := tx.Employee.Query().Where(where...).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check team members: %w", err)
+	}
+	if terminated {
+		return ErrEmployeeTerminated
+	}
+	return nil
+}
+
+// recordProjectEvent records an event carrying the project's current state and team
+func recordProjectEvent(ctx context.Context, tx *ent.Tx, id uuid.UUID, eventType string) error {
+	entProj, err := tx.Project.Query().Where(project.ID(id)).WithTeamMembers().Only(ctx)
+	if err != nil {
+		return err
+	}
+	return recordOutboxEvent(ctx, tx, outbox.AggregateProject, id, eventType, outbox.NewProject(entProjectToModel(entProj)))
+}
+
 // entProjectToModel converts an EntGo project entity to a GraphQL model
 func entProjectToModel(entProj *ent.Project) *model.Project {
 	proj := &model.Project{
@@ -519,17 +616,16 @@ func entProjectToModel(entProj *ent.Project) *model.Project {
 	if entProj.Description != "" {
 		proj.Description = &entProj.Description
 	}
+	if entProj.LeadID != nil {
+		leadID := entProj.LeadID.String()
+		proj.LeadID = &leadID
+	}
 
 	// Convert team members if loaded
 	if entProj.Edges.TeamMembers != nil {
 		proj.TeamMembers = make([]*model.Employee, len(entProj.Edges.TeamMembers))
 		for i, entEmp := range entProj.Edges.TeamMembers {
-			proj.TeamMembers[i] = &model.Employee{
-				ID:           entEmp.ID.String(),
-				Name:         entEmp.Name,
-				Email:        entEmp.Email,
-				DepartmentID: entEmp.DepartmentID.String(),
-			}
+			proj.TeamMembers[i] = entEmployeeToModel(entEmp)
 		}
 	}
 
@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"gin-crud-api/internal/ent/schema"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
+
+	"github.com/google/uuid"
+)
+
+// Status workflow operations of EntProjectRepo
+
+// TransitionStatus moves a project to a new status and records the change in one transaction
+func (r *EntProjectRepo) TransitionStatus(ctx context.Context, change *model.ProjectStatusChange) error {
+	log := logger.WithComponent("ProjectRepo")
+
+	log.Debug().
+		Str("project_id", change.ProjectID).
+		Str("from", string(change.From)).
+		Str("to", string(change.To)).
+		Msg("Transitioning project status")
+
+	projID, err := uuid.Parse(change.ProjectID)
+	if err != nil {
+		return fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Compare-and-set on the current status so concurrent transitions cannot both apply
+	n, err := tx.Project.
+		Update().
+		Where(
+			project.ID(projID),
+			project.StatusEQ(project.Status(change.From)),
+		).
+		SetStatus(project.Status(change.To)).
+		Save(schema.WithStatusTransition(ctx))
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update project status: %w", err)
+	}
+	if n == 0 {
+		_ = tx.Rollback()
+		exists, err := r.client.Project.Query().Where(project.ID(projID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find project: %w", err)
+		}
+		if !exists {
+			return ErrNotFound
+		}
+		return ErrStatusConflict
+	}
+
+	entChange, err := tx.ProjectStatusChange.
+		Create().
+		SetProjectID(projID).
+		SetFromStatus(projectstatuschange.FromStatus(change.From)).
+		SetToStatus(projectstatuschange.ToStatus(change.To)).
+		SetNillableReason(change.Reason).
+		SetNillableChangedBy(change.ChangedBy).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+
+	err = recordOutboxEvent(ctx, tx, outbox.AggregateProject, projID, outbox.ProjectStatusChanged, outbox.NewStatusChange(change))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit status change: %w", err)
+	}
+
+	change.ID = entChange.ID.String()
+	change.ChangedAt = entChange.ChangedAt.UTC().Format(time.RFC3339)
+
+	log.Debug().
+		Str("project_id", change.ProjectID).
+		Str("status", string(change.To)).
+		Msg("Project status transitioned successfully")
+
+	return nil
+}
+
+// FindStatusChanges retrieves a project's status history, oldest first
+func (r *EntProjectRepo) FindStatusChanges(ctx context.Context, projectID string) ([]*model.ProjectStatusChange, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	entChanges, err := r.client.ProjectStatusChange.
+		Query().
+		Where(projectstatuschange.ProjectID(projID)).
+		Order(ent.Asc(projectstatuschange.FieldChangedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find status changes: %w", err)
+	}
+
+	changes := make([]*model.ProjectStatusChange, len(entChanges))
+	for i, c := range entChanges {
+		changes[i] = &model.ProjectStatusChange{
+			ID:        c.ID.String(),
+			ProjectID: c.ProjectID.String(),
+			From:      model.ProjectStatus(c.FromStatus),
+			To:        model.ProjectStatus(c.ToStatus),
+			ChangedAt: c.ChangedAt.UTC().Format(time.RFC3339),
+		}
+		if c.Reason != "" {
+			changes[i].Reason = &c.Reason
+		}
+		if c.ChangedBy != "" {
+			changes[i].ChangedBy = &c.ChangedBy
+		}
+	}
+	return changes, nil
+}
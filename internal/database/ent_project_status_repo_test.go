@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/schema"
+	"gin-crud-api/internal/fixture"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectHook_RejectsDirectStatusChange(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	ctx := auth.WithSystem(context.Background())
+	proj := testutil.Create(t, testutil.NewFactory(t, client).Project())
+
+	// Test: plain update of the status
+	err := client.Project.UpdateOneID(proj.ID).SetStatus(project.StatusCOMPLETED).Exec(ctx)
+	assert.ErrorIs(t, err, schema.ErrDirectStatusChange)
+
+	// Other fields can still be updated
+	require.NoError(t, client.Project.UpdateOneID(proj.ID).SetName("Artemis").Exec(ctx))
+
+	// Workflow transitions are allowed
+	err = client.Project.UpdateOneID(proj.ID).SetStatus(project.StatusCOMPLETED).Exec(schema.WithStatusTransition(ctx))
+	require.NoError(t, err)
+}
+
+func TestEntProjectRepo_TransitionStatus(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntProjectRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	proj := testutil.Create(t, testutil.NewFactory(t, client).Project())
+
+	reason, by := "Waiting for budget", "user-1"
+	change := &model.ProjectStatusChange{
+		ProjectID: proj.ID.String(),
+		From:      model.ProjectStatusActive,
+		To:        model.ProjectStatusOnHold,
+		Reason:    &reason,
+		ChangedBy: &by,
+	}
+
+	// Test: transition
+	require.NoError(t, repo.TransitionStatus(ctx, change))
+	assert.NotEmpty(t, change.ID)
+	assert.NotEmpty(t, change.ChangedAt)
+
+	// Assert: status updated and history recorded
+	found, err := repo.FindByID(ctx, proj.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusOnHold, found.Status)
+
+	history, err := repo.FindStatusChanges(ctx, proj.ID.String())
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, change, history[0])
+
+	// Update keeps the status
+	found.Name = "Artemis"
+	require.NoError(t, repo.Update(ctx, found))
+	found, err = repo.FindByID(ctx, proj.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusOnHold, found.Status)
+}
+
+func TestEntProjectRepo_TransitionStatus_Conflict(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntProjectRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	proj := testutil.Create(t, testutil.NewFactory(t, client).Project())
+
+	// Test: the project is ACTIVE, not PLANNED
+	err := repo.TransitionStatus(ctx, &model.ProjectStatusChange{
+		ProjectID: proj.ID.String(),
+		From:      model.ProjectStatusPlanned,
+		To:        model.ProjectStatusActive,
+	})
+	assert.ErrorIs(t, err, ErrStatusConflict)
+
+	// Assert: nothing recorded
+	history, err := repo.FindStatusChanges(ctx, proj.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	// Unknown project
+	err = repo.TransitionStatus(ctx, &model.ProjectStatusChange{
+		ProjectID: uuid.New().String(),
+		From:      model.ProjectStatusActive,
+		To:        model.ProjectStatusCompleted,
+	})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEntProjectRepo_FindStatusChanges_OldestFirst(t *testing.T) {
+	// Setup: history recorded out of order
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntProjectRepo(client)
+	f := testutil.NewFactory(t, client)
+	proj := testutil.Create(t, f.Project())
+	resumed := testutil.Create(t, f.ProjectStatusChange(proj).
+		From(project.StatusON_HOLD).
+		To(project.StatusACTIVE).
+		ChangedAt(fixture.Epoch.AddDate(0, 0, 7)))
+	paused := testutil.Create(t, f.ProjectStatusChange(proj).
+		From(project.StatusACTIVE).
+		To(project.StatusON_HOLD).
+		Reason("Waiting for budget").
+		ChangedBy("user-1").
+		ChangedAt(fixture.Epoch))
+	testutil.Create(t, f.ProjectStatusChange(testutil.Create(t, f.Project())))
+
+	// Test
+	history, err := repo.FindStatusChanges(auth.WithSystem(context.Background()), proj.ID.String())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, paused.ID.String(), history[0].ID)
+	assert.Equal(t, model.ProjectStatusOnHold, history[0].To)
+	require.NotNil(t, history[0].Reason)
+	assert.Equal(t, "Waiting for budget", *history[0].Reason)
+	assert.Equal(t, resumed.ID.String(), history[1].ID)
+	assert.Equal(t, model.ProjectStatusActive, history[1].To)
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/logger"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Workload report of EntProjectRepo
+
+// Workload loads the employees with their overlapping ACTIVE projects in one
+// eager-loaded query, and counts those projects and sums the open task hours
+// in SQL, grouped by team member and by assignee
+func (r *EntProjectRepo) Workload(ctx context.Context, from, to time.Time) ([]*EmployeeLoad, error) {
+	log := logger.WithComponent("ProjectRepo")
+
+	log.Debug().
+		Time("from", from).
+		Time("to", to).
+		Msg("Computing workload")
+
+	// A project overlaps the window when it starts before its end and ends after its start
+	overlapping := []predicate.Project{
+		project.StatusEQ(project.StatusACTIVE),
+		project.StartDateLTE(to),
+		project.EndDateGTE(from),
+	}
+	entEmps, err := r.client.Employee.
+		Query().
+		Where(employee.StatusNEQ(employee.StatusTERMINATED)).
+		WithProjects(func(q *ent.ProjectQuery) {
+			q.Where(overlapping...).Order(project.ByName())
+		}).
+		Order(employee.ByName()).
+		All(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Database error while loading employee projects")
+		return nil, fmt.Errorf("failed to load employee projects: %w", err)
+	}
+
+	// Count the overlapping projects per team member over the join table
+	var counts []struct {
+		EmployeeID uuid.UUID `json:"employee_id"`
+		Count      int       `json:"count"`
+	}
+	err = r.client.Project.
+		Query().
+		Where(overlapping...).
+		Aggregate(
+			func(s *sql.Selector) string {
+				team := sql.Table(project.TeamMembersTable)
+				s.Join(team).On(s.C(project.FieldID), team.C(project.TeamMembersPrimaryKey[0]))
+				memberID := team.C(project.TeamMembersPrimaryKey[1])
+				s.GroupBy(memberID)
+				return sql.As(memberID, "employee_id")
+			},
+			func(s *sql.Selector) string {
+				return sql.As(sql.Count("*"), "count")
+			},
+		).
+		Scan(ctx, &counts)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Database error while counting employee projects")
+		return nil, fmt.Errorf("failed to count employee projects: %w", err)
+	}
+	countByEmployee := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		countByEmployee[c.EmployeeID] = c.Count
+	}
+
+	// Sum estimates of open tasks of ACTIVE projects due in the window per assignee
+	var hours []struct {
+		AssigneeID uuid.UUID `json:"assignee_id"`
+		Sum        float64   `json:"sum"`
+	}
+	err = r.client.Task.
+		Query().
+		Where(
+			task.AssigneeIDNotNil(),
+			task.StatusNEQ(task.StatusDONE),
+			task.EstimateHoursNotNil(),
+			task.DueDateGTE(from),
+			task.DueDateLTE(to),
+			task.HasProjectWith(project.StatusEQ(project.StatusACTIVE)),
+		).
+		GroupBy(task.FieldAssigneeID).
+		Aggregate(ent.Sum(task.FieldEstimateHours)).
+		Scan(ctx, &hours)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Database error while summing task hours")
+		return nil, fmt.Errorf("failed to sum task hours: %w", err)
+	}
+	hoursByEmployee := make(map[uuid.UUID]float64, len(hours))
+	for _, h := range hours {
+		hoursByEmployee[h.AssigneeID] = h.Sum
+	}
+
+	loads := make([]*EmployeeLoad, len(entEmps))
+	for i, entEmp := range entEmps {
+		load := &EmployeeLoad{
+			Employee:      entEmployeeToModel(entEmp),
+			ProjectCount:  countByEmployee[entEmp.ID],
+			OpenTaskHours: hoursByEmployee[entEmp.ID],
+		}
+		for _, entProj := range entEmp.Edges.Projects {
+			load.Projects = append(load.Projects, entProjectToModel(entProj))
+		}
+		loads[i] = load
+	}
+
+	log.Debug().
+		Int("employees", len(loads)).
+		Msg("Workload computed successfully")
+
+	return loads, nil
+}
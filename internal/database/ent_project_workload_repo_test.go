@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/fixture"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntProjectRepo_Workload(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntProjectRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	f := testutil.NewFactory(t, client)
+	dept := testutil.Create(t, f.Department())
+	ann := testutil.Create(t, f.Employee(dept).Name("ann"))
+	bob := testutil.Create(t, f.Employee(dept).Name("bob"))
+	gone := testutil.Create(t, f.Employee(dept).Name("cid").Terminated(fixture.Epoch))
+
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return d
+	}
+	newProject := func(name, start, end string, status project.Status) uuid.UUID {
+		p := client.Project.Create().
+			SetName(name).
+			SetStartDate(day(start)).
+			SetEndDate(day(end)).
+			SetBudget(1000).
+			SetStatus(status).
+			AddTeamMemberIDs(ann.ID, gone.ID).
+			SaveX(ctx)
+		return p.ID
+	}
+	apollo := newProject("Apollo", "2025-01-01", "2025-03-31", project.StatusACTIVE)
+	newProject("Gemini", "2025-03-01", "2025-12-31", project.StatusACTIVE)
+	newProject("Mercury", "2024-01-01", "2024-12-31", project.StatusACTIVE) // Before the window
+	newProject("Skylab", "2025-01-01", "2025-12-31", project.StatusON_HOLD) // Not active
+
+	// Open tasks due in the window count, DONE and later tasks do not
+	for _, tc := range []struct {
+		due    string
+		status task.Status
+		hours  float64
+	}{
+		{"2025-02-10", task.StatusTODO, 8},
+		{"2025-02-20", task.StatusIN_PROGRESS, 4.5},
+		{"2025-02-21", task.StatusDONE, 100},
+		{"2025-06-01", task.StatusTODO, 100},
+	} {
+		client.Task.Create().
+			SetProjectID(apollo).
+			SetTitle("Work").
+			SetDueDate(day(tc.due)).
+			SetStatus(tc.status).
+			SetEstimateHours(tc.hours).
+			SetAssigneeID(ann.ID).
+			ExecX(ctx)
+	}
+
+	// Test
+	loads, err := repo.Workload(ctx, day("2025-02-01"), day("2025-03-15"))
+	require.NoError(t, err)
+
+	// Assert: terminated employees are skipped, employees are ordered by name
+	require.Len(t, loads, 2)
+	assert.Equal(t, ann.ID.String(), loads[0].Employee.ID)
+	require.Len(t, loads[0].Projects, 2)
+	assert.Equal(t, apollo.String(), loads[0].Projects[0].ID)
+	assert.Equal(t, "Gemini", loads[0].Projects[1].Name)
+	assert.Equal(t, 2, loads[0].ProjectCount)
+	assert.Equal(t, 12.5, loads[0].OpenTaskHours)
+
+	assert.Equal(t, bob.ID.String(), loads[1].Employee.ID)
+	assert.Empty(t, loads[1].Projects)
+	assert.Zero(t, loads[1].ProjectCount)
+	assert.Zero(t, loads[1].OpenTaskHours)
+}
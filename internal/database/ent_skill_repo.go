@@ -0,0 +1,447 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+
+	"github.com/google/uuid"
+)
+
+// EntSkillRepo implements SkillRepository using EntGo
+type EntSkillRepo struct {
+	client *ent.Client
+}
+
+// NewEntSkillRepo creates a new skill repository using EntGo
+func NewEntSkillRepo(client *ent.Client) SkillRepository {
+	return &EntSkillRepo{client: client}
+}
+
+// Save creates a new skill in the catalog
+func (r *EntSkillRepo) Save(ctx context.Context, s *model.Skill) error {
+	log := logger.WithComponent("SkillRepo")
+
+	log.Debug().
+		Str("skill_id", s.ID).
+		Str("name", s.Name).
+		Msg("Saving skill to database")
+
+	id, err := uuid.Parse(s.ID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	_, err = r.client.Skill.
+		Create().
+		SetID(id).
+		SetName(s.Name).
+		SetCategory(stringValue(s.Category)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ErrAlreadyExists
+		}
+		log.Error().
+			Err(err).
+			Str("skill_id", s.ID).
+			Msg("Failed to save skill to database")
+		return fmt.Errorf("failed to save skill: %w", err)
+	}
+
+	log.Debug().
+		Str("skill_id", s.ID).
+		Msg("Skill saved successfully")
+
+	return nil
+}
+
+// FindByID retrieves a skill by its ID
+func (r *EntSkillRepo) FindByID(ctx context.Context, id string) (*model.Skill, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	entSkill, err := r.client.Skill.Get(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find skill: %w", err)
+	}
+
+	return entSkillToModel(entSkill), nil
+}
+
+// FindAll retrieves all skills ordered by name
+func (r *EntSkillRepo) FindAll(ctx context.Context) ([]*model.Skill, error) {
+	entSkills, err := r.client.Skill.
+		Query().
+		Order(skill.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find all skills: %w", err)
+	}
+
+	skills := make([]*model.Skill, len(entSkills))
+	for i, entSkill := range entSkills {
+		skills[i] = entSkillToModel(entSkill)
+	}
+	return skills, nil
+}
+
+// Update modifies an existing skill
+func (r *EntSkillRepo) Update(ctx context.Context, s *model.Skill) error {
+	log := logger.WithComponent("SkillRepo")
+
+	uid, err := uuid.Parse(s.ID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	err = r.client.Skill.
+		UpdateOneID(uid).
+		SetName(s.Name).
+		SetCategory(stringValue(s.Category)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			return ErrAlreadyExists
+		}
+		log.Error().
+			Err(err).
+			Str("skill_id", s.ID).
+			Msg("Failed to update skill in database")
+		return fmt.Errorf("failed to update skill: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a skill; employee and project rows cascade in the database
+func (r *EntSkillRepo) Delete(ctx context.Context, id string) error {
+	log := logger.WithComponent("SkillRepo")
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	if err := r.client.Skill.DeleteOneID(uid).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		log.Error().
+			Err(err).
+			Str("skill_id", id).
+			Msg("Failed to delete skill from database")
+		return fmt.Errorf("failed to delete skill: %w", err)
+	}
+
+	return nil
+}
+
+// SetEmployeeSkill adds a skill to an employee or changes its level
+func (r *EntSkillRepo) SetEmployeeSkill(ctx context.Context, employeeID string, skillID string, level int) error {
+	empID, err := uuid.Parse(employeeID)
+	if err != nil {
+		return fmt.Errorf("invalid employee ID: %w", err)
+	}
+	sklID, err := uuid.Parse(skillID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	// Update the existing level, otherwise add the skill
+	n, err := r.client.EmployeeSkill.
+		Update().
+		Where(employeeskill.EmployeeID(empID), employeeskill.SkillID(sklID)).
+		SetLevel(level).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set employee skill: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.EmployeeSkill.
+		Create().
+		SetEmployeeID(empID).
+		SetSkillID(sklID).
+		SetLevel(level).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set employee skill: %w", err)
+	}
+	return nil
+}
+
+// RemoveEmployeeSkill removes a skill from an employee
+func (r *EntSkillRepo) RemoveEmployeeSkill(ctx context.Context, employeeID string, skillID string) error {
+	empID, err := uuid.Parse(employeeID)
+	if err != nil {
+		return fmt.Errorf("invalid employee ID: %w", err)
+	}
+	sklID, err := uuid.Parse(skillID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	n, err := r.client.EmployeeSkill.
+		Delete().
+		Where(employeeskill.EmployeeID(empID), employeeskill.SkillID(sklID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove employee skill: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindEmployeeSkills retrieves an employee's skills ordered by skill name
+func (r *EntSkillRepo) FindEmployeeSkills(ctx context.Context, employeeID string) ([]*model.EmployeeSkill, error) {
+	empID, err := uuid.Parse(employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid employee ID: %w", err)
+	}
+
+	rows, err := r.client.EmployeeSkill.
+		Query().
+		Where(employeeskill.EmployeeID(empID)).
+		WithSkill().
+		Order(employeeskill.BySkillField(skill.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find employee skills: %w", err)
+	}
+
+	skills := make([]*model.EmployeeSkill, len(rows))
+	for i, row := range rows {
+		skills[i] = &model.EmployeeSkill{
+			Skill: entSkillToModel(row.Edges.Skill),
+			Level: row.Level,
+		}
+	}
+	return skills, nil
+}
+
+// SetProjectSkill requires a skill for a project or changes its minimum level
+func (r *EntSkillRepo) SetProjectSkill(ctx context.Context, projectID string, skillID string, minLevel int) error {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("invalid project ID: %w", err)
+	}
+	sklID, err := uuid.Parse(skillID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	// Update the existing requirement, otherwise add it
+	n, err := r.client.ProjectSkill.
+		Update().
+		Where(projectskill.ProjectID(projID), projectskill.SkillID(sklID)).
+		SetMinLevel(minLevel).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set project skill: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+
+	err = r.client.ProjectSkill.
+		Create().
+		SetProjectID(projID).
+		SetSkillID(sklID).
+		SetMinLevel(minLevel).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set project skill: %w", err)
+	}
+	return nil
+}
+
+// RemoveProjectSkill stops requiring a skill for a project
+func (r *EntSkillRepo) RemoveProjectSkill(ctx context.Context, projectID string, skillID string) error {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return fmt.Errorf("invalid project ID: %w", err)
+	}
+	sklID, err := uuid.Parse(skillID)
+	if err != nil {
+		return fmt.Errorf("invalid skill ID: %w", err)
+	}
+
+	n, err := r.client.ProjectSkill.
+		Delete().
+		Where(projectskill.ProjectID(projID), projectskill.SkillID(sklID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove project skill: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindProjectSkills retrieves a project's required skills ordered by skill name
+func (r *EntSkillRepo) FindProjectSkills(ctx context.Context, projectID string) ([]*model.RequiredSkill, error) {
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	rows, err := r.client.ProjectSkill.
+		Query().
+		Where(projectskill.ProjectID(projID)).
+		WithSkill().
+		Order(projectskill.BySkillField(skill.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find project skills: %w", err)
+	}
+
+	skills := make([]*model.RequiredSkill, len(rows))
+	for i, row := range rows {
+		skills[i] = &model.RequiredSkill{
+			Skill:    entSkillToModel(row.Edges.Skill),
+			MinLevel: row.MinLevel,
+		}
+	}
+	return skills, nil
+}
+
+// SuggestTeamMembers ranks candidates for a project's team. The score is the
+// average over the required skills of min(level, minLevel)/minLevel, so each
+// skill counts equally with partial credit below the minimum; it is 1 when
+// the project requires no skills. Ties go to the employee on fewer current
+// projects (team_members of projects not COMPLETED or CANCELLED), then by name.
+func (r *EntSkillRepo) SuggestTeamMembers(ctx context.Context, projectID string, limit int) ([]*model.TeamMemberSuggestion, error) {
+	log := logger.WithComponent("SkillRepo")
+
+	projID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	required, err := r.FindProjectSkills(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	skillIDs := make([]uuid.UUID, len(required))
+	for i, req := range required {
+		skillIDs[i] = uuid.MustParse(req.Skill.ID)
+	}
+
+	// Candidates: not on the team, not terminated and, when the project
+	// requires skills, with at least one of them
+	query := r.client.Employee.
+		Query().
+		Where(
+			employee.StatusNEQ(employee.StatusTERMINATED),
+			employee.Not(employee.HasProjectsWith(project.ID(projID))),
+		).
+		WithEmployeeSkills(func(q *ent.EmployeeSkillQuery) {
+			q.Where(employeeskill.SkillIDIn(skillIDs...))
+		}).
+		WithProjects(func(q *ent.ProjectQuery) {
+			q.Where(project.StatusNotIn(project.StatusCOMPLETED, project.StatusCANCELLED))
+		})
+	if len(skillIDs) > 0 {
+		query = query.Where(employee.HasEmployeeSkillsWith(employeeskill.SkillIDIn(skillIDs...)))
+	}
+
+	candidates, err := query.All(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("project_id", projectID).
+			Msg("Database error while finding team member candidates")
+		return nil, fmt.Errorf("failed to find candidates: %w", err)
+	}
+
+	suggestions := make([]*model.TeamMemberSuggestion, len(candidates))
+	for i, candidate := range candidates {
+		levels := make(map[string]int, len(candidate.Edges.EmployeeSkills))
+		for _, es := range candidate.Edges.EmployeeSkills {
+			levels[es.SkillID.String()] = es.Level
+		}
+
+		suggestion := &model.TeamMemberSuggestion{
+			Employee:        entEmployeeToModel(candidate),
+			Score:           1,
+			MatchedSkills:   []*model.EmployeeSkill{},
+			MissingSkills:   []*model.RequiredSkill{},
+			CurrentProjects: len(candidate.Edges.Projects),
+		}
+		if len(required) > 0 {
+			var total float64
+			for _, req := range required {
+				level := levels[req.Skill.ID]
+				if level >= req.MinLevel {
+					total++
+					suggestion.MatchedSkills = append(suggestion.MatchedSkills, &model.EmployeeSkill{
+						Skill: req.Skill,
+						Level: level,
+					})
+					continue
+				}
+				total += float64(level) / float64(req.MinLevel)
+				suggestion.MissingSkills = append(suggestion.MissingSkills, req)
+			}
+			suggestion.Score = total / float64(len(required))
+		}
+		suggestions[i] = suggestion
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.CurrentProjects != b.CurrentProjects {
+			return a.CurrentProjects < b.CurrentProjects
+		}
+		return a.Employee.Name < b.Employee.Name
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	log.Debug().
+		Str("project_id", projectID).
+		Int("candidates", len(candidates)).
+		Int("count", len(suggestions)).
+		Msg("Team members suggested")
+
+	return suggestions, nil
+}
+
+// entSkillToModel converts an EntGo skill entity to a GraphQL model
+func entSkillToModel(entSkill *ent.Skill) *model.Skill {
+	s := &model.Skill{
+		ID:   entSkill.ID.String(),
+		Name: entSkill.Name,
+	}
+	if entSkill.Category != "" {
+		s.Category = &entSkill.Category
+	}
+	return s
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/fixture"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntSkillRepo_SaveDuplicateName(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntSkillRepo(client)
+	f := testutil.NewFactory(t, client)
+	testutil.Create(t, f.Skill().Name("Go"))
+
+	// Test
+	err := repo.Save(auth.WithSystem(context.Background()), &model.Skill{ID: uuid.NewString(), Name: "Go"})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+}
+
+func TestEntSkillRepo_EmployeeSkills(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntSkillRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	f := testutil.NewFactory(t, client)
+	emp := testutil.Create(t, f.Employee(testutil.Create(t, f.Department())))
+	golang := testutil.Create(t, f.Skill().Name("Go"))
+	sql := testutil.Create(t, f.Skill().Name("SQL"))
+
+	// Test: set twice to change the level
+	require.NoError(t, repo.SetEmployeeSkill(ctx, emp.ID.String(), sql.ID.String(), 2))
+	require.NoError(t, repo.SetEmployeeSkill(ctx, emp.ID.String(), golang.ID.String(), 3))
+	require.NoError(t, repo.SetEmployeeSkill(ctx, emp.ID.String(), golang.ID.String(), 5))
+
+	// Assert: ordered by skill name
+	skills, err := repo.FindEmployeeSkills(ctx, emp.ID.String())
+	require.NoError(t, err)
+	require.Len(t, skills, 2)
+	assert.Equal(t, "Go", skills[0].Skill.Name)
+	assert.Equal(t, 5, skills[0].Level)
+	assert.Equal(t, "SQL", skills[1].Skill.Name)
+
+	// Removing a skill twice reports not found
+	require.NoError(t, repo.RemoveEmployeeSkill(ctx, emp.ID.String(), sql.ID.String()))
+	assert.ErrorIs(t, repo.RemoveEmployeeSkill(ctx, emp.ID.String(), sql.ID.String()), ErrNotFound)
+
+	// Deleting the skill removes it from the employee
+	require.NoError(t, repo.Delete(ctx, golang.ID.String()))
+	skills, err = repo.FindEmployeeSkills(ctx, emp.ID.String())
+	require.NoError(t, err)
+	assert.Empty(t, skills)
+}
+
+func TestEntSkillRepo_SuggestTeamMembers(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntSkillRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	f := testutil.NewFactory(t, client)
+	dept := testutil.Create(t, f.Department())
+	golang := testutil.Create(t, f.Skill().Name("Go"))
+	sql := testutil.Create(t, f.Skill().Name("SQL"))
+
+	proj := testutil.Create(t, f.Project())
+	other := testutil.Create(t, f.Project())
+	testutil.Create(t, f.ProjectSkill(proj, golang).MinLevel(4))
+	testutil.Create(t, f.ProjectSkill(proj, sql).MinLevel(2))
+
+	// ann and bob match fully, bob is busier; cid is below the Go minimum
+	ann := testutil.Create(t, f.Employee(dept).Name("ann"))
+	bob := testutil.Create(t, f.Employee(dept).Name("bob"))
+	cid := testutil.Create(t, f.Employee(dept).Name("cid"))
+	for _, e := range []*ent.Employee{ann, bob} {
+		testutil.Create(t, f.EmployeeSkill(e, golang).Level(5))
+		testutil.Create(t, f.EmployeeSkill(e, sql).Level(2))
+	}
+	testutil.Create(t, f.EmployeeSkill(cid, golang).Level(2))
+	require.NoError(t, f.AddTeamMembers(ctx, other, bob))
+
+	// Not suggested: team members, terminated employees and employees without required skills
+	member := testutil.Create(t, f.Employee(dept).Name("dan"))
+	testutil.Create(t, f.EmployeeSkill(member, golang).Level(5))
+	require.NoError(t, f.AddTeamMembers(ctx, proj, member))
+	gone := testutil.Create(t, f.Employee(dept).Name("eve").Terminated(fixture.Epoch))
+	testutil.Create(t, f.EmployeeSkill(gone, golang).Level(5))
+	testutil.Create(t, f.Employee(dept).Name("fay"))
+
+	// Test
+	suggestions, err := repo.SuggestTeamMembers(ctx, proj.ID.String(), 10)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, suggestions, 3)
+	assert.Equal(t, "ann", suggestions[0].Employee.Name)
+	assert.Equal(t, 1.0, suggestions[0].Score)
+	assert.Equal(t, 0, suggestions[0].CurrentProjects)
+	assert.Len(t, suggestions[0].MatchedSkills, 2)
+	assert.Empty(t, suggestions[0].MissingSkills)
+
+	assert.Equal(t, "bob", suggestions[1].Employee.Name)
+	assert.Equal(t, 1, suggestions[1].CurrentProjects)
+
+	// Go at 2 of 4 is half credit, SQL is missing
+	assert.Equal(t, "cid", suggestions[2].Employee.Name)
+	assert.InDelta(t, 0.25, suggestions[2].Score, 1e-9)
+	assert.Empty(t, suggestions[2].MatchedSkills)
+	assert.Len(t, suggestions[2].MissingSkills, 2)
+
+	// The limit keeps the best candidates
+	suggestions, err = repo.SuggestTeamMembers(ctx, proj.ID.String(), 1)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "ann", suggestions[0].Employee.Name)
+}
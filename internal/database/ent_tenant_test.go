@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/tenant"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntRepos_CrossTenantReadsAreImpossible(t *testing.T) {
+	// Setup: a department, employee, project and task owned by acme
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	deptRepo := NewEntDepartmentRepo(client)
+	empRepo := NewEntEmployeeRepo(client)
+	projRepo := NewEntProjectRepo(client)
+	acme := tenant.NewContext(auth.WithSystem(context.Background()), "acme")
+	globex := tenant.NewContext(auth.WithSystem(context.Background()), "globex")
+
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	emp := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID}
+	proj := &model.Project{
+		ID: uuid.NewString(), Name: "Apollo", Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+		StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	}
+	task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Kickoff"}
+	require.NoError(t, deptRepo.Save(acme, dept))
+	require.NoError(t, empRepo.Save(acme, emp))
+	require.NoError(t, projRepo.Save(acme, proj))
+	require.NoError(t, projRepo.SaveTask(acme, task))
+	require.NoError(t, projRepo.AddTeamMember(acme, proj.ID, emp.ID))
+
+	// Test & Assert: globex sees nothing by ID
+	_, err := deptRepo.FindByID(globex, dept.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = empRepo.FindByID(globex, emp.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = projRepo.FindByID(globex, proj.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = projRepo.FindTaskByID(globex, task.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// ...nor in lists and lookups
+	depts, err := deptRepo.FindAll(globex)
+	require.NoError(t, err)
+	assert.Empty(t, depts)
+	emps, err := empRepo.FindByDepartmentID(globex, dept.ID)
+	require.NoError(t, err)
+	assert.Empty(t, emps)
+	projs, err := projRepo.FindByEmployeeID(globex, emp.ID)
+	require.NoError(t, err)
+	assert.Empty(t, projs)
+	tasks, err := projRepo.FindTasksByProjectID(globex, proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	// acme still sees its own rows
+	found, err := empRepo.FindByID(acme, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ann", found.Name)
+	projs, err = projRepo.FindByEmployeeID(acme, emp.ID)
+	require.NoError(t, err)
+	assert.Len(t, projs, 1)
+}
+
+func TestEntRepos_CrossTenantWritesAreImpossible(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	deptRepo := NewEntDepartmentRepo(client)
+	empRepo := NewEntEmployeeRepo(client)
+	projRepo := NewEntProjectRepo(client)
+	acme := tenant.NewContext(auth.WithSystem(context.Background()), "acme")
+	globex := tenant.NewContext(auth.WithSystem(context.Background()), "globex")
+
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	emp := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID}
+	milestone := &model.Milestone{ID: uuid.NewString(), Title: "Beta"}
+	proj := &model.Project{
+		ID: uuid.NewString(), Name: "Apollo", Status: model.ProjectStatusPlanned, Priority: model.ProjectPriorityMedium,
+		StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	}
+	milestone.ProjectID = proj.ID
+	require.NoError(t, deptRepo.Save(acme, dept))
+	require.NoError(t, empRepo.Save(acme, emp))
+	require.NoError(t, projRepo.Save(acme, proj))
+	require.NoError(t, projRepo.SaveMilestone(acme, milestone))
+
+	// Test
+	updateErr := deptRepo.Update(globex, &model.Department{ID: dept.ID, Name: "Hijacked"})
+	deleteEmpErr := empRepo.Delete(globex, emp.ID)
+	deleteProjErr := projRepo.Delete(globex, proj.ID)
+	deleteMilestoneErr := projRepo.DeleteMilestone(globex, milestone.ID)
+
+	// Assert: every write is treated as a missing row and nothing changed
+	assert.ErrorIs(t, updateErr, ErrNotFound)
+	assert.ErrorIs(t, deleteEmpErr, ErrNotFound)
+	assert.ErrorIs(t, deleteProjErr, ErrNotFound)
+	assert.ErrorIs(t, deleteMilestoneErr, ErrNotFound)
+
+	found, err := deptRepo.FindByID(acme, dept.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Engineering", found.Name)
+	_, err = empRepo.FindByID(acme, emp.ID)
+	assert.NoError(t, err)
+	_, err = projRepo.FindMilestoneByID(acme, milestone.ID)
+	assert.NoError(t, err)
+}
+
+func TestEntEmployeeRepo_EmailUniquePerTenant(t *testing.T) {
+	// Setup: the same email in two tenants
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	deptRepo := NewEntDepartmentRepo(client)
+	empRepo := NewEntEmployeeRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	acme := tenant.NewContext(ctx, "acme")
+	globex := tenant.NewContext(ctx, "globex")
+
+	acmeDept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	globexDept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, deptRepo.Save(acme, acmeDept))
+	require.NoError(t, deptRepo.Save(globex, globexDept))
+
+	// Test
+	acmeErr := empRepo.Save(acme, &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: acmeDept.ID})
+	globexErr := empRepo.Save(globex, &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: globexDept.ID})
+	duplicateErr := empRepo.Save(acme, &model.Employee{ID: uuid.NewString(), Name: "Ann B", Email: "ann@example.com", DepartmentID: acmeDept.ID})
+
+	// Assert
+	assert.NoError(t, acmeErr)
+	assert.NoError(t, globexErr)
+	assert.Error(t, duplicateErr)
+
+	acmeEmps, err := empRepo.FindAll(acme)
+	require.NoError(t, err)
+	assert.Len(t, acmeEmps, 1)
+	globexEmps, err := empRepo.FindAll(globex)
+	require.NoError(t, err)
+	assert.Len(t, globexEmps, 1)
+	assert.NotEqual(t, acmeEmps[0].ID, globexEmps[0].ID)
+
+	// A context without a tenant acts for the default tenant and sees neither
+	defaultEmps, err := empRepo.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, defaultEmps)
+}
+
+func TestEntWebhookRepo_SubscriptionsArePerTenant(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntWebhookRepo(client)
+	acme := tenant.NewContext(auth.WithSystem(context.Background()), "acme")
+	globex := tenant.NewContext(auth.WithSystem(context.Background()), "globex")
+
+	sub := &model.WebhookSubscription{
+		ID: uuid.NewString(), URL: "https://hooks.acme.example/hr", EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated}, Active: true,
+	}
+	require.NoError(t, repo.SaveSubscription(acme, sub, "0123456789abcdef"))
+
+	// Test
+	subs, err := repo.FindSubscriptions(globex)
+	_, findErr := repo.FindSubscriptionByID(globex, sub.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, subs)
+	assert.ErrorIs(t, findErr, ErrNotFound)
+}
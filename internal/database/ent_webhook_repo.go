@@ -0,0 +1,400 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/webhookdelivery"
+	"gin-crud-api/internal/ent/webhooksubscription"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
+	"gin-crud-api/internal/tenant"
+	"gin-crud-api/internal/webhook"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EntWebhookRepo implements WebhookRepository and webhook.Store using EntGo.
+// Deliveries reference the outbox event they send.
+type EntWebhookRepo struct {
+	client *ent.Client
+}
+
+// NewEntWebhookRepo creates a new webhook repository using EntGo
+func NewEntWebhookRepo(client *ent.Client) *EntWebhookRepo {
+	return &EntWebhookRepo{client: client}
+}
+
+// SaveSubscription creates a new webhook subscription
+func (r *EntWebhookRepo) SaveSubscription(ctx context.Context, sub *model.WebhookSubscription, secret string) error {
+	log := logger.WithComponent("WebhookRepo")
+
+	id, err := uuid.Parse(sub.ID)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID: %w", err)
+	}
+
+	entSub, err := r.client.WebhookSubscription.
+		Create().
+		SetID(id).
+		SetURL(sub.URL).
+		SetSecret(secret).
+		SetEventTypes(eventTypeStrings(sub.EventTypes)).
+		SetActive(sub.Active).
+		Save(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("subscription_id", sub.ID).
+			Msg("Failed to save webhook subscription")
+		return fmt.Errorf("failed to save webhook subscription: %w", err)
+	}
+
+	sub.CreatedAt = entSub.CreatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// FindSubscriptionByID retrieves a webhook subscription by its ID
+func (r *EntWebhookRepo) FindSubscriptionByID(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription ID: %w", err)
+	}
+
+	entSub, err := r.client.WebhookSubscription.Get(ctx, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+	return entSubscriptionToModel(entSub), nil
+}
+
+// FindSubscriptions retrieves all webhook subscriptions, oldest first
+func (r *EntWebhookRepo) FindSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	entSubs, err := r.client.WebhookSubscription.
+		Query().
+		Order(webhooksubscription.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+
+	subs := make([]*model.WebhookSubscription, len(entSubs))
+	for i, entSub := range entSubs {
+		subs[i] = entSubscriptionToModel(entSub)
+	}
+	return subs, nil
+}
+
+// UpdateSubscription modifies an existing webhook subscription
+func (r *EntWebhookRepo) UpdateSubscription(ctx context.Context, sub *model.WebhookSubscription, secret *string) error {
+	id, err := uuid.Parse(sub.ID)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID: %w", err)
+	}
+
+	err = r.client.WebhookSubscription.
+		UpdateOneID(id).
+		SetURL(sub.URL).
+		SetEventTypes(eventTypeStrings(sub.EventTypes)).
+		SetActive(sub.Active).
+		SetNillableSecret(secret).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteSubscription removes a subscription; its deliveries cascade in the database
+func (r *EntWebhookRepo) DeleteSubscription(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid subscription ID: %w", err)
+	}
+
+	if err := r.client.WebhookSubscription.DeleteOneID(uid).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// FindDeliveries retrieves a subscription's delivery log, newest first
+func (r *EntWebhookRepo) FindDeliveries(ctx context.Context, subscriptionID string, status *model.WebhookDeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	subID, err := uuid.Parse(subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription ID: %w", err)
+	}
+
+	query := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.SubscriptionID(subID)).
+		WithEvent().
+		Order(webhookdelivery.ByCreatedAt(sql.OrderDesc()), webhookdelivery.ByID()).
+		Limit(limit)
+	if status != nil {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(*status)))
+	}
+
+	entDeliveries, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*model.WebhookDelivery, len(entDeliveries))
+	for i, entDelivery := range entDeliveries {
+		deliveries[i] = entDeliveryToModel(entDelivery)
+	}
+	return deliveries, nil
+}
+
+// Redeliver moves a DEAD delivery back to PENDING, due immediately
+func (r *EntWebhookRepo) Redeliver(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid delivery ID: %w", err)
+	}
+
+	n, err := r.client.WebhookDelivery.
+		Update().
+		Where(
+			webhookdelivery.ID(uid),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusDEAD),
+		).
+		SetStatus(webhookdelivery.StatusPENDING).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+
+	entDelivery, err := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.ID(uid)).
+		WithEvent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrStatusConflict
+	}
+	return entDeliveryToModel(entDelivery), nil
+}
+
+// ClaimDue returns due PENDING deliveries, postponing each by lease with a
+// compare-and-set on next_attempt_at so concurrent pollers skip it
+func (r *EntWebhookRepo) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	// The dispatcher serves every tenant
+	ctx = tenant.WithoutScope(ctx)
+
+	due, err := r.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPENDING),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		WithEvent().
+		WithSubscription().
+		Order(webhookdelivery.ByNextAttemptAt()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due deliveries: %w", err)
+	}
+
+	claimed := make([]*webhook.Delivery, 0, len(due))
+	for _, d := range due {
+		n, err := r.client.WebhookDelivery.
+			Update().
+			Where(
+				webhookdelivery.ID(d.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPENDING),
+				webhookdelivery.NextAttemptAt(d.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim delivery: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		event, ok := webhook.NewEvent(entOutboxEventToModel(d.Edges.Event))
+		if !ok {
+			return nil, fmt.Errorf("delivery %s has event type %s, which is not sent to webhooks", d.ID, d.Edges.Event.Type)
+		}
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode webhook event: %w", err)
+		}
+
+		claimed = append(claimed, &webhook.Delivery{
+			ID:        d.ID.String(),
+			EventType: string(event.Type),
+			URL:       d.Edges.Subscription.URL,
+			Secret:    d.Edges.Subscription.Secret,
+			Payload:   payload,
+			Attempts:  d.Attempts,
+		})
+	}
+	return claimed, nil
+}
+
+// MarkDelivered records a successful attempt
+func (r *EntWebhookRepo) MarkDelivered(ctx context.Context, id string, statusCode int, at time.Time) error {
+	ctx = tenant.WithoutScope(ctx)
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid delivery ID: %w", err)
+	}
+
+	err = r.client.WebhookDelivery.
+		UpdateOneID(uid).
+		SetStatus(webhookdelivery.StatusDELIVERED).
+		AddAttempts(1).
+		SetLastStatusCode(statusCode).
+		ClearLastError().
+		SetDeliveredAt(at).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark delivery delivered: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt and schedules the retry or dead-letters the delivery
+func (r *EntWebhookRepo) MarkFailed(ctx context.Context, id string, statusCode *int, errMsg string, retryAt *time.Time) error {
+	ctx = tenant.WithoutScope(ctx)
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid delivery ID: %w", err)
+	}
+
+	update := r.client.WebhookDelivery.
+		UpdateOneID(uid).
+		AddAttempts(1).
+		SetLastError(errMsg)
+	if statusCode != nil {
+		update = update.SetLastStatusCode(*statusCode)
+	} else {
+		update = update.ClearLastStatusCode()
+	}
+	if retryAt != nil {
+		update = update.SetNextAttemptAt(*retryAt)
+	} else {
+		update = update.SetStatus(webhookdelivery.StatusDEAD)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to mark delivery failed: %w", err)
+	}
+	return nil
+}
+
+// Enqueue queues a PENDING delivery of an outbox event for each active
+// subscription of the event's tenant to eventType that existed when it occurred
+func (r *EntWebhookRepo) Enqueue(ctx context.Context, event *outbox.Event, eventType model.WebhookEventType) error {
+	ctx = tenant.NewContext(ctx, event.TenantID)
+
+	subs, err := r.client.WebhookSubscription.
+		Query().
+		Where(
+			webhooksubscription.Active(true),
+			webhooksubscription.CreatedAtLTE(event.OccurredAt),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+
+	for _, sub := range subs {
+		if !slices.Contains(sub.EventTypes, string(eventType)) {
+			continue
+		}
+		err := r.client.WebhookDelivery.
+			Create().
+			SetEventID(event.Sequence).
+			SetSubscriptionID(sub.ID).
+			Exec(ctx)
+		// The event was read before, by this or another dispatcher
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+	}
+	return nil
+}
+
+// entSubscriptionToModel converts an EntGo webhook subscription to a GraphQL model
+func entSubscriptionToModel(entSub *ent.WebhookSubscription) *model.WebhookSubscription {
+	eventTypes := make([]model.WebhookEventType, len(entSub.EventTypes))
+	for i, t := range entSub.EventTypes {
+		eventTypes[i] = model.WebhookEventType(t)
+	}
+	return &model.WebhookSubscription{
+		ID:         entSub.ID.String(),
+		URL:        entSub.URL,
+		EventTypes: eventTypes,
+		Active:     entSub.Active,
+		CreatedAt:  entSub.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// entDeliveryToModel converts an EntGo webhook delivery (with its event) to a GraphQL model
+func entDeliveryToModel(entDelivery *ent.WebhookDelivery) *model.WebhookDelivery {
+	d := &model.WebhookDelivery{
+		ID:             entDelivery.ID.String(),
+		SubscriptionID: entDelivery.SubscriptionID.String(),
+		Status:         model.WebhookDeliveryStatus(entDelivery.Status),
+		Attempts:       entDelivery.Attempts,
+		LastStatusCode: entDelivery.LastStatusCode,
+		LastError:      entDelivery.LastError,
+		CreatedAt:      entDelivery.CreatedAt.Format(time.RFC3339),
+	}
+	if entDelivery.Edges.Event != nil {
+		d.EventID = entDelivery.Edges.Event.EventID.String()
+		d.EventType, _ = webhook.EventType(entDelivery.Edges.Event.Type)
+	}
+	if entDelivery.Status == webhookdelivery.StatusPENDING {
+		next := entDelivery.NextAttemptAt.Format(time.RFC3339)
+		d.NextAttemptAt = &next
+	}
+	if entDelivery.DeliveredAt != nil {
+		at := entDelivery.DeliveredAt.Format(time.RFC3339)
+		d.DeliveredAt = &at
+	}
+	return d
+}
+
+// eventTypeStrings converts GraphQL event types for storage
+func eventTypeStrings(types []model.WebhookEventType) []string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = string(t)
+	}
+	return s
+}
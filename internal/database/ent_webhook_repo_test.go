@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/outbox"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// queueWebhookEvents lets a dispatcher queue the deliveries of every outbox event
+func queueWebhookEvents(t *testing.T, client *ent.Client) {
+	dispatcher := webhook.NewDispatcher(NewEntWebhookRepo(client), NewEntOutboxRepo(client), config.WebhookConfig{}, config.OutboxConfig{})
+	for {
+		n, err := dispatcher.QueueEvents(auth.WithSystem(context.Background()))
+		require.NoError(t, err)
+		if n == 0 {
+			return
+		}
+	}
+}
+
+func TestEntWebhookRepo_EmployeeWritesQueueDeliveries(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntWebhookRepo(client)
+	empRepo := NewEntEmployeeRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	engineering := client.Department.Create().SetName("Engineering").SaveX(ctx)
+	sales := client.Department.Create().SetName("Sales").SaveX(ctx)
+	f := testutil.NewFactory(t, client)
+	moves := testutil.Create(t, f.WebhookSubscription().EventTypes(model.WebhookEventTypeEmployeeMoved))
+	creates := testutil.Create(t, f.WebhookSubscription().EventTypes(model.WebhookEventTypeEmployeeCreated))
+
+	// Test: create, rename (no event) and move
+	emp := &model.Employee{
+		ID:           uuid.NewString(),
+		Name:         "Ann",
+		Email:        "ann@example.com",
+		DepartmentID: engineering.ID.String(),
+	}
+	require.NoError(t, empRepo.Save(ctx, emp))
+	emp.Name = "Ann Lee"
+	require.NoError(t, empRepo.Update(ctx, emp))
+	emp.DepartmentID = sales.ID.String()
+	require.NoError(t, empRepo.Update(ctx, emp))
+	queueWebhookEvents(t, client)
+
+	// Assert: each subscription only gets its own event type
+	created, err := repo.FindDeliveries(ctx, creates.ID.String(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, model.WebhookEventTypeEmployeeCreated, created[0].EventType)
+	assert.Equal(t, model.WebhookDeliveryStatusPending, created[0].Status)
+
+	moved, err := repo.FindDeliveries(ctx, moves.ID.String(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, moved, 1)
+	assert.Equal(t, model.WebhookEventTypeEmployeeMoved, moved[0].EventType)
+
+	claimed, err := repo.ClaimDue(ctx, time.Now().Add(time.Second), 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	for _, d := range claimed {
+		if d.ID != moved[0].ID {
+			continue
+		}
+		var event struct {
+			ID   string          `json:"id"`
+			Type string          `json:"type"`
+			Data outbox.Employee `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(d.Payload, &event))
+		assert.Equal(t, moved[0].EventID, event.ID)
+		assert.Equal(t, "EMPLOYEE_MOVED", event.Type)
+		assert.Equal(t, engineering.ID.String(), event.Data.PreviousDepartmentID)
+		assert.Equal(t, sales.ID.String(), event.Data.DepartmentID)
+	}
+}
+
+func TestEntWebhookRepo_EnqueueOnce(t *testing.T) {
+	// Setup: an event read again after a crash, and a subscription created after it
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntWebhookRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	dept := client.Department.Create().SetName("Engineering").SaveX(ctx)
+	f := testutil.NewFactory(t, client)
+	early := testutil.Create(t, f.WebhookSubscription().EventTypes(model.WebhookEventTypeEmployeeCreated))
+	require.NoError(t, NewEntEmployeeRepo(client).Save(ctx, &model.Employee{
+		ID:           uuid.NewString(),
+		Name:         "Ann",
+		Email:        "ann@example.com",
+		DepartmentID: dept.ID.String(),
+	}))
+	events, err := NewEntOutboxRepo(client).Fetch(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	time.Sleep(10 * time.Millisecond)
+	late := testutil.Create(t, f.WebhookSubscription().EventTypes(model.WebhookEventTypeEmployeeCreated))
+
+	// Test
+	require.NoError(t, repo.Enqueue(ctx, events[0], model.WebhookEventTypeEmployeeCreated))
+	require.NoError(t, repo.Enqueue(ctx, events[0], model.WebhookEventTypeEmployeeCreated))
+
+	// Assert
+	queued, err := repo.FindDeliveries(ctx, early.ID.String(), nil, 10)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, events[0].ID, queued[0].EventID)
+
+	missed, err := repo.FindDeliveries(ctx, late.ID.String(), nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, missed)
+}
+
+func TestEntWebhookRepo_ClaimFailRedeliver(t *testing.T) {
+	// Setup: one queued delivery
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	repo := NewEntWebhookRepo(client)
+	ctx := auth.WithSystem(context.Background())
+	dept := client.Department.Create().SetName("Engineering").SaveX(ctx)
+	sub := testutil.Create(t, testutil.NewFactory(t, client).WebhookSubscription().
+		URL("https://hooks.example.com/hr").
+		Secret("0123456789abcdef").
+		EventTypes(model.WebhookEventTypeEmployeeCreated))
+	require.NoError(t, NewEntEmployeeRepo(client).Save(ctx, &model.Employee{
+		ID:           uuid.NewString(),
+		Name:         "Ann",
+		Email:        "ann@example.com",
+		DepartmentID: dept.ID.String(),
+	}))
+	queueWebhookEvents(t, client)
+	now := time.Now().Add(time.Second)
+
+	// Test: a claimed delivery is leased and not claimed again
+	claimed, err := repo.ClaimDue(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, "https://hooks.example.com/hr", claimed[0].URL)
+	assert.Equal(t, "0123456789abcdef", claimed[0].Secret)
+
+	again, err := repo.ClaimDue(ctx, now, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, again)
+
+	// Only DEAD deliveries can be redelivered
+	_, err = repo.Redeliver(ctx, claimed[0].ID)
+	assert.ErrorIs(t, err, ErrStatusConflict)
+
+	code := 500
+	require.NoError(t, repo.MarkFailed(ctx, claimed[0].ID, &code, "receiver responded 500", nil))
+	dead := model.WebhookDeliveryStatusDead
+	deliveries, err := repo.FindDeliveries(ctx, sub.ID.String(), &dead, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+
+	redelivered, err := repo.Redeliver(ctx, claimed[0].ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, model.WebhookDeliveryStatusPending, redelivered.Status)
+	assert.NotNil(t, redelivered.NextAttemptAt)
+}
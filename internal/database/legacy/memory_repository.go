@@ -1,9 +1,11 @@
 package legacy
 
 import (
+	"context"
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/graph/model"
 	"sync"
+	"time"
 )
 
 // InMemoryStore provides thread-safe in-memory storage using RWMutex
@@ -40,14 +42,14 @@ func NewEmployeeRepository(store *InMemoryStore) database.EmployeeRepository {
 
 // Department Repository Implementation
 
-func (r *InMemoryDepartmentRepo) Save(dept *model.Department) error {
+func (r *InMemoryDepartmentRepo) Save(ctx context.Context, dept *model.Department) error {
 	r.store.deptMu.Lock()
 	defer r.store.deptMu.Unlock()
 	r.store.departments[dept.ID] = dept
 	return nil
 }
 
-func (r *InMemoryDepartmentRepo) FindByID(id string) (*model.Department, error) {
+func (r *InMemoryDepartmentRepo) FindByID(ctx context.Context, id string) (*model.Department, error) {
 	r.store.deptMu.RLock()
 	defer r.store.deptMu.RUnlock()
 	dept, ok := r.store.departments[id]
@@ -57,7 +59,7 @@ func (r *InMemoryDepartmentRepo) FindByID(id string) (*model.Department, error)
 	return dept, nil
 }
 
-func (r *InMemoryDepartmentRepo) FindAll() ([]*model.Department, error) {
+func (r *InMemoryDepartmentRepo) FindAll(ctx context.Context) ([]*model.Department, error) {
 	r.store.deptMu.RLock()
 	defer r.store.deptMu.RUnlock()
 
@@ -68,7 +70,7 @@ func (r *InMemoryDepartmentRepo) FindAll() ([]*model.Department, error) {
 	return result, nil
 }
 
-func (r *InMemoryDepartmentRepo) Update(dept *model.Department) error {
+func (r *InMemoryDepartmentRepo) Update(ctx context.Context, dept *model.Department) error {
 	r.store.deptMu.Lock()
 	defer r.store.deptMu.Unlock()
 	if _, exists := r.store.departments[dept.ID]; !exists {
@@ -78,7 +80,7 @@ func (r *InMemoryDepartmentRepo) Update(dept *model.Department) error {
 	return nil
 }
 
-func (r *InMemoryDepartmentRepo) Delete(id string) error {
+func (r *InMemoryDepartmentRepo) Delete(ctx context.Context, id string) error {
 	r.store.deptMu.Lock()
 	defer r.store.deptMu.Unlock()
 	if _, exists := r.store.departments[id]; !exists {
@@ -90,14 +92,14 @@ func (r *InMemoryDepartmentRepo) Delete(id string) error {
 
 // Employee Repository Implementation
 
-func (r *InMemoryEmployeeRepo) Save(emp *model.Employee) error {
+func (r *InMemoryEmployeeRepo) Save(ctx context.Context, emp *model.Employee) error {
 	r.store.empMu.Lock()
 	defer r.store.empMu.Unlock()
 	r.store.employees[emp.ID] = emp
 	return nil
 }
 
-func (r *InMemoryEmployeeRepo) FindByID(id string) (*model.Employee, error) {
+func (r *InMemoryEmployeeRepo) FindByID(ctx context.Context, id string) (*model.Employee, error) {
 	r.store.empMu.RLock()
 	defer r.store.empMu.RUnlock()
 	emp, ok := r.store.employees[id]
@@ -107,7 +109,7 @@ func (r *InMemoryEmployeeRepo) FindByID(id string) (*model.Employee, error) {
 	return emp, nil
 }
 
-func (r *InMemoryEmployeeRepo) FindAll() ([]*model.Employee, error) {
+func (r *InMemoryEmployeeRepo) FindAll(ctx context.Context) ([]*model.Employee, error) {
 	r.store.empMu.RLock()
 	defer r.store.empMu.RUnlock()
 
@@ -118,7 +120,7 @@ func (r *InMemoryEmployeeRepo) FindAll() ([]*model.Employee, error) {
 	return result, nil
 }
 
-func (r *InMemoryEmployeeRepo) Update(emp *model.Employee) error {
+func (r *InMemoryEmployeeRepo) Update(ctx context.Context, emp *model.Employee) error {
 	r.store.empMu.Lock()
 	defer r.store.empMu.Unlock()
 	if _, exists := r.store.employees[emp.ID]; !exists {
@@ -128,7 +130,7 @@ func (r *InMemoryEmployeeRepo) Update(emp *model.Employee) error {
 	return nil
 }
 
-func (r *InMemoryEmployeeRepo) Delete(id string) error {
+func (r *InMemoryEmployeeRepo) Delete(ctx context.Context, id string) error {
 	r.store.empMu.Lock()
 	defer r.store.empMu.Unlock()
 	if _, exists := r.store.employees[id]; !exists {
@@ -138,7 +140,7 @@ func (r *InMemoryEmployeeRepo) Delete(id string) error {
 	return nil
 }
 
-func (r *InMemoryEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Employee, error) {
+func (r *InMemoryEmployeeRepo) FindByDepartmentID(ctx context.Context, deptID string) ([]*model.Employee, error) {
 	r.store.empMu.RLock()
 	defer r.store.empMu.RUnlock()
 
@@ -149,4 +151,31 @@ func (r *InMemoryEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Emplo
 		}
 	}
 	return result, nil
-}
\ No newline at end of file
+}
+// Terminate marks the employee as terminated; the legacy store has no projects to leave
+func (r *InMemoryEmployeeRepo) Terminate(ctx context.Context, id string, terminationDate time.Time) error {
+	r.store.empMu.Lock()
+	defer r.store.empMu.Unlock()
+	emp, exists := r.store.employees[id]
+	if !exists {
+		return database.ErrNotFound
+	}
+	date := terminationDate.Format("2006-01-02")
+	emp.Status = model.EmploymentStatusTerminated
+	emp.TerminationDate = &date
+	return nil
+}
+
+func (r *InMemoryEmployeeRepo) Rehire(ctx context.Context, id string, hireDate time.Time) error {
+	r.store.empMu.Lock()
+	defer r.store.empMu.Unlock()
+	emp, exists := r.store.employees[id]
+	if !exists {
+		return database.ErrNotFound
+	}
+	date := hireDate.Format("2006-01-02")
+	emp.Status = model.EmploymentStatusActive
+	emp.HireDate = &date
+	emp.TerminationDate = nil
+	return nil
+}
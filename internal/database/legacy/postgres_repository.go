@@ -32,8 +32,8 @@ func NewPostgresEmployeeRepository(db *PostgresDB) database.EmployeeRepository {
 
 // Department Repository Implementation
 
-func (r *PostgresDepartmentRepo) Save(dept *model.Department) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresDepartmentRepo) Save(ctx context.Context, dept *model.Department) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -47,8 +47,8 @@ func (r *PostgresDepartmentRepo) Save(dept *model.Department) error {
 	return nil
 }
 
-func (r *PostgresDepartmentRepo) FindByID(id string) (*model.Department, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresDepartmentRepo) FindByID(ctx context.Context, id string) (*model.Department, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `SELECT id, name FROM departments WHERE id = $1`
@@ -64,8 +64,8 @@ func (r *PostgresDepartmentRepo) FindByID(id string) (*model.Department, error)
 	return &dept, nil
 }
 
-func (r *PostgresDepartmentRepo) FindAll() ([]*model.Department, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresDepartmentRepo) FindAll(ctx context.Context) ([]*model.Department, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `SELECT id, name FROM departments ORDER BY name`
@@ -97,8 +97,8 @@ func (r *PostgresDepartmentRepo) FindAll() ([]*model.Department, error) {
 	return departments, nil
 }
 
-func (r *PostgresDepartmentRepo) Update(dept *model.Department) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresDepartmentRepo) Update(ctx context.Context, dept *model.Department) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -118,8 +118,8 @@ func (r *PostgresDepartmentRepo) Update(dept *model.Department) error {
 	return nil
 }
 
-func (r *PostgresDepartmentRepo) Delete(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresDepartmentRepo) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	// Note: CASCADE DELETE is handled by database foreign key constraint
@@ -139,8 +139,8 @@ func (r *PostgresDepartmentRepo) Delete(id string) error {
 
 // Employee Repository Implementation
 
-func (r *PostgresEmployeeRepo) Save(emp *model.Employee) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) Save(ctx context.Context, emp *model.Employee) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -154,8 +154,8 @@ func (r *PostgresEmployeeRepo) Save(emp *model.Employee) error {
 	return nil
 }
 
-func (r *PostgresEmployeeRepo) FindByID(id string) (*model.Employee, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) FindByID(ctx context.Context, id string) (*model.Employee, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -177,8 +177,8 @@ func (r *PostgresEmployeeRepo) FindByID(id string) (*model.Employee, error) {
 	return &emp, nil
 }
 
-func (r *PostgresEmployeeRepo) FindAll() ([]*model.Employee, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) FindAll(ctx context.Context) ([]*model.Employee, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -214,8 +214,8 @@ func (r *PostgresEmployeeRepo) FindAll() ([]*model.Employee, error) {
 	return employees, nil
 }
 
-func (r *PostgresEmployeeRepo) Update(emp *model.Employee) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) Update(ctx context.Context, emp *model.Employee) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -235,8 +235,8 @@ func (r *PostgresEmployeeRepo) Update(emp *model.Employee) error {
 	return nil
 }
 
-func (r *PostgresEmployeeRepo) Delete(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `DELETE FROM employees WHERE id = $1`
@@ -253,8 +253,8 @@ func (r *PostgresEmployeeRepo) Delete(id string) error {
 	return nil
 }
 
-func (r *PostgresEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Employee, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+func (r *PostgresEmployeeRepo) FindByDepartmentID(ctx context.Context, deptID string) ([]*model.Employee, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	query := `
@@ -289,4 +289,14 @@ func (r *PostgresEmployeeRepo) FindByDepartmentID(deptID string) ([]*model.Emplo
 	}
 
 	return employees, nil
-}
\ No newline at end of file
+}
+// errLifecycleUnsupported is returned for operations the legacy employees table has no columns for
+var errLifecycleUnsupported = errors.New("employee lifecycle is not supported by the legacy schema")
+
+func (r *PostgresEmployeeRepo) Terminate(ctx context.Context, id string, terminationDate time.Time) error {
+	return errLifecycleUnsupported
+}
+
+func (r *PostgresEmployeeRepo) Rehire(ctx context.Context, id string, hireDate time.Time) error {
+	return errLifecycleUnsupported
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// MemoryDepartmentRepo implements DepartmentRepository on a MemoryStore
+type MemoryDepartmentRepo struct {
+	store *MemoryStore
+}
+
+// NewMemoryDepartmentRepo creates a new department repository on store
+func NewMemoryDepartmentRepo(store *MemoryStore) DepartmentRepository {
+	return &MemoryDepartmentRepo{store: store}
+}
+
+// Save creates a new department
+func (r *MemoryDepartmentRepo) Save(ctx context.Context, dept *model.Department) error {
+	stored, err := r.parse(dept)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := r.check(stored); err != nil {
+		return fmt.Errorf("failed to save department: %w", err)
+	}
+	if _, exists := s.departments[stored.ID]; exists {
+		return fmt.Errorf("failed to save department: %w", ErrAlreadyExists)
+	}
+	s.departments[stored.ID] = newRow(s, ctx, stored)
+	return nil
+}
+
+// FindByID retrieves a department by its ID
+func (r *MemoryDepartmentRepo) FindByID(ctx context.Context, id string) (*model.Department, error) {
+	id, err := memoryID("department", id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row := r.store.departments[id]
+	if !row.visible(ctx) {
+		return nil, ErrNotFound
+	}
+	return copyDepartment(row.value), nil
+}
+
+// FindAll retrieves all departments
+func (r *MemoryDepartmentRepo) FindAll(ctx context.Context) ([]*model.Department, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rows := visibleRows(ctx, r.store.departments, nil)
+	departments := make([]*model.Department, len(rows))
+	for i, row := range rows {
+		departments[i] = copyDepartment(row.value)
+	}
+	return departments, nil
+}
+
+// Update updates an existing department
+func (r *MemoryDepartmentRepo) Update(ctx context.Context, dept *model.Department) error {
+	stored, err := r.parse(dept)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.departments[stored.ID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	if err := r.check(stored); err != nil {
+		return fmt.Errorf("failed to update department: %w", err)
+	}
+	row.value = stored
+	return nil
+}
+
+// Delete removes a department; its sub-departments become top-level. It
+// fails while employees still belong to the department.
+func (r *MemoryDepartmentRepo) Delete(ctx context.Context, id string) error {
+	id, err := memoryID("department", id)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.departments[id].visible(ctx) {
+		return ErrNotFound
+	}
+	for _, emp := range s.employees {
+		if emp.value.DepartmentID == id {
+			return fmt.Errorf("failed to delete department: employee %s still belongs to it", emp.value.ID)
+		}
+	}
+	for _, child := range s.departments {
+		if child.value.ParentID != nil && *child.value.ParentID == id {
+			child.value.ParentID = nil
+		}
+	}
+	delete(s.departments, id)
+	return nil
+}
+
+// parse validates the IDs of dept and returns the row to store
+func (r *MemoryDepartmentRepo) parse(dept *model.Department) (model.Department, error) {
+	id, err := memoryID("department", dept.ID)
+	if err != nil {
+		return model.Department{}, err
+	}
+	parentID, err := memoryOptionalID("parent department", dept.ParentID)
+	if err != nil {
+		return model.Department{}, err
+	}
+	return model.Department{ID: id, Name: dept.Name, ParentID: parentID}, nil
+}
+
+// check validates a department against the schema; the store must be locked
+func (r *MemoryDepartmentRepo) check(dept model.Department) error {
+	if err := requireNotEmpty("name", dept.Name); err != nil {
+		return err
+	}
+	if dept.ParentID != nil && r.store.departments[*dept.ParentID] == nil {
+		return missingReference("parent department", *dept.ParentID)
+	}
+	return nil
+}
+
+// copyDepartment returns a department the caller may modify
+func copyDepartment(dept model.Department) *model.Department {
+	dept.ParentID = cloneString(dept.ParentID)
+	return &dept
+}
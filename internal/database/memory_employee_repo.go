@@ -0,0 +1,276 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// MemoryEmployeeRepo implements EmployeeRepository on a MemoryStore
+type MemoryEmployeeRepo struct {
+	store *MemoryStore
+}
+
+// NewMemoryEmployeeRepo creates a new employee repository on store
+func NewMemoryEmployeeRepo(store *MemoryStore) EmployeeRepository {
+	return &MemoryEmployeeRepo{store: store}
+}
+
+// Save creates a new employee; unset enums default to FULL_TIME and ACTIVE
+func (r *MemoryEmployeeRepo) Save(ctx context.Context, emp *model.Employee) error {
+	stored, err := parseMemoryEmployee(emp)
+	if err != nil {
+		return err
+	}
+	if stored.EmploymentType == "" {
+		stored.EmploymentType = model.EmploymentTypeFullTime
+	}
+	if stored.Status == "" {
+		stored.Status = model.EmploymentStatusActive
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.employees[stored.ID]; exists {
+		return fmt.Errorf("failed to save employee: %w", ErrAlreadyExists)
+	}
+	row := newRow(s, ctx, stored)
+	if err := r.check(row); err != nil {
+		return fmt.Errorf("failed to save employee: %w", err)
+	}
+	s.employees[stored.ID] = row
+	return nil
+}
+
+// FindByID retrieves an employee by their ID
+func (r *MemoryEmployeeRepo) FindByID(ctx context.Context, id string) (*model.Employee, error) {
+	id, err := memoryID("employee", id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row := r.store.employees[id]
+	if !row.visible(ctx) {
+		return nil, ErrNotFound
+	}
+	return copyEmployee(row.value), nil
+}
+
+// FindAll retrieves all employees
+func (r *MemoryEmployeeRepo) FindAll(ctx context.Context) ([]*model.Employee, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return copyEmployees(visibleRows(ctx, r.store.employees, nil)), nil
+}
+
+// Update updates an existing employee; unset enums keep their values
+func (r *MemoryEmployeeRepo) Update(ctx context.Context, emp *model.Employee) error {
+	stored, err := parseMemoryEmployee(emp)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.employees[stored.ID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	if stored.EmploymentType == "" {
+		stored.EmploymentType = row.value.EmploymentType
+	}
+	if stored.Status == "" {
+		stored.Status = row.value.Status
+	}
+	updated := &memoryRow[model.Employee]{tenantID: row.tenantID, seq: row.seq, value: stored}
+	if err := r.check(updated); err != nil {
+		return fmt.Errorf("failed to update employee: %w", err)
+	}
+	row.value = stored
+	return nil
+}
+
+// Delete removes an employee from project teams, project leads and task
+// assignments, then deletes it
+func (r *MemoryEmployeeRepo) Delete(ctx context.Context, id string) error {
+	id, err := memoryID("employee", id)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.employees[id].visible(ctx) {
+		return ErrNotFound
+	}
+	for projID := range s.teams {
+		s.removeTeamMember(projID, id)
+	}
+	for _, proj := range s.projects {
+		if proj.value.LeadID != nil && *proj.value.LeadID == id {
+			proj.value.LeadID = nil
+		}
+	}
+	for _, t := range s.tasks {
+		if t.value.AssigneeID != nil && *t.value.AssigneeID == id {
+			t.value.AssigneeID = nil
+		}
+	}
+	delete(s.employees, id)
+	return nil
+}
+
+// FindByDepartmentID retrieves all employees in a specific department
+func (r *MemoryEmployeeRepo) FindByDepartmentID(ctx context.Context, deptID string) ([]*model.Employee, error) {
+	deptID, err := memoryID("department", deptID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rows := visibleRows(ctx, r.store.employees, func(emp *model.Employee) bool {
+		return emp.DepartmentID == deptID
+	})
+	return copyEmployees(rows), nil
+}
+
+// Terminate marks an employee as terminated and removes them from every
+// project that is not completed or cancelled
+func (r *MemoryEmployeeRepo) Terminate(ctx context.Context, id string, terminationDate time.Time) error {
+	id, err := memoryID("employee", id)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.employees[id]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+
+	// Completed and cancelled projects keep the employee for historical reporting
+	for _, proj := range visibleRows(ctx, s.projects, nil) {
+		if proj.value.Status != model.ProjectStatusCompleted && proj.value.Status != model.ProjectStatusCancelled {
+			s.removeTeamMember(proj.value.ID, id)
+		}
+	}
+	date := terminationDate.Format("2006-01-02")
+	row.value.Status = model.EmploymentStatusTerminated
+	row.value.TerminationDate = &date
+	return nil
+}
+
+// Rehire reactivates a terminated employee with a new hire date
+func (r *MemoryEmployeeRepo) Rehire(ctx context.Context, id string, hireDate time.Time) error {
+	id, err := memoryID("employee", id)
+	if err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row := r.store.employees[id]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	date := hireDate.Format("2006-01-02")
+	row.value.Status = model.EmploymentStatusActive
+	row.value.HireDate = &date
+	row.value.TerminationDate = nil
+	return nil
+}
+
+// check validates an employee row against the schema, including the
+// per-tenant email uniqueness; the store must be locked
+func (r *MemoryEmployeeRepo) check(row *memoryRow[model.Employee]) error {
+	emp := row.value
+	if err := requireNotEmpty("name", emp.Name); err != nil {
+		return err
+	}
+	if err := requireNotEmpty("email", emp.Email); err != nil {
+		return err
+	}
+	if !emp.EmploymentType.IsValid() {
+		return fmt.Errorf("invalid employment type %q", emp.EmploymentType)
+	}
+	if !emp.Status.IsValid() {
+		return fmt.Errorf("invalid employment status %q", emp.Status)
+	}
+	if r.store.departments[emp.DepartmentID] == nil {
+		return missingReference("department", emp.DepartmentID)
+	}
+	for _, other := range r.store.employees {
+		if other.value.ID != emp.ID && other.tenantID == row.tenantID && other.value.Email == emp.Email {
+			return fmt.Errorf("email %s: %w", emp.Email, ErrAlreadyExists)
+		}
+	}
+	return nil
+}
+
+// parseMemoryEmployee validates the IDs and dates of emp and returns the row to store
+func parseMemoryEmployee(emp *model.Employee) (model.Employee, error) {
+	id, err := memoryID("employee", emp.ID)
+	if err != nil {
+		return model.Employee{}, err
+	}
+	deptID, err := memoryID("department", emp.DepartmentID)
+	if err != nil {
+		return model.Employee{}, err
+	}
+	if _, err := parseOptionalDate(emp.HireDate); err != nil {
+		return model.Employee{}, fmt.Errorf("invalid hire date: %w", err)
+	}
+	if _, err := parseOptionalDate(emp.TerminationDate); err != nil {
+		return model.Employee{}, fmt.Errorf("invalid termination date: %w", err)
+	}
+
+	return model.Employee{
+		ID:              id,
+		Name:            emp.Name,
+		Email:           emp.Email,
+		DepartmentID:    deptID,
+		JobTitle:        optionalString(emp.JobTitle),
+		EmploymentType:  emp.EmploymentType,
+		Status:          emp.Status,
+		HireDate:        cloneString(emp.HireDate),
+		TerminationDate: cloneString(emp.TerminationDate),
+		Phone:           optionalString(emp.Phone),
+		Location:        optionalString(emp.Location),
+	}, nil
+}
+
+// copyEmployee returns an employee the caller may modify
+func copyEmployee(emp model.Employee) *model.Employee {
+	emp.JobTitle = cloneString(emp.JobTitle)
+	emp.HireDate = cloneString(emp.HireDate)
+	emp.TerminationDate = cloneString(emp.TerminationDate)
+	emp.Phone = cloneString(emp.Phone)
+	emp.Location = cloneString(emp.Location)
+	return &emp
+}
+
+func copyEmployees(rows []*memoryRow[model.Employee]) []*model.Employee {
+	employees := make([]*model.Employee, len(rows))
+	for i, row := range rows {
+		employees[i] = copyEmployee(row.value)
+	}
+	return employees
+}
@@ -0,0 +1,376 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// Milestone and task operations of MemoryProjectRepo
+
+// SaveMilestone creates a new milestone in its project; an unset status defaults to OPEN
+func (r *MemoryProjectRepo) SaveMilestone(ctx context.Context, m *model.Milestone) error {
+	stored, err := parseMemoryMilestone(m)
+	if err != nil {
+		return err
+	}
+	if stored.Status == "" {
+		stored.Status = model.MilestoneStatusOpen
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := r.checkMilestone(stored); err != nil {
+		return fmt.Errorf("failed to save milestone: %w", err)
+	}
+	if _, exists := s.milestones[stored.ID]; exists {
+		return fmt.Errorf("failed to save milestone: %w", ErrAlreadyExists)
+	}
+	row := newRow(s, ctx, stored)
+	row.tenantID = s.projects[stored.ProjectID].tenantID
+	s.milestones[stored.ID] = row
+	return nil
+}
+
+// FindMilestoneByID retrieves a milestone by its ID
+func (r *MemoryProjectRepo) FindMilestoneByID(ctx context.Context, id string) (*model.Milestone, error) {
+	id, err := memoryID("milestone", id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row := r.store.milestones[id]
+	if !row.visible(ctx) {
+		return nil, ErrNotFound
+	}
+	return copyMilestone(row.value), nil
+}
+
+// FindMilestonesByProjectID retrieves a project's milestones ordered by due date
+func (r *MemoryProjectRepo) FindMilestonesByProjectID(ctx context.Context, projectID string) ([]*model.Milestone, error) {
+	projectID, err := memoryID("project", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rows := visibleRows(ctx, r.store.milestones, func(m *model.Milestone) bool {
+		return m.ProjectID == projectID
+	})
+	sortByDueDate(rows, func(m *model.Milestone) *string { return m.DueDate })
+
+	milestones := make([]*model.Milestone, len(rows))
+	for i, row := range rows {
+		milestones[i] = copyMilestone(row.value)
+	}
+	return milestones, nil
+}
+
+// UpdateMilestone updates an existing milestone; a nil due date clears it
+func (r *MemoryProjectRepo) UpdateMilestone(ctx context.Context, m *model.Milestone) error {
+	stored, err := parseMemoryMilestone(m)
+	if err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row := r.store.milestones[stored.ID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	// The project of a milestone never changes
+	stored.ProjectID = row.value.ProjectID
+	if err := r.checkMilestone(stored); err != nil {
+		return fmt.Errorf("failed to update milestone: %w", err)
+	}
+	row.value = stored
+	return nil
+}
+
+// DeleteMilestone deletes a milestone; its tasks are kept without a milestone
+func (r *MemoryProjectRepo) DeleteMilestone(ctx context.Context, id string) error {
+	id, err := memoryID("milestone", id)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.milestones[id].visible(ctx) {
+		return ErrNotFound
+	}
+	for _, t := range s.tasks {
+		if t.value.MilestoneID != nil && *t.value.MilestoneID == id {
+			t.value.MilestoneID = nil
+		}
+	}
+	delete(s.milestones, id)
+	return nil
+}
+
+// SaveTask creates a new task in its project; an unset status defaults to TODO
+func (r *MemoryProjectRepo) SaveTask(ctx context.Context, t *model.Task) error {
+	stored, err := parseMemoryTask(t)
+	if err != nil {
+		return err
+	}
+	if stored.Status == "" {
+		stored.Status = model.TaskStatusTodo
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := r.checkTask(stored); err != nil {
+		return fmt.Errorf("failed to save task: %w", err)
+	}
+	if stored.AssigneeID != nil && !slices.Contains(s.teams[stored.ProjectID], *stored.AssigneeID) {
+		return ErrNotTeamMember
+	}
+	if _, exists := s.tasks[stored.ID]; exists {
+		return fmt.Errorf("failed to save task: %w", ErrAlreadyExists)
+	}
+	row := newRow(s, ctx, stored)
+	row.tenantID = s.projects[stored.ProjectID].tenantID
+	s.tasks[stored.ID] = row
+	return nil
+}
+
+// FindTaskByID retrieves a task by its ID
+func (r *MemoryProjectRepo) FindTaskByID(ctx context.Context, id string) (*model.Task, error) {
+	id, err := memoryID("task", id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row := r.store.tasks[id]
+	if !row.visible(ctx) {
+		return nil, ErrNotFound
+	}
+	return copyTask(row.value), nil
+}
+
+// FindTasksByProjectID retrieves all tasks of a project
+func (r *MemoryProjectRepo) FindTasksByProjectID(ctx context.Context, projectID string) ([]*model.Task, error) {
+	projectID, err := memoryID("project", projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.findTasks(ctx, func(t *model.Task) bool {
+		return t.ProjectID == projectID
+	}), nil
+}
+
+// FindTasksByMilestoneID retrieves the tasks scheduled for a milestone
+func (r *MemoryProjectRepo) FindTasksByMilestoneID(ctx context.Context, milestoneID string) ([]*model.Task, error) {
+	milestoneID, err := memoryID("milestone", milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return r.findTasks(ctx, func(t *model.Task) bool {
+		return t.MilestoneID != nil && *t.MilestoneID == milestoneID
+	}), nil
+}
+
+func (r *MemoryProjectRepo) findTasks(ctx context.Context, keep func(*model.Task) bool) []*model.Task {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rows := visibleRows(ctx, r.store.tasks, keep)
+	sortByDueDate(rows, func(t *model.Task) *string { return t.DueDate })
+
+	tasks := make([]*model.Task, len(rows))
+	for i, row := range rows {
+		tasks[i] = copyTask(row.value)
+	}
+	return tasks
+}
+
+// UpdateTask updates an existing task; nil optional fields are cleared
+func (r *MemoryProjectRepo) UpdateTask(ctx context.Context, t *model.Task) error {
+	stored, err := parseMemoryTask(t)
+	if err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	row := r.store.tasks[stored.ID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	// The project of a task never changes
+	stored.ProjectID = row.value.ProjectID
+	if err := r.checkTask(stored); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+	// Only a new assignee is checked: done tasks keep assignees that left the team
+	current := row.value.AssigneeID
+	if stored.AssigneeID != nil && (current == nil || *current != *stored.AssigneeID) &&
+		!slices.Contains(r.store.teams[stored.ProjectID], *stored.AssigneeID) {
+		return ErrNotTeamMember
+	}
+	row.value = stored
+	return nil
+}
+
+// DeleteTask deletes a task by its ID
+func (r *MemoryProjectRepo) DeleteTask(ctx context.Context, id string) error {
+	id, err := memoryID("task", id)
+	if err != nil {
+		return err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if !r.store.tasks[id].visible(ctx) {
+		return ErrNotFound
+	}
+	delete(r.store.tasks, id)
+	return nil
+}
+
+// Progress returns the share of the project's tasks that are DONE
+func (r *MemoryProjectRepo) Progress(ctx context.Context, projectID string) (float64, error) {
+	tasks, err := r.FindTasksByProjectID(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+
+	done := 0
+	for _, t := range tasks {
+		if t.Status == model.TaskStatusDone {
+			done++
+		}
+	}
+	return float64(done) / float64(len(tasks)), nil
+}
+
+// checkMilestone validates a milestone against the schema; the store must be locked
+func (r *MemoryProjectRepo) checkMilestone(m model.Milestone) error {
+	if err := requireNotEmpty("title", m.Title); err != nil {
+		return err
+	}
+	if !m.Status.IsValid() {
+		return fmt.Errorf("invalid milestone status %q", m.Status)
+	}
+	if r.store.projects[m.ProjectID] == nil {
+		return missingReference("project", m.ProjectID)
+	}
+	return nil
+}
+
+// checkTask validates a task against the schema; the store must be locked
+func (r *MemoryProjectRepo) checkTask(t model.Task) error {
+	if err := requireNotEmpty("title", t.Title); err != nil {
+		return err
+	}
+	if !t.Status.IsValid() {
+		return fmt.Errorf("invalid task status %q", t.Status)
+	}
+	if t.EstimateHours != nil && *t.EstimateHours < 0 {
+		return fmt.Errorf("estimate hours must not be negative")
+	}
+	if r.store.projects[t.ProjectID] == nil {
+		return missingReference("project", t.ProjectID)
+	}
+	if t.MilestoneID != nil && r.store.milestones[*t.MilestoneID] == nil {
+		return missingReference("milestone", *t.MilestoneID)
+	}
+	if t.AssigneeID != nil && r.store.employees[*t.AssigneeID] == nil {
+		return missingReference("assignee", *t.AssigneeID)
+	}
+	return nil
+}
+
+// parseMemoryMilestone validates the IDs and due date of m and returns the row to store
+func parseMemoryMilestone(m *model.Milestone) (model.Milestone, error) {
+	id, err := memoryID("milestone", m.ID)
+	if err != nil {
+		return model.Milestone{}, err
+	}
+	projectID, err := memoryID("project", m.ProjectID)
+	if err != nil {
+		return model.Milestone{}, err
+	}
+	if _, err := parseOptionalDate(m.DueDate); err != nil {
+		return model.Milestone{}, fmt.Errorf("invalid due date: %w", err)
+	}
+	return model.Milestone{
+		ID:        id,
+		ProjectID: projectID,
+		Title:     m.Title,
+		DueDate:   cloneString(m.DueDate),
+		Status:    m.Status,
+	}, nil
+}
+
+// parseMemoryTask validates the IDs and due date of t and returns the row to store
+func parseMemoryTask(t *model.Task) (model.Task, error) {
+	id, err := memoryID("task", t.ID)
+	if err != nil {
+		return model.Task{}, err
+	}
+	projectID, err := memoryID("project", t.ProjectID)
+	if err != nil {
+		return model.Task{}, err
+	}
+	milestoneID, err := memoryOptionalID("milestone", t.MilestoneID)
+	if err != nil {
+		return model.Task{}, err
+	}
+	assigneeID, err := memoryOptionalID("assignee", t.AssigneeID)
+	if err != nil {
+		return model.Task{}, err
+	}
+	if _, err := parseOptionalDate(t.DueDate); err != nil {
+		return model.Task{}, fmt.Errorf("invalid due date: %w", err)
+	}
+	return model.Task{
+		ID:            id,
+		ProjectID:     projectID,
+		MilestoneID:   milestoneID,
+		Title:         t.Title,
+		DueDate:       cloneString(t.DueDate),
+		Status:        t.Status,
+		AssigneeID:    assigneeID,
+		EstimateHours: cloneFloat(t.EstimateHours),
+	}, nil
+}
+
+// copyMilestone returns a milestone the caller may modify
+func copyMilestone(m model.Milestone) *model.Milestone {
+	m.DueDate = cloneString(m.DueDate)
+	return &m
+}
+
+// copyTask returns a task the caller may modify
+func copyTask(t model.Task) *model.Task {
+	t.MilestoneID = cloneString(t.MilestoneID)
+	t.DueDate = cloneString(t.DueDate)
+	t.AssigneeID = cloneString(t.AssigneeID)
+	t.EstimateHours = cloneFloat(t.EstimateHours)
+	return &t
+}
@@ -0,0 +1,344 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// MemoryProjectRepo implements ProjectRepository on a MemoryStore
+type MemoryProjectRepo struct {
+	store *MemoryStore
+}
+
+// NewMemoryProjectRepo creates a new project repository on store
+func NewMemoryProjectRepo(store *MemoryStore) ProjectRepository {
+	return &MemoryProjectRepo{store: store}
+}
+
+// Save creates a new project with its team members
+func (r *MemoryProjectRepo) Save(ctx context.Context, proj *model.Project) error {
+	stored, team, err := parseMemoryProject(proj)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := r.check(stored, team); err != nil {
+		return fmt.Errorf("failed to save project: %w", err)
+	}
+	if err := s.checkJoiningMembers(stored.ID, team); err != nil {
+		return err
+	}
+	if _, exists := s.projects[stored.ID]; exists {
+		return fmt.Errorf("failed to save project: %w", ErrAlreadyExists)
+	}
+	s.projects[stored.ID] = newRow(s, ctx, stored)
+	s.teams[stored.ID] = team
+	return nil
+}
+
+// FindByID retrieves a project by its ID with team members
+func (r *MemoryProjectRepo) FindByID(ctx context.Context, id string) (*model.Project, error) {
+	id, err := memoryID("project", id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	row := r.store.projects[id]
+	if !row.visible(ctx) {
+		return nil, ErrNotFound
+	}
+	return r.store.copyProject(row.value), nil
+}
+
+// FindAll retrieves all projects with team members
+func (r *MemoryProjectRepo) FindAll(ctx context.Context) ([]*model.Project, error) {
+	return r.find(ctx, nil), nil
+}
+
+// Update updates an existing project. The status is left alone and the team
+// is replaced only when TeamMembers is not nil.
+func (r *MemoryProjectRepo) Update(ctx context.Context, proj *model.Project) error {
+	stored, team, err := parseMemoryProject(proj)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.projects[stored.ID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	stored.Status = row.value.Status
+	if proj.TeamMembers == nil {
+		team = s.teams[stored.ID]
+	}
+	if err := r.check(stored, team); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+	if err := s.checkJoiningMembers(stored.ID, team); err != nil {
+		return err
+	}
+	row.value = stored
+	s.teams[stored.ID] = team
+	s.unassignNonMembers(stored.ID)
+	return nil
+}
+
+// Delete deletes a project with its milestones, tasks and status history
+func (r *MemoryProjectRepo) Delete(ctx context.Context, id string) error {
+	id, err := memoryID("project", id)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.projects[id].visible(ctx) {
+		return ErrNotFound
+	}
+	for milestoneID, m := range s.milestones {
+		if m.value.ProjectID == id {
+			delete(s.milestones, milestoneID)
+		}
+	}
+	for taskID, t := range s.tasks {
+		if t.value.ProjectID == id {
+			delete(s.tasks, taskID)
+		}
+	}
+	s.statusChanges = slices.DeleteFunc(s.statusChanges, func(c *memoryRow[model.ProjectStatusChange]) bool {
+		return c.value.ProjectID == id
+	})
+	delete(s.teams, id)
+	delete(s.projects, id)
+	return nil
+}
+
+// FindByStatus retrieves all projects with a specific status
+func (r *MemoryProjectRepo) FindByStatus(ctx context.Context, status model.ProjectStatus) ([]*model.Project, error) {
+	return r.find(ctx, func(proj *model.Project) bool {
+		return proj.Status == status
+	}), nil
+}
+
+// FindByEmployeeID retrieves all projects that an employee is working on
+func (r *MemoryProjectRepo) FindByEmployeeID(ctx context.Context, employeeID string) ([]*model.Project, error) {
+	employeeID, err := memoryID("employee", employeeID)
+	if err != nil {
+		return nil, err
+	}
+	return r.find(ctx, func(proj *model.Project) bool {
+		return slices.Contains(r.store.teams[proj.ID], employeeID)
+	}), nil
+}
+
+// AddTeamMember adds an employee to a project's team; adding a member again does nothing
+func (r *MemoryProjectRepo) AddTeamMember(ctx context.Context, projectID string, employeeID string) error {
+	projectID, employeeID, err := parseMembership(projectID, employeeID)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.projects[projectID].visible(ctx) {
+		return ErrNotFound
+	}
+	if s.employees[employeeID] == nil {
+		return fmt.Errorf("failed to add team member: %w", missingReference("employee", employeeID))
+	}
+	if err := s.checkJoiningMembers(projectID, []string{employeeID}); err != nil {
+		return err
+	}
+	if !slices.Contains(s.teams[projectID], employeeID) {
+		s.teams[projectID] = append(s.teams[projectID], employeeID)
+	}
+	return nil
+}
+
+// RemoveTeamMember removes an employee from a project's team
+func (r *MemoryProjectRepo) RemoveTeamMember(ctx context.Context, projectID string, employeeID string) error {
+	projectID, employeeID, err := parseMembership(projectID, employeeID)
+	if err != nil {
+		return err
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.projects[projectID].visible(ctx) {
+		return ErrNotFound
+	}
+	s.removeTeamMember(projectID, employeeID)
+	return nil
+}
+
+// IsTeamMember reports whether an employee is on a project's team
+func (r *MemoryProjectRepo) IsTeamMember(ctx context.Context, projectID string, employeeID string) (bool, error) {
+	projectID, employeeID, err := parseMembership(projectID, employeeID)
+	if err != nil {
+		return false, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.projects[projectID].visible(ctx) && slices.Contains(r.store.teams[projectID], employeeID), nil
+}
+
+// find returns copies of the projects visible from ctx that match keep (nil keeps all)
+func (r *MemoryProjectRepo) find(ctx context.Context, keep func(*model.Project) bool) []*model.Project {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	rows := visibleRows(ctx, r.store.projects, keep)
+	projects := make([]*model.Project, len(rows))
+	for i, row := range rows {
+		projects[i] = r.store.copyProject(row.value)
+	}
+	return projects
+}
+
+// check validates a project and its team against the schema; the store must be locked
+func (r *MemoryProjectRepo) check(proj model.Project, team []string) error {
+	if err := requireNotEmpty("name", proj.Name); err != nil {
+		return err
+	}
+	if !proj.Status.IsValid() {
+		return fmt.Errorf("invalid project status %q", proj.Status)
+	}
+	if !proj.Priority.IsValid() {
+		return fmt.Errorf("invalid project priority %q", proj.Priority)
+	}
+	if proj.Budget <= 0 {
+		return fmt.Errorf("budget must be positive")
+	}
+	if proj.LeadID != nil && r.store.employees[*proj.LeadID] == nil {
+		return missingReference("lead", *proj.LeadID)
+	}
+	for _, memberID := range team {
+		if r.store.employees[memberID] == nil {
+			return missingReference("team member", memberID)
+		}
+	}
+	return nil
+}
+
+// checkJoiningMembers rejects terminated employees who are not yet on the
+// project's team; the store must be locked
+func (s *MemoryStore) checkJoiningMembers(projectID string, team []string) error {
+	for _, memberID := range team {
+		if slices.Contains(s.teams[projectID], memberID) {
+			continue
+		}
+		if s.employees[memberID].value.Status == model.EmploymentStatusTerminated {
+			return ErrEmployeeTerminated
+		}
+	}
+	return nil
+}
+
+// removeTeamMember takes an employee off a project's team and unassigns
+// their open tasks of the project; the store must be locked
+func (s *MemoryStore) removeTeamMember(projectID, employeeID string) {
+	s.teams[projectID] = slices.DeleteFunc(s.teams[projectID], func(id string) bool {
+		return id == employeeID
+	})
+	s.unassignNonMembers(projectID)
+}
+
+// unassignNonMembers clears the assignee of the project's open tasks that is
+// not on its team; done tasks keep it. The store must be locked.
+func (s *MemoryStore) unassignNonMembers(projectID string) {
+	for _, t := range s.tasks {
+		if t.value.ProjectID == projectID && t.value.Status != model.TaskStatusDone &&
+			t.value.AssigneeID != nil && !slices.Contains(s.teams[projectID], *t.value.AssigneeID) {
+			t.value.AssigneeID = nil
+		}
+	}
+}
+
+// copyProject returns a project with its team members that the caller may
+// modify; the store must be locked
+func (s *MemoryStore) copyProject(proj model.Project) *model.Project {
+	proj.Description = cloneString(proj.Description)
+	proj.LeadID = cloneString(proj.LeadID)
+	proj.TeamMembers = make([]*model.Employee, 0, len(s.teams[proj.ID]))
+	for _, memberID := range s.teams[proj.ID] {
+		proj.TeamMembers = append(proj.TeamMembers, copyEmployee(s.employees[memberID].value))
+	}
+	return &proj
+}
+
+// parseMemoryProject validates the IDs and dates of proj and returns the row
+// to store and the IDs of its team members
+func parseMemoryProject(proj *model.Project) (model.Project, []string, error) {
+	id, err := memoryID("project", proj.ID)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	if _, err := time.Parse("2006-01-02", proj.StartDate); err != nil {
+		return model.Project{}, nil, fmt.Errorf("invalid start date format: %w", err)
+	}
+	if _, err := time.Parse("2006-01-02", proj.EndDate); err != nil {
+		return model.Project{}, nil, fmt.Errorf("invalid end date format: %w", err)
+	}
+	leadID, err := memoryOptionalID("lead", proj.LeadID)
+	if err != nil {
+		return model.Project{}, nil, err
+	}
+	team := []string{}
+	for _, member := range proj.TeamMembers {
+		memberID, err := memoryID("team member", member.ID)
+		if err != nil {
+			return model.Project{}, nil, err
+		}
+		if !slices.Contains(team, memberID) {
+			team = append(team, memberID)
+		}
+	}
+
+	return model.Project{
+		ID:          id,
+		Name:        proj.Name,
+		Description: optionalString(proj.Description),
+		Status:      proj.Status,
+		Priority:    proj.Priority,
+		StartDate:   proj.StartDate,
+		EndDate:     proj.EndDate,
+		Budget:      proj.Budget,
+		LeadID:      leadID,
+	}, team, nil
+}
+
+// parseMembership validates the IDs of a team membership
+func parseMembership(projectID, employeeID string) (string, string, error) {
+	projectID, err := memoryID("project", projectID)
+	if err != nil {
+		return "", "", err
+	}
+	employeeID, err = memoryID("employee", employeeID)
+	if err != nil {
+		return "", "", err
+	}
+	return projectID, employeeID, nil
+}
@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryFixture is the memory repositories with a department, an employee
+// and an ACTIVE project the employee works on
+type memoryFixture struct {
+	deptRepo DepartmentRepository
+	empRepo  EmployeeRepository
+	projRepo ProjectRepository
+	dept     *model.Department
+	emp      *model.Employee
+	proj     *model.Project
+}
+
+func setupMemoryFixture(t *testing.T) *memoryFixture {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	f := &memoryFixture{
+		deptRepo: NewMemoryDepartmentRepo(store),
+		empRepo:  NewMemoryEmployeeRepo(store),
+		projRepo: NewMemoryProjectRepo(store),
+	}
+	f.dept = &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, f.deptRepo.Save(ctx, f.dept))
+	f.emp = &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: f.dept.ID}
+	require.NoError(t, f.empRepo.Save(ctx, f.emp))
+	f.proj = &model.Project{
+		ID: uuid.NewString(), Name: "Apollo", Status: model.ProjectStatusActive, Priority: model.ProjectPriorityMedium,
+		StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000, LeadID: &f.emp.ID,
+		TeamMembers: []*model.Employee{{ID: f.emp.ID}},
+	}
+	require.NoError(t, f.projRepo.Save(ctx, f.proj))
+	return f
+}
+
+func TestMemoryProjectRepo_SaveAndFind(t *testing.T) {
+	// Setup
+	f := setupMemoryFixture(t)
+	ctx := context.Background()
+
+	// Test
+	found, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	found.TeamMembers[0].Name = "Changed by caller"
+	byEmployee, err := f.projRepo.FindByEmployeeID(ctx, f.emp.ID)
+	require.NoError(t, err)
+	isMember, err := f.projRepo.IsTeamMember(ctx, f.proj.ID, f.emp.ID)
+	require.NoError(t, err)
+
+	// Assert: the team is loaded and returned values are copies
+	assert.Equal(t, "Apollo", found.Name)
+	assert.Equal(t, f.emp.ID, *found.LeadID)
+	assert.Equal(t, model.EmploymentStatusActive, found.TeamMembers[0].Status)
+	require.Len(t, byEmployee, 1)
+	assert.Equal(t, "Ann", byEmployee[0].TeamMembers[0].Name)
+	assert.True(t, isMember)
+
+	_, err = f.projRepo.FindByID(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, ErrNotFound)
+	err = f.projRepo.AddTeamMember(ctx, f.proj.ID, uuid.NewString())
+	assert.Error(t, err)
+}
+
+func TestMemoryProjectRepo_UpdateKeepsStatusAndTeam(t *testing.T) {
+	// Setup
+	f := setupMemoryFixture(t)
+	ctx := context.Background()
+
+	// Test: update without a team and with another status
+	update := *f.proj
+	update.Name = "Artemis"
+	update.Status = model.ProjectStatusCompleted
+	update.TeamMembers = nil
+	require.NoError(t, f.projRepo.Update(ctx, &update))
+
+	// Assert
+	found, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Artemis", found.Name)
+	assert.Equal(t, model.ProjectStatusActive, found.Status)
+	assert.Len(t, found.TeamMembers, 1)
+}
+
+func TestMemoryProjectRepo_PlanningAndStatus(t *testing.T) {
+	// Setup
+	f := setupMemoryFixture(t)
+	ctx := context.Background()
+	early, late := "2025-03-01", "2025-06-01"
+	milestone := &model.Milestone{ID: uuid.NewString(), ProjectID: f.proj.ID, Title: "Beta", DueDate: &late}
+	require.NoError(t, f.projRepo.SaveMilestone(ctx, milestone))
+	hours := 8.0
+	tasks := []*model.Task{
+		{ID: uuid.NewString(), ProjectID: f.proj.ID, Title: "Ship", DueDate: &late, MilestoneID: &milestone.ID, AssigneeID: &f.emp.ID, EstimateHours: &hours},
+		{ID: uuid.NewString(), ProjectID: f.proj.ID, Title: "Design", DueDate: &early, Status: model.TaskStatusDone},
+	}
+	for _, task := range tasks {
+		require.NoError(t, f.projRepo.SaveTask(ctx, task))
+	}
+
+	// Test
+	byProject, err := f.projRepo.FindTasksByProjectID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	progress, err := f.projRepo.Progress(ctx, f.proj.ID)
+	require.NoError(t, err)
+	loads, err := f.projRepo.Workload(ctx, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	change := &model.ProjectStatusChange{ProjectID: f.proj.ID, From: model.ProjectStatusActive, To: model.ProjectStatusOnHold}
+	require.NoError(t, f.projRepo.TransitionStatus(ctx, change))
+	conflictErr := f.projRepo.TransitionStatus(ctx, &model.ProjectStatusChange{ProjectID: f.proj.ID, From: model.ProjectStatusActive, To: model.ProjectStatusCompleted})
+	require.NoError(t, f.projRepo.DeleteMilestone(ctx, milestone.ID))
+	unscheduled, err := f.projRepo.FindTaskByID(ctx, tasks[0].ID)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, byProject, 2)
+	assert.Equal(t, "Design", byProject[0].Title)
+	assert.Equal(t, model.TaskStatusTodo, byProject[1].Status)
+	assert.InDelta(t, 0.5, progress, 0.001)
+	require.Len(t, loads, 1)
+	assert.Len(t, loads[0].Projects, 1)
+	assert.InDelta(t, 8.0, loads[0].OpenTaskHours, 0.001)
+	assert.NotEmpty(t, change.ID)
+	assert.ErrorIs(t, conflictErr, ErrStatusConflict)
+	history, err := f.projRepo.FindStatusChanges(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ProjectStatusChange{change}, history)
+	assert.Nil(t, unscheduled.MilestoneID)
+}
+
+func TestMemoryRepos_Cascades(t *testing.T) {
+	// Setup
+	f := setupMemoryFixture(t)
+	ctx := context.Background()
+	task := &model.Task{ID: uuid.NewString(), ProjectID: f.proj.ID, Title: "Ship", AssigneeID: &f.emp.ID}
+	require.NoError(t, f.projRepo.SaveTask(ctx, task))
+
+	// Test & Assert: a department with employees cannot be deleted
+	assert.Error(t, f.deptRepo.Delete(ctx, f.dept.ID))
+
+	// Deleting the employee clears the team, the lead and the assignment
+	require.NoError(t, f.empRepo.Delete(ctx, f.emp.ID))
+	proj, err := f.projRepo.FindByID(ctx, f.proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, proj.TeamMembers)
+	assert.Nil(t, proj.LeadID)
+	found, err := f.projRepo.FindTaskByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.AssigneeID)
+
+	// Deleting the project deletes its tasks
+	require.NoError(t, f.projRepo.Delete(ctx, f.proj.ID))
+	_, err = f.projRepo.FindTaskByID(ctx, task.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, f.deptRepo.Delete(ctx, f.dept.ID))
+}
+
+func TestMemoryRepos_TenantScoping(t *testing.T) {
+	// Setup
+	f := setupMemoryFixture(t)
+	globex := tenant.NewContext(context.Background(), "globex")
+
+	// Test
+	_, findErr := f.projRepo.FindByID(globex, f.proj.ID)
+	all, err := f.empRepo.FindAll(globex)
+	require.NoError(t, err)
+	deleteErr := f.deptRepo.Delete(globex, f.dept.ID)
+	sameEmail := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: f.emp.Email, DepartmentID: f.dept.ID}
+	otherTenantErr := f.empRepo.Save(globex, sameEmail)
+	duplicate := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: f.emp.Email, DepartmentID: f.dept.ID}
+	duplicateErr := f.empRepo.Save(context.Background(), duplicate)
+
+	// Assert: rows of other tenants are invisible; emails are unique per tenant
+	assert.ErrorIs(t, findErr, ErrNotFound)
+	assert.Empty(t, all)
+	assert.ErrorIs(t, deleteErr, ErrNotFound)
+	assert.NoError(t, otherTenantErr)
+	assert.ErrorIs(t, duplicateErr, ErrAlreadyExists)
+}
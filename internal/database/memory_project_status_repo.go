@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// Status workflow operations of MemoryProjectRepo
+
+// TransitionStatus moves a project to a new status and records the change
+func (r *MemoryProjectRepo) TransitionStatus(ctx context.Context, change *model.ProjectStatusChange) error {
+	projectID, err := memoryID("project", change.ProjectID)
+	if err != nil {
+		return err
+	}
+	if !change.To.IsValid() {
+		return fmt.Errorf("failed to update project status: invalid project status %q", change.To)
+	}
+
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	row := s.projects[projectID]
+	if !row.visible(ctx) {
+		return ErrNotFound
+	}
+	if row.value.Status != change.From {
+		return ErrStatusConflict
+	}
+	row.value.Status = change.To
+
+	recorded := model.ProjectStatusChange{
+		ID:        uuid.NewString(),
+		ProjectID: projectID,
+		From:      change.From,
+		To:        change.To,
+		Reason:    optionalString(change.Reason),
+		ChangedBy: optionalString(change.ChangedBy),
+		ChangedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	changeRow := newRow(s, ctx, recorded)
+	changeRow.tenantID = row.tenantID
+	s.statusChanges = append(s.statusChanges, changeRow)
+
+	change.ID = recorded.ID
+	change.ChangedAt = recorded.ChangedAt
+	return nil
+}
+
+// FindStatusChanges retrieves a project's status history, oldest first
+func (r *MemoryProjectRepo) FindStatusChanges(ctx context.Context, projectID string) ([]*model.ProjectStatusChange, error) {
+	projectID, err := memoryID("project", projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	changes := []*model.ProjectStatusChange{}
+	for _, row := range r.store.statusChanges {
+		if row.visible(ctx) && row.value.ProjectID == projectID {
+			c := row.value
+			c.Reason = cloneString(c.Reason)
+			c.ChangedBy = cloneString(c.ChangedBy)
+			changes = append(changes, &c)
+		}
+	}
+	return changes, nil
+}
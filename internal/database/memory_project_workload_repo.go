@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// Workload report of MemoryProjectRepo
+
+// Workload returns the employees that are not terminated, ordered by name,
+// with their overlapping ACTIVE projects and open task hours in the window
+func (r *MemoryProjectRepo) Workload(ctx context.Context, from, to time.Time) ([]*EmployeeLoad, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// A project overlaps the window when it starts before its end and ends after its start
+	projects := visibleRows(ctx, s.projects, func(proj *model.Project) bool {
+		return proj.Status == model.ProjectStatusActive &&
+			!parseMemoryDate(proj.StartDate).After(to) &&
+			!parseMemoryDate(proj.EndDate).Before(from)
+	})
+	sort.SliceStable(projects, func(i, j int) bool { return projects[i].value.Name < projects[j].value.Name })
+
+	// Sum estimates of open tasks of ACTIVE projects due in the window per assignee
+	hours := make(map[string]float64)
+	for _, row := range visibleRows(ctx, s.tasks, nil) {
+		t := row.value
+		if t.AssigneeID == nil || t.Status == model.TaskStatusDone || t.EstimateHours == nil || t.DueDate == nil {
+			continue
+		}
+		if s.projects[t.ProjectID].value.Status != model.ProjectStatusActive {
+			continue
+		}
+		if due := parseMemoryDate(*t.DueDate); !due.Before(from) && !due.After(to) {
+			hours[*t.AssigneeID] += *t.EstimateHours
+		}
+	}
+
+	employees := visibleRows(ctx, s.employees, func(emp *model.Employee) bool {
+		return emp.Status != model.EmploymentStatusTerminated
+	})
+	sort.SliceStable(employees, func(i, j int) bool { return employees[i].value.Name < employees[j].value.Name })
+
+	loads := make([]*EmployeeLoad, len(employees))
+	for i, emp := range employees {
+		load := &EmployeeLoad{
+			Employee:      copyEmployee(emp.value),
+			OpenTaskHours: hours[emp.value.ID],
+		}
+		for _, proj := range projects {
+			if slices.Contains(s.teams[proj.value.ID], emp.value.ID) {
+				load.Projects = append(load.Projects, s.copyProject(proj.value))
+				load.ProjectCount++
+			}
+		}
+		loads[i] = load
+	}
+	return loads, nil
+}
+
+// parseMemoryDate parses a date that was validated when it was stored
+func parseMemoryDate(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/google/uuid"
+)
+
+// MemoryStore holds the rows of the memory storage driver. The memory
+// repositories share one store so that cascades behave like the foreign keys
+// of the SQL drivers: deleting an employee removes it from project teams,
+// deleting a project deletes its milestones and tasks, and so on.
+//
+// Rows are scoped to the tenant of the context like the Ent repositories.
+// The Ent privacy policies and outbox events (which feed webhooks) are not
+// applied, so the memory driver is meant for demos and tests.
+type MemoryStore struct {
+	mu  sync.RWMutex
+	seq uint64 // Insertion counter; lists are returned in insertion order
+
+	departments   map[string]*memoryRow[model.Department]
+	employees     map[string]*memoryRow[model.Employee]
+	projects      map[string]*memoryRow[model.Project]
+	teams         map[string][]string // Project ID to team member IDs, in the order they joined
+	milestones    map[string]*memoryRow[model.Milestone]
+	tasks         map[string]*memoryRow[model.Task]
+	statusChanges []*memoryRow[model.ProjectStatusChange]
+}
+
+// memoryRow is a stored row with the tenant it belongs to. Milestones, tasks
+// and status changes belong to the tenant of their project.
+type memoryRow[T any] struct {
+	tenantID string
+	seq      uint64
+	value    T
+}
+
+// NewMemoryStore creates an empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		departments: make(map[string]*memoryRow[model.Department]),
+		employees:   make(map[string]*memoryRow[model.Employee]),
+		projects:    make(map[string]*memoryRow[model.Project]),
+		teams:       make(map[string][]string),
+		milestones:  make(map[string]*memoryRow[model.Milestone]),
+		tasks:       make(map[string]*memoryRow[model.Task]),
+	}
+}
+
+// newRow creates a row of the tenant of ctx (the default tenant for unscoped contexts)
+func newRow[T any](s *MemoryStore, ctx context.Context, value T) *memoryRow[T] {
+	tenantID := tenant.Default
+	if !tenant.IsUnscoped(ctx) {
+		tenantID = tenant.FromContext(ctx)
+	}
+	s.seq++
+	return &memoryRow[T]{tenantID: tenantID, seq: s.seq, value: value}
+}
+
+// visible reports whether the row can be read and changed from ctx
+func (row *memoryRow[T]) visible(ctx context.Context) bool {
+	return row != nil && (tenant.IsUnscoped(ctx) || row.tenantID == tenant.FromContext(ctx))
+}
+
+// visibleRows returns the rows visible from ctx that match keep (nil keeps
+// all), in insertion order
+func visibleRows[T any](ctx context.Context, rows map[string]*memoryRow[T], keep func(*T) bool) []*memoryRow[T] {
+	result := make([]*memoryRow[T], 0, len(rows))
+	for _, row := range rows {
+		if row.visible(ctx) && (keep == nil || keep(&row.value)) {
+			result = append(result, row)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].seq < result[j].seq })
+	return result
+}
+
+// memoryID parses a UUID and returns its canonical form, which rows are keyed by
+func memoryID(kind, s string) (string, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s ID: %w", kind, err)
+	}
+	return id.String(), nil
+}
+
+// memoryOptionalID is memoryID for optional references
+func memoryOptionalID(kind string, s *string) (*string, error) {
+	if s == nil {
+		return nil, nil
+	}
+	id, err := memoryID(kind, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// missingReference is the error of a row referring to one that does not
+// exist, where the SQL drivers fail with a foreign key violation
+func missingReference(kind, id string) error {
+	return fmt.Errorf("%s %s does not exist", kind, id)
+}
+
+// requireNotEmpty mirrors the NotEmpty validators of the Ent schema
+func requireNotEmpty(field, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not be empty", field)
+	}
+	return nil
+}
+
+// optionalString stores an optional text field the way Ent reads it back:
+// an empty string is the same as no value
+func optionalString(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	v := *s
+	return &v
+}
+
+// cloneString copies an optional value so stored rows never share memory with callers
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := *s
+	return &v
+}
+
+func cloneFloat(f *float64) *float64 {
+	if f == nil {
+		return nil
+	}
+	v := *f
+	return &v
+}
+
+// sortByDueDate orders milestones and tasks by due date, undated last, then by creation
+func sortByDueDate[T any](rows []*memoryRow[T], dueDate func(*T) *string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := dueDate(&rows[i].value), dueDate(&rows[j].value)
+		switch {
+		case a != nil && b != nil && *a != *b:
+			return *a < *b
+		case (a == nil) != (b == nil):
+			return a != nil
+		}
+		return rows[i].seq < rows[j].seq
+	})
+}
@@ -0,0 +1,254 @@
+package database
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/logger"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// ReplicaDriver routes Ent statements between a primary and read replicas:
+// SELECT queries go to a healthy replica (round-robin), everything else and
+// every transaction goes to the primary. Reads also go to the primary when
+// no replica is healthy, and for the rest of a context prepared by
+// WithReadYourWrites once a write was made through it.
+type ReplicaDriver struct {
+	primary  dialect.Driver
+	replicas []*replica
+	next     atomic.Uint64
+}
+
+// replica is a read replica and whether it currently takes reads
+type replica struct {
+	name    string
+	driver  dialect.Driver
+	healthy atomic.Bool
+}
+
+var _ dialect.Driver = (*ReplicaDriver)(nil)
+
+const defaultReplicaCheckInterval = 10 * time.Second
+
+// NewReplicaDriver creates a driver routing between primary and replicas,
+// all of which start out healthy
+func NewReplicaDriver(primary dialect.Driver, replicas ...dialect.Driver) *ReplicaDriver {
+	d := &ReplicaDriver{primary: primary}
+	for i, drv := range replicas {
+		r := &replica{name: fmt.Sprintf("replica-%d", i), driver: drv}
+		r.healthy.Store(true)
+		d.replicas = append(d.replicas, r)
+	}
+	return d
+}
+
+// NewEntReplicaDrivers opens a driver for each DSN in cfg.Replicas with the
+// pool settings of the primary
+func NewEntReplicaDrivers(cfg *config.DatabaseConfig) ([]dialect.Driver, error) {
+	drivers := make([]dialect.Driver, 0, len(cfg.Replicas))
+	for i, dsn := range cfg.Replicas {
+		drv, err := entsql.Open("postgres", dsn)
+		if err != nil {
+			for _, opened := range drivers {
+				_ = opened.Close()
+			}
+			return nil, fmt.Errorf("failed to open read replica %d: %w", i, err)
+		}
+		db := drv.DB()
+		db.SetMaxOpenConns(cfg.MaxConns)
+		db.SetMaxIdleConns(cfg.MinConns)
+		db.SetConnMaxLifetime(time.Hour)
+		drivers = append(drivers, drv)
+	}
+	return drivers, nil
+}
+
+// Exec implements dialect.ExecQuerier. Statements run on the primary.
+func (d *ReplicaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	UsePrimary(ctx)
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query implements dialect.ExecQuerier. SELECT statements run on a healthy
+// replica; a replica that cannot be reached is taken out of rotation until its
+// next successful health check and the query is retried on the primary. Other
+// errors (bad SQL, constraint or privacy failures) are the query's own and
+// returned as they are.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	if !isReadStatement(query) {
+		// e.g. INSERT ... RETURNING
+		UsePrimary(ctx)
+		return d.primary.Query(ctx, query, args, v)
+	}
+	if r := d.pickReplica(ctx); r != nil {
+		err := r.driver.Query(ctx, query, args, v)
+		if err == nil || ctx.Err() != nil || !isConnectionError(err) {
+			return err
+		}
+		d.markUnhealthy(r, err)
+	}
+	return d.primary.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction on the primary
+func (d *ReplicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	UsePrimary(ctx)
+	return d.primary.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on the primary when it supports them
+// Ent's Client.BeginTx relies on this method being present
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.primary.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.BeginTx is not supported")
+	}
+	UsePrimary(ctx)
+	return drv.BeginTx(ctx, opts)
+}
+
+// Dialect implements dialect.Driver
+func (d *ReplicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// Close closes the primary and every replica
+func (d *ReplicaDriver) Close() error {
+	errs := []error{d.primary.Close()}
+	for _, r := range d.replicas {
+		errs = append(errs, r.driver.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// HealthyReplicas returns how many replicas currently take reads
+func (d *ReplicaDriver) HealthyReplicas() int {
+	healthy := 0
+	for _, r := range d.replicas {
+		if r.healthy.Load() {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+// CheckReplicas runs a trivial query on every replica and updates whether it takes reads
+func (d *ReplicaDriver) CheckReplicas(ctx context.Context) {
+	for _, r := range d.replicas {
+		var rows entsql.Rows
+		err := r.driver.Query(ctx, "SELECT 1", []any{}, &rows)
+		if err == nil {
+			err = rows.Close()
+		}
+		if err != nil {
+			d.markUnhealthy(r, err)
+			continue
+		}
+		if !r.healthy.Swap(true) {
+			log := logger.WithComponent("ReplicaDriver")
+			log.Info().
+				Str("replica", r.name).
+				Msg("Read replica recovered, routing reads to it again")
+		}
+	}
+}
+
+// RunHealthChecks checks the replicas every interval (default 10s) until ctx is cancelled
+func (d *ReplicaDriver) RunHealthChecks(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultReplicaCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.CheckReplicas(ctx)
+		}
+	}
+}
+
+// pickReplica returns the next healthy replica, or nil when reads must go to the primary
+func (d *ReplicaDriver) pickReplica(ctx context.Context) *replica {
+	if len(d.replicas) == 0 || primarySticky(ctx) {
+		return nil
+	}
+	start := d.next.Add(1)
+	for i := range d.replicas {
+		r := d.replicas[(start+uint64(i))%uint64(len(d.replicas))]
+		if r.healthy.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+func (d *ReplicaDriver) markUnhealthy(r *replica, err error) {
+	if r.healthy.Swap(false) {
+		log := logger.WithComponent("ReplicaDriver")
+		log.Warn().
+			Err(err).
+			Str("replica", r.name).
+			Msg("Read replica failed, routing its reads to the primary")
+	}
+}
+
+// isConnectionError reports whether err means the database could not be
+// reached or dropped the connection, rather than rejecting the statement
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, stdsql.ErrConnDone) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.As(err, &netErr)
+}
+
+// isReadStatement reports whether query is a SELECT that takes no row locks
+func isReadStatement(query string) bool {
+	query = strings.TrimLeft(query, " \t\r\n(")
+	if len(query) < 6 || !strings.EqualFold(query[:6], "SELECT") {
+		return false
+	}
+	upper := strings.ToUpper(query)
+	return !strings.Contains(upper, " FOR UPDATE") && !strings.Contains(upper, " FOR SHARE")
+}
+
+type readYourWritesKey struct{}
+
+// WithReadYourWrites returns a copy of ctx whose reads go to the primary once
+// a write was made through it (or a context derived from it), so a request
+// sees its own changes even while replicas lag behind
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, new(atomic.Bool))
+}
+
+// UsePrimary sends the remaining reads of a context prepared by
+// WithReadYourWrites to the primary; it does nothing for other contexts
+func UsePrimary(ctx context.Context) {
+	if sticky, ok := ctx.Value(readYourWritesKey{}).(*atomic.Bool); ok {
+		sticky.Store(true)
+	}
+}
+
+// primarySticky reports whether reads of ctx must go to the primary
+func primarySticky(ctx context.Context) bool {
+	sticky, ok := ctx.Value(readYourWritesKey{}).(*atomic.Bool)
+	return ok && sticky.Load()
+}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/graph/model"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openReplicaTestDB opens an empty in-memory SQLite database named after the test and name
+func openReplicaTestDB(t *testing.T, name string) *entsql.Driver {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", url.PathEscape(t.Name()+"-"+name))
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { drv.Close() })
+	return drv
+}
+
+// migrate creates the schema in drv
+func migrate(t *testing.T, drv dialect.Driver) {
+	require.NoError(t, ent.NewClient(ent.Driver(drv)).Schema.Create(auth.WithSystem(context.Background())))
+}
+
+// setupReplicaRouting returns a department repository routed by a
+// ReplicaDriver, the driver, and a repository writing to the primary
+// directly. The replica holds a "Replica" department, the primary does not.
+func setupReplicaRouting(t *testing.T, migrateReplica bool) (DepartmentRepository, *ReplicaDriver, DepartmentRepository) {
+	primary := openReplicaTestDB(t, "primary")
+	replica := openReplicaTestDB(t, "replica")
+	migrate(t, primary)
+	if migrateReplica {
+		migrate(t, replica)
+		replicaRepo := NewEntDepartmentRepo(ent.NewClient(ent.Driver(replica)))
+		require.NoError(t, replicaRepo.Save(auth.WithSystem(context.Background()), &model.Department{ID: uuid.NewString(), Name: "Replica"}))
+	}
+
+	router := NewReplicaDriver(primary, replica)
+	routed := NewEntDepartmentRepo(ent.NewClient(ent.Driver(router)))
+	return routed, router, NewEntDepartmentRepo(ent.NewClient(ent.Driver(primary)))
+}
+
+func departmentNames(t *testing.T, ctx context.Context, repo DepartmentRepository) []string {
+	depts, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	names := []string{}
+	for _, dept := range depts {
+		names = append(names, dept.Name)
+	}
+	return names
+}
+
+func TestReplicaDriver_RoutesReadsToReplicasAndWritesToPrimary(t *testing.T) {
+	// Setup
+	routed, _, primaryRepo := setupReplicaRouting(t, true)
+	ctx := auth.WithSystem(context.Background())
+
+	// Test
+	saveErr := routed.Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Engineering"})
+
+	// Assert: the write reached the primary, reads still come from the replica
+	require.NoError(t, saveErr)
+	assert.Equal(t, []string{"Engineering"}, departmentNames(t, ctx, primaryRepo))
+	assert.Equal(t, []string{"Replica"}, departmentNames(t, ctx, routed))
+}
+
+func TestReplicaDriver_ReadYourWrites(t *testing.T) {
+	// Setup
+	routed, _, _ := setupReplicaRouting(t, true)
+	ctx := WithReadYourWrites(auth.WithSystem(context.Background()))
+	assert.Equal(t, []string{"Replica"}, departmentNames(t, ctx, routed))
+
+	// Test
+	require.NoError(t, routed.Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Engineering"}))
+
+	// Assert: the rest of the request reads from the primary, other requests do not
+	assert.Equal(t, []string{"Engineering"}, departmentNames(t, ctx, routed))
+	assert.Equal(t, []string{"Replica"}, departmentNames(t, WithReadYourWrites(auth.WithSystem(context.Background())), routed))
+}
+
+// flakyDriver fails every query with a dropped connection while down
+type flakyDriver struct {
+	dialect.Driver
+	down atomic.Bool
+}
+
+func (d *flakyDriver) Query(ctx context.Context, query string, args, v any) error {
+	if d.down.Load() {
+		return driver.ErrBadConn
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func TestReplicaDriver_FallsBackToPrimary(t *testing.T) {
+	// Setup: the replica drops its connections
+	primary := openReplicaTestDB(t, "primary")
+	replica := &flakyDriver{Driver: openReplicaTestDB(t, "replica")}
+	migrate(t, primary)
+	migrate(t, replica)
+	replica.down.Store(true)
+	router := NewReplicaDriver(primary, replica)
+	routed := NewEntDepartmentRepo(ent.NewClient(ent.Driver(router)))
+	ctx := auth.WithSystem(context.Background())
+	require.NoError(t, NewEntDepartmentRepo(ent.NewClient(ent.Driver(primary))).Save(ctx, &model.Department{ID: uuid.NewString(), Name: "Engineering"}))
+
+	// Test & Assert: the failed read is retried on the primary
+	assert.Equal(t, []string{"Engineering"}, departmentNames(t, ctx, routed))
+	assert.Equal(t, 0, router.HealthyReplicas())
+	assert.Equal(t, []string{"Engineering"}, departmentNames(t, ctx, routed))
+
+	// The next health check restores the replica once it answers again
+	replica.down.Store(false)
+	router.CheckReplicas(ctx)
+	assert.Equal(t, 1, router.HealthyReplicas())
+	assert.Empty(t, departmentNames(t, ctx, routed))
+}
+
+func TestReplicaDriver_ReturnsQueryErrors(t *testing.T) {
+	// Setup: the replica has no tables, so its queries fail
+	routed, router, _ := setupReplicaRouting(t, false)
+
+	// Test
+	_, err := routed.FindAll(auth.WithSystem(context.Background()))
+
+	// Assert: the error is the query's own, the replica keeps taking reads
+	assert.ErrorContains(t, err, "no such table")
+	assert.Equal(t, 1, router.HealthyReplicas())
+}
+
+func TestIsConnectionError(t *testing.T) {
+	assert.True(t, isConnectionError(fmt.Errorf("querying: %w", driver.ErrBadConn)))
+	assert.True(t, isConnectionError(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.True(t, isConnectionError(io.ErrUnexpectedEOF))
+	assert.False(t, isConnectionError(errors.New("no such table: departments")))
+	assert.False(t, isConnectionError(context.Canceled))
+}
+
+func TestIsReadStatement(t *testing.T) {
+	assert.True(t, isReadStatement(`SELECT "id" FROM "departments"`))
+	assert.True(t, isReadStatement(` (select 1)`))
+	assert.False(t, isReadStatement(`INSERT INTO "departments" ("id") VALUES ($1) RETURNING "id"`))
+	assert.False(t, isReadStatement(`SELECT "id" FROM "departments" FOR UPDATE`))
+	assert.False(t, isReadStatement(`WITH moved AS (DELETE FROM t RETURNING *) SELECT * FROM moved`))
+}
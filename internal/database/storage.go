@@ -0,0 +1,108 @@
+package database
+
+import (
+	stdsql "database/sql"
+	"fmt"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// Storage drivers selectable with database.driver
+const (
+	DriverPostgres = "postgres" // Ent on PostgreSQL (default)
+	DriverSQLite   = "sqlite"   // Ent on a SQLite file, for local demos
+	DriverMemory   = "memory"   // In-process maps; data is lost on restart
+)
+
+// Storage is an opened storage backend with the repositories built on it
+type Storage struct {
+	Driver string
+
+	Departments DepartmentRepository
+	Employees   EmployeeRepository
+	Projects    ProjectRepository
+
+	// Client is the Ent client of the SQL drivers, which the skill, webhook,
+	// idempotency and outbox repositories need; nil for the memory driver
+	Client *ent.Client
+
+	// DB is the connection pool of the primary database (health checks and
+	// pool metrics); nil for the memory driver
+	DB *stdsql.DB
+
+	// Replicas routes queries to the read replicas when postgres has some configured
+	Replicas *ReplicaDriver
+}
+
+// NewStorage opens the storage driver selected by cfg.Driver and builds the
+// repositories on it. For the SQL drivers, wrap (when not nil) wraps the
+// Ent driver before the client is created and migrated, e.g. for tracing.
+func NewStorage(cfg *config.DatabaseConfig, wrap func(dialect.Driver) dialect.Driver) (*Storage, error) {
+	driver := cfg.Driver
+	if driver == "" {
+		driver = DriverPostgres
+	}
+	if len(cfg.Replicas) > 0 && driver != DriverPostgres {
+		return nil, fmt.Errorf("read replicas require the %s driver, not %s", DriverPostgres, driver)
+	}
+
+	var drv *entsql.Driver
+	var err error
+	switch driver {
+	case DriverMemory:
+		store := NewMemoryStore()
+		return &Storage{
+			Driver:      driver,
+			Departments: NewMemoryDepartmentRepo(store),
+			Employees:   NewMemoryEmployeeRepo(store),
+			Projects:    NewMemoryProjectRepo(store),
+		}, nil
+	case DriverPostgres:
+		drv, err = NewEntDriver(cfg)
+	case DriverSQLite:
+		drv, err = NewEntSQLiteDriver(cfg)
+	default:
+		return nil, fmt.Errorf("unknown database driver %q (want %s, %s or %s)", cfg.Driver, DriverPostgres, DriverSQLite, DriverMemory)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	storage := &Storage{Driver: driver, DB: drv.DB()}
+	var routed dialect.Driver = drv
+	if len(cfg.Replicas) > 0 {
+		replicas, err := NewEntReplicaDrivers(cfg)
+		if err != nil {
+			_ = drv.Close()
+			return nil, err
+		}
+		storage.Replicas = NewReplicaDriver(drv, replicas...)
+		routed = storage.Replicas
+	}
+	if wrap != nil {
+		routed = wrap(routed)
+	}
+
+	client, err := NewEntClientWithDriver(routed)
+	if err != nil {
+		_ = routed.Close()
+		return nil, err
+	}
+	storage.Client = client
+	storage.Departments = NewEntDepartmentRepo(client)
+	storage.Employees = NewEntEmployeeRepo(client)
+	storage.Projects = NewEntProjectRepo(client)
+	return storage, nil
+}
+
+// Close closes the Ent client and its connections; it does nothing for the memory driver
+func (s *Storage) Close() error {
+	if s.Client == nil {
+		return nil
+	}
+	return CloseEntClient(s.Client)
+}
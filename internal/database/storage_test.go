@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorage_Memory(t *testing.T) {
+	// Test
+	storage, err := NewStorage(&config.DatabaseConfig{Driver: DriverMemory}, nil)
+	require.NoError(t, err)
+	defer storage.Close()
+
+	// Assert: repositories share one store and there is no Ent client
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, storage.Departments.Save(auth.WithSystem(context.Background()), dept))
+	emp := &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID}
+	assert.NoError(t, storage.Employees.Save(auth.WithSystem(context.Background()), emp))
+	assert.Nil(t, storage.Client)
+	assert.Nil(t, storage.DB)
+	_, err = NewUnsupportedSkillRepo(storage.Driver).FindAll(auth.WithSystem(context.Background()))
+	assert.True(t, errors.Is(err, errors.ErrUnsupported))
+}
+
+func TestNewStorage_SQLiteFile(t *testing.T) {
+	// Setup
+	cfg := &config.DatabaseConfig{Driver: DriverSQLite, SQLitePath: filepath.Join(t.TempDir(), "demo.db"), MaxConns: 4, MinConns: 1}
+	storage, err := NewStorage(cfg, nil)
+	require.NoError(t, err)
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, storage.Departments.Save(auth.WithSystem(context.Background()), dept))
+	require.NoError(t, storage.Close())
+
+	// Test: reopen the file
+	reopened, err := NewStorage(cfg, nil)
+	require.NoError(t, err)
+	defer reopened.Close()
+	found, err := reopened.Departments.FindByID(auth.WithSystem(context.Background()), dept.ID)
+
+	// Assert: the data survived and the Ent client is available
+	require.NoError(t, err)
+	assert.Equal(t, "Engineering", found.Name)
+	assert.NotNil(t, reopened.Client)
+	assert.NotNil(t, reopened.DB)
+}
+
+func TestNewStorage_InvalidConfig(t *testing.T) {
+	_, err := NewStorage(&config.DatabaseConfig{Driver: "mongodb"}, nil)
+	assert.ErrorContains(t, err, "unknown database driver")
+
+	_, err = NewStorage(&config.DatabaseConfig{Driver: DriverSQLite}, nil)
+	assert.ErrorContains(t, err, "sqlite_path")
+
+	_, err = NewStorage(&config.DatabaseConfig{Driver: DriverMemory, Replicas: []string{"postgres://replica"}}, nil)
+	assert.ErrorContains(t, err, "read replicas")
+}
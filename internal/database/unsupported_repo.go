@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-crud-api/internal/graph/model"
+)
+
+// The skill catalog and webhooks are stored with Ent only. Storage drivers
+// without an Ent client (memory) get these repositories instead, which fail
+// every call with an error wrapping errors.ErrUnsupported.
+
+// UnsupportedSkillRepo is the SkillRepository of drivers without skill support
+type UnsupportedSkillRepo struct {
+	err error
+}
+
+// NewUnsupportedSkillRepo creates a skill repository failing every call for driver
+func NewUnsupportedSkillRepo(driver string) SkillRepository {
+	return &UnsupportedSkillRepo{err: unsupported("skills", driver)}
+}
+
+func (r *UnsupportedSkillRepo) Save(context.Context, *model.Skill) error { return r.err }
+
+func (r *UnsupportedSkillRepo) FindByID(context.Context, string) (*model.Skill, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedSkillRepo) FindAll(context.Context) ([]*model.Skill, error) { return nil, r.err }
+
+func (r *UnsupportedSkillRepo) Update(context.Context, *model.Skill) error { return r.err }
+
+func (r *UnsupportedSkillRepo) Delete(context.Context, string) error { return r.err }
+
+func (r *UnsupportedSkillRepo) SetEmployeeSkill(context.Context, string, string, int) error {
+	return r.err
+}
+
+func (r *UnsupportedSkillRepo) RemoveEmployeeSkill(context.Context, string, string) error {
+	return r.err
+}
+
+func (r *UnsupportedSkillRepo) FindEmployeeSkills(context.Context, string) ([]*model.EmployeeSkill, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedSkillRepo) SetProjectSkill(context.Context, string, string, int) error {
+	return r.err
+}
+
+func (r *UnsupportedSkillRepo) RemoveProjectSkill(context.Context, string, string) error {
+	return r.err
+}
+
+func (r *UnsupportedSkillRepo) FindProjectSkills(context.Context, string) ([]*model.RequiredSkill, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedSkillRepo) SuggestTeamMembers(context.Context, string, int) ([]*model.TeamMemberSuggestion, error) {
+	return nil, r.err
+}
+
+// UnsupportedWebhookRepo is the WebhookRepository of drivers without webhook support
+type UnsupportedWebhookRepo struct {
+	err error
+}
+
+// NewUnsupportedWebhookRepo creates a webhook repository failing every call for driver
+func NewUnsupportedWebhookRepo(driver string) WebhookRepository {
+	return &UnsupportedWebhookRepo{err: unsupported("webhooks", driver)}
+}
+
+func (r *UnsupportedWebhookRepo) SaveSubscription(context.Context, *model.WebhookSubscription, string) error {
+	return r.err
+}
+
+func (r *UnsupportedWebhookRepo) FindSubscriptionByID(context.Context, string) (*model.WebhookSubscription, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedWebhookRepo) FindSubscriptions(context.Context) ([]*model.WebhookSubscription, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedWebhookRepo) UpdateSubscription(context.Context, *model.WebhookSubscription, *string) error {
+	return r.err
+}
+
+func (r *UnsupportedWebhookRepo) DeleteSubscription(context.Context, string) error { return r.err }
+
+func (r *UnsupportedWebhookRepo) FindDeliveries(context.Context, string, *model.WebhookDeliveryStatus, int) ([]*model.WebhookDelivery, error) {
+	return nil, r.err
+}
+
+func (r *UnsupportedWebhookRepo) Redeliver(context.Context, string) (*model.WebhookDelivery, error) {
+	return nil, r.err
+}
+
+func unsupported(feature, driver string) error {
+	return fmt.Errorf("%s are not supported by the %s storage driver: %w", feature, driver, errors.ErrUnsupported)
+}
@@ -20,12 +20,28 @@ type Employee struct {
 	// ID of the ent.
 	// Unique identifier for the employee
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant (subsidiary) the row belongs to
+	TenantID string `json:"tenant_id,omitempty"`
 	// Name of the employee
 	Name string `json:"name,omitempty"`
-	// Email address of the employee (must be unique)
+	// Email address of the employee (unique per tenant)
 	Email string `json:"email,omitempty"`
 	// ID of the department this employee belongs to
 	DepartmentID uuid.UUID `json:"department_id,omitempty"`
+	// Job title of the employee
+	JobTitle string `json:"job_title,omitempty"`
+	// Type of employment contract
+	EmploymentType employee.EmploymentType `json:"employment_type,omitempty"`
+	// Current employment status
+	Status employee.Status `json:"status,omitempty"`
+	// Date the employee was (last) hired
+	HireDate *time.Time `json:"hire_date,omitempty"`
+	// Date the employment ended
+	TerminationDate *time.Time `json:"termination_date,omitempty"`
+	// Phone number of the employee
+	Phone string `json:"phone,omitempty"`
+	// Office or city the employee works from
+	Location string `json:"location,omitempty"`
 	// Timestamp when employee was created
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Timestamp when employee was last updated
@@ -42,9 +58,17 @@ type EmployeeEdges struct {
 	Department *Department `json:"department,omitempty"`
 	// Projects that this employee is working on
 	Projects []*Project `json:"projects,omitempty"`
+	// Tasks assigned to this employee
+	AssignedTasks []*Task `json:"assigned_tasks,omitempty"`
+	// Projects this employee leads
+	LedProjects []*Project `json:"led_projects,omitempty"`
+	// Skills of this employee
+	Skills []*Skill `json:"skills,omitempty"`
+	// EmployeeSkills holds the value of the employee_skills edge.
+	EmployeeSkills []*EmployeeSkill `json:"employee_skills,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // DepartmentOrErr returns the Department value or an error if the edge
@@ -67,14 +91,50 @@ func (e EmployeeEdges) ProjectsOrErr() ([]*Project, error) {
 	return nil, &NotLoadedError{edge: "projects"}
 }
 
+// AssignedTasksOrErr returns the AssignedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) AssignedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.AssignedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_tasks"}
+}
+
+// LedProjectsOrErr returns the LedProjects value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) LedProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[3] {
+		return e.LedProjects, nil
+	}
+	return nil, &NotLoadedError{edge: "led_projects"}
+}
+
+// SkillsOrErr returns the Skills value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) SkillsOrErr() ([]*Skill, error) {
+	if e.loadedTypes[4] {
+		return e.Skills, nil
+	}
+	return nil, &NotLoadedError{edge: "skills"}
+}
+
+// EmployeeSkillsOrErr returns the EmployeeSkills value or an error if the edge
+// was not loaded in eager-loading.
+func (e EmployeeEdges) EmployeeSkillsOrErr() ([]*EmployeeSkill, error) {
+	if e.loadedTypes[5] {
+		return e.EmployeeSkills, nil
+	}
+	return nil, &NotLoadedError{edge: "employee_skills"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Employee) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case employee.FieldName, employee.FieldEmail:
+		case employee.FieldTenantID, employee.FieldName, employee.FieldEmail, employee.FieldJobTitle, employee.FieldEmploymentType, employee.FieldStatus, employee.FieldPhone, employee.FieldLocation:
 			values[i] = new(sql.NullString)
-		case employee.FieldCreatedAt, employee.FieldUpdatedAt:
+		case employee.FieldHireDate, employee.FieldTerminationDate, employee.FieldCreatedAt, employee.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case employee.FieldID, employee.FieldDepartmentID:
 			values[i] = new(uuid.UUID)
@@ -99,6 +159,12 @@ func (_m *Employee) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case employee.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
 		case employee.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -117,6 +183,50 @@ func (_m *Employee) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.DepartmentID = *value
 			}
+		case employee.FieldJobTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_title", values[i])
+			} else if value.Valid {
+				_m.JobTitle = value.String
+			}
+		case employee.FieldEmploymentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field employment_type", values[i])
+			} else if value.Valid {
+				_m.EmploymentType = employee.EmploymentType(value.String)
+			}
+		case employee.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = employee.Status(value.String)
+			}
+		case employee.FieldHireDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hire_date", values[i])
+			} else if value.Valid {
+				_m.HireDate = new(time.Time)
+				*_m.HireDate = value.Time
+			}
+		case employee.FieldTerminationDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field termination_date", values[i])
+			} else if value.Valid {
+				_m.TerminationDate = new(time.Time)
+				*_m.TerminationDate = value.Time
+			}
+		case employee.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case employee.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
 		case employee.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -152,6 +262,26 @@ func (_m *Employee) QueryProjects() *ProjectQuery {
 	return NewEmployeeClient(_m.config).QueryProjects(_m)
 }
 
+// QueryAssignedTasks queries the "assigned_tasks" edge of the Employee entity.
+func (_m *Employee) QueryAssignedTasks() *TaskQuery {
+	return NewEmployeeClient(_m.config).QueryAssignedTasks(_m)
+}
+
+// QueryLedProjects queries the "led_projects" edge of the Employee entity.
+func (_m *Employee) QueryLedProjects() *ProjectQuery {
+	return NewEmployeeClient(_m.config).QueryLedProjects(_m)
+}
+
+// QuerySkills queries the "skills" edge of the Employee entity.
+func (_m *Employee) QuerySkills() *SkillQuery {
+	return NewEmployeeClient(_m.config).QuerySkills(_m)
+}
+
+// QueryEmployeeSkills queries the "employee_skills" edge of the Employee entity.
+func (_m *Employee) QueryEmployeeSkills() *EmployeeSkillQuery {
+	return NewEmployeeClient(_m.config).QueryEmployeeSkills(_m)
+}
+
 // Update returns a builder for updating this Employee.
 // Note that you need to call Employee.Unwrap() before calling this method if this Employee
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -175,6 +305,9 @@ func (_m *Employee) String() string {
 	var builder strings.Builder
 	builder.WriteString("Employee(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -184,6 +317,31 @@ func (_m *Employee) String() string {
 	builder.WriteString("department_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DepartmentID))
 	builder.WriteString(", ")
+	builder.WriteString("job_title=")
+	builder.WriteString(_m.JobTitle)
+	builder.WriteString(", ")
+	builder.WriteString("employment_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmploymentType))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.HireDate; v != nil {
+		builder.WriteString("hire_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TerminationDate; v != nil {
+		builder.WriteString("termination_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
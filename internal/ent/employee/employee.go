@@ -3,8 +3,10 @@
 package employee
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -15,12 +17,28 @@ const (
 	Label = "employee"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
 	// FieldDepartmentID holds the string denoting the department_id field in the database.
 	FieldDepartmentID = "department_id"
+	// FieldJobTitle holds the string denoting the job_title field in the database.
+	FieldJobTitle = "job_title"
+	// FieldEmploymentType holds the string denoting the employment_type field in the database.
+	FieldEmploymentType = "employment_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHireDate holds the string denoting the hire_date field in the database.
+	FieldHireDate = "hire_date"
+	// FieldTerminationDate holds the string denoting the termination_date field in the database.
+	FieldTerminationDate = "termination_date"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -29,6 +47,14 @@ const (
 	EdgeDepartment = "department"
 	// EdgeProjects holds the string denoting the projects edge name in mutations.
 	EdgeProjects = "projects"
+	// EdgeAssignedTasks holds the string denoting the assigned_tasks edge name in mutations.
+	EdgeAssignedTasks = "assigned_tasks"
+	// EdgeLedProjects holds the string denoting the led_projects edge name in mutations.
+	EdgeLedProjects = "led_projects"
+	// EdgeSkills holds the string denoting the skills edge name in mutations.
+	EdgeSkills = "skills"
+	// EdgeEmployeeSkills holds the string denoting the employee_skills edge name in mutations.
+	EdgeEmployeeSkills = "employee_skills"
 	// Table holds the table name of the employee in the database.
 	Table = "employees"
 	// DepartmentTable is the table that holds the department relation/edge.
@@ -43,14 +69,48 @@ const (
 	// ProjectsInverseTable is the table name for the Project entity.
 	// It exists in this package in order to avoid circular dependency with the "project" package.
 	ProjectsInverseTable = "projects"
+	// AssignedTasksTable is the table that holds the assigned_tasks relation/edge.
+	AssignedTasksTable = "tasks"
+	// AssignedTasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	AssignedTasksInverseTable = "tasks"
+	// AssignedTasksColumn is the table column denoting the assigned_tasks relation/edge.
+	AssignedTasksColumn = "assignee_id"
+	// LedProjectsTable is the table that holds the led_projects relation/edge.
+	LedProjectsTable = "projects"
+	// LedProjectsInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	LedProjectsInverseTable = "projects"
+	// LedProjectsColumn is the table column denoting the led_projects relation/edge.
+	LedProjectsColumn = "lead_id"
+	// SkillsTable is the table that holds the skills relation/edge. The primary key declared below.
+	SkillsTable = "employee_skills"
+	// SkillsInverseTable is the table name for the Skill entity.
+	// It exists in this package in order to avoid circular dependency with the "skill" package.
+	SkillsInverseTable = "skills"
+	// EmployeeSkillsTable is the table that holds the employee_skills relation/edge.
+	EmployeeSkillsTable = "employee_skills"
+	// EmployeeSkillsInverseTable is the table name for the EmployeeSkill entity.
+	// It exists in this package in order to avoid circular dependency with the "employeeskill" package.
+	EmployeeSkillsInverseTable = "employee_skills"
+	// EmployeeSkillsColumn is the table column denoting the employee_skills relation/edge.
+	EmployeeSkillsColumn = "employee_id"
 )
 
 // Columns holds all SQL columns for employee fields.
 var Columns = []string{
 	FieldID,
+	FieldTenantID,
 	FieldName,
 	FieldEmail,
 	FieldDepartmentID,
+	FieldJobTitle,
+	FieldEmploymentType,
+	FieldStatus,
+	FieldHireDate,
+	FieldTerminationDate,
+	FieldPhone,
+	FieldLocation,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -59,6 +119,9 @@ var (
 	// ProjectsPrimaryKey and ProjectsColumn2 are the table columns denoting the
 	// primary key for the projects relation (M2M).
 	ProjectsPrimaryKey = []string{"project_id", "employee_id"}
+	// SkillsPrimaryKey and SkillsColumn2 are the table columns denoting the
+	// primary key for the skills relation (M2M).
+	SkillsPrimaryKey = []string{"employee_id", "skill_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,7 +134,19 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "gin-crud-api/internal/ent/runtime"
 var (
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID string
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
@@ -86,6 +161,60 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// EmploymentType defines the type for the "employment_type" enum field.
+type EmploymentType string
+
+// EmploymentTypeFULL_TIME is the default value of the EmploymentType enum.
+const DefaultEmploymentType = EmploymentTypeFULL_TIME
+
+// EmploymentType values.
+const (
+	EmploymentTypeFULL_TIME  EmploymentType = "FULL_TIME"
+	EmploymentTypeCONTRACTOR EmploymentType = "CONTRACTOR"
+	EmploymentTypeINTERN     EmploymentType = "INTERN"
+)
+
+func (et EmploymentType) String() string {
+	return string(et)
+}
+
+// EmploymentTypeValidator is a validator for the "employment_type" field enum values. It is called by the builders before save.
+func EmploymentTypeValidator(et EmploymentType) error {
+	switch et {
+	case EmploymentTypeFULL_TIME, EmploymentTypeCONTRACTOR, EmploymentTypeINTERN:
+		return nil
+	default:
+		return fmt.Errorf("employee: invalid enum value for employment_type field: %q", et)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusACTIVE is the default value of the Status enum.
+const DefaultStatus = StatusACTIVE
+
+// Status values.
+const (
+	StatusACTIVE     Status = "ACTIVE"
+	StatusON_LEAVE   Status = "ON_LEAVE"
+	StatusTERMINATED Status = "TERMINATED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusACTIVE, StatusON_LEAVE, StatusTERMINATED:
+		return nil
+	default:
+		return fmt.Errorf("employee: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Employee queries.
 type OrderOption func(*sql.Selector)
 
@@ -94,6 +223,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -109,6 +243,41 @@ func ByDepartmentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDepartmentID, opts...).ToFunc()
 }
 
+// ByJobTitle orders the results by the job_title field.
+func ByJobTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobTitle, opts...).ToFunc()
+}
+
+// ByEmploymentType orders the results by the employment_type field.
+func ByEmploymentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmploymentType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByHireDate orders the results by the hire_date field.
+func ByHireDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHireDate, opts...).ToFunc()
+}
+
+// ByTerminationDate orders the results by the termination_date field.
+func ByTerminationDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerminationDate, opts...).ToFunc()
+}
+
+// ByPhone orders the results by the phone field.
+func ByPhone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPhone, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -139,6 +308,62 @@ func ByProjects(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProjectsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAssignedTasksCount orders the results by assigned_tasks count.
+func ByAssignedTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignedTasksStep(), opts...)
+	}
+}
+
+// ByAssignedTasks orders the results by assigned_tasks terms.
+func ByAssignedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLedProjectsCount orders the results by led_projects count.
+func ByLedProjectsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLedProjectsStep(), opts...)
+	}
+}
+
+// ByLedProjects orders the results by led_projects terms.
+func ByLedProjects(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedProjectsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySkillsCount orders the results by skills count.
+func BySkillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSkillsStep(), opts...)
+	}
+}
+
+// BySkills orders the results by skills terms.
+func BySkills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSkillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmployeeSkillsCount orders the results by employee_skills count.
+func ByEmployeeSkillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmployeeSkillsStep(), opts...)
+	}
+}
+
+// ByEmployeeSkills orders the results by employee_skills terms.
+func ByEmployeeSkills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmployeeSkillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDepartmentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -153,3 +378,31 @@ func newProjectsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, ProjectsTable, ProjectsPrimaryKey...),
 	)
 }
+func newAssignedTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignedTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignedTasksTable, AssignedTasksColumn),
+	)
+}
+func newLedProjectsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedProjectsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LedProjectsTable, LedProjectsColumn),
+	)
+}
+func newSkillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SkillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, SkillsTable, SkillsPrimaryKey...),
+	)
+}
+func newEmployeeSkillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmployeeSkillsInverseTable, EmployeeSkillsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, EmployeeSkillsTable, EmployeeSkillsColumn),
+	)
+}
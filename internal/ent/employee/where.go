@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.Employee {
 	return predicate.Employee(sql.FieldLTE(FieldID, id))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTenantID, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldName, v))
@@ -71,6 +76,31 @@ func DepartmentID(v uuid.UUID) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldDepartmentID, v))
 }
 
+// JobTitle applies equality check predicate on the "job_title" field. It's identical to JobTitleEQ.
+func JobTitle(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldJobTitle, v))
+}
+
+// HireDate applies equality check predicate on the "hire_date" field. It's identical to HireDateEQ.
+func HireDate(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHireDate, v))
+}
+
+// TerminationDate applies equality check predicate on the "termination_date" field. It's identical to TerminationDateEQ.
+func TerminationDate(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTerminationDate, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldPhone, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldLocation, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldCreatedAt, v))
@@ -81,6 +111,71 @@ func UpdatedAt(v time.Time) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldTenantID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldName, v))
@@ -231,6 +326,371 @@ func DepartmentIDNotIn(vs ...uuid.UUID) predicate.Employee {
 	return predicate.Employee(sql.FieldNotIn(FieldDepartmentID, vs...))
 }
 
+// JobTitleEQ applies the EQ predicate on the "job_title" field.
+func JobTitleEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldJobTitle, v))
+}
+
+// JobTitleNEQ applies the NEQ predicate on the "job_title" field.
+func JobTitleNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldJobTitle, v))
+}
+
+// JobTitleIn applies the In predicate on the "job_title" field.
+func JobTitleIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldJobTitle, vs...))
+}
+
+// JobTitleNotIn applies the NotIn predicate on the "job_title" field.
+func JobTitleNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldJobTitle, vs...))
+}
+
+// JobTitleGT applies the GT predicate on the "job_title" field.
+func JobTitleGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldJobTitle, v))
+}
+
+// JobTitleGTE applies the GTE predicate on the "job_title" field.
+func JobTitleGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldJobTitle, v))
+}
+
+// JobTitleLT applies the LT predicate on the "job_title" field.
+func JobTitleLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldJobTitle, v))
+}
+
+// JobTitleLTE applies the LTE predicate on the "job_title" field.
+func JobTitleLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldJobTitle, v))
+}
+
+// JobTitleContains applies the Contains predicate on the "job_title" field.
+func JobTitleContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldJobTitle, v))
+}
+
+// JobTitleHasPrefix applies the HasPrefix predicate on the "job_title" field.
+func JobTitleHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldJobTitle, v))
+}
+
+// JobTitleHasSuffix applies the HasSuffix predicate on the "job_title" field.
+func JobTitleHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldJobTitle, v))
+}
+
+// JobTitleIsNil applies the IsNil predicate on the "job_title" field.
+func JobTitleIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldJobTitle))
+}
+
+// JobTitleNotNil applies the NotNil predicate on the "job_title" field.
+func JobTitleNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldJobTitle))
+}
+
+// JobTitleEqualFold applies the EqualFold predicate on the "job_title" field.
+func JobTitleEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldJobTitle, v))
+}
+
+// JobTitleContainsFold applies the ContainsFold predicate on the "job_title" field.
+func JobTitleContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldJobTitle, v))
+}
+
+// EmploymentTypeEQ applies the EQ predicate on the "employment_type" field.
+func EmploymentTypeEQ(v EmploymentType) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldEmploymentType, v))
+}
+
+// EmploymentTypeNEQ applies the NEQ predicate on the "employment_type" field.
+func EmploymentTypeNEQ(v EmploymentType) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldEmploymentType, v))
+}
+
+// EmploymentTypeIn applies the In predicate on the "employment_type" field.
+func EmploymentTypeIn(vs ...EmploymentType) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldEmploymentType, vs...))
+}
+
+// EmploymentTypeNotIn applies the NotIn predicate on the "employment_type" field.
+func EmploymentTypeNotIn(vs ...EmploymentType) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldEmploymentType, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HireDateEQ applies the EQ predicate on the "hire_date" field.
+func HireDateEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldHireDate, v))
+}
+
+// HireDateNEQ applies the NEQ predicate on the "hire_date" field.
+func HireDateNEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldHireDate, v))
+}
+
+// HireDateIn applies the In predicate on the "hire_date" field.
+func HireDateIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldHireDate, vs...))
+}
+
+// HireDateNotIn applies the NotIn predicate on the "hire_date" field.
+func HireDateNotIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldHireDate, vs...))
+}
+
+// HireDateGT applies the GT predicate on the "hire_date" field.
+func HireDateGT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldHireDate, v))
+}
+
+// HireDateGTE applies the GTE predicate on the "hire_date" field.
+func HireDateGTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldHireDate, v))
+}
+
+// HireDateLT applies the LT predicate on the "hire_date" field.
+func HireDateLT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldHireDate, v))
+}
+
+// HireDateLTE applies the LTE predicate on the "hire_date" field.
+func HireDateLTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldHireDate, v))
+}
+
+// HireDateIsNil applies the IsNil predicate on the "hire_date" field.
+func HireDateIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldHireDate))
+}
+
+// HireDateNotNil applies the NotNil predicate on the "hire_date" field.
+func HireDateNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldHireDate))
+}
+
+// TerminationDateEQ applies the EQ predicate on the "termination_date" field.
+func TerminationDateEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldTerminationDate, v))
+}
+
+// TerminationDateNEQ applies the NEQ predicate on the "termination_date" field.
+func TerminationDateNEQ(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldTerminationDate, v))
+}
+
+// TerminationDateIn applies the In predicate on the "termination_date" field.
+func TerminationDateIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldTerminationDate, vs...))
+}
+
+// TerminationDateNotIn applies the NotIn predicate on the "termination_date" field.
+func TerminationDateNotIn(vs ...time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldTerminationDate, vs...))
+}
+
+// TerminationDateGT applies the GT predicate on the "termination_date" field.
+func TerminationDateGT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldTerminationDate, v))
+}
+
+// TerminationDateGTE applies the GTE predicate on the "termination_date" field.
+func TerminationDateGTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldTerminationDate, v))
+}
+
+// TerminationDateLT applies the LT predicate on the "termination_date" field.
+func TerminationDateLT(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldTerminationDate, v))
+}
+
+// TerminationDateLTE applies the LTE predicate on the "termination_date" field.
+func TerminationDateLTE(v time.Time) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldTerminationDate, v))
+}
+
+// TerminationDateIsNil applies the IsNil predicate on the "termination_date" field.
+func TerminationDateIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldTerminationDate))
+}
+
+// TerminationDateNotNil applies the NotNil predicate on the "termination_date" field.
+func TerminationDateNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldTerminationDate))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.Employee {
+	return predicate.Employee(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.Employee {
+	return predicate.Employee(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.Employee {
+	return predicate.Employee(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.Employee {
+	return predicate.Employee(sql.FieldContainsFold(FieldLocation, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Employee {
 	return predicate.Employee(sql.FieldEQ(FieldCreatedAt, v))
@@ -357,6 +817,98 @@ func HasProjectsWith(preds ...predicate.Project) predicate.Employee {
 	})
 }
 
+// HasAssignedTasks applies the HasEdge predicate on the "assigned_tasks" edge.
+func HasAssignedTasks() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignedTasksTable, AssignedTasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignedTasksWith applies the HasEdge predicate on the "assigned_tasks" edge with a given conditions (other predicates).
+func HasAssignedTasksWith(preds ...predicate.Task) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newAssignedTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLedProjects applies the HasEdge predicate on the "led_projects" edge.
+func HasLedProjects() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LedProjectsTable, LedProjectsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedProjectsWith applies the HasEdge predicate on the "led_projects" edge with a given conditions (other predicates).
+func HasLedProjectsWith(preds ...predicate.Project) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newLedProjectsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSkills applies the HasEdge predicate on the "skills" edge.
+func HasSkills() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, SkillsTable, SkillsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSkillsWith applies the HasEdge predicate on the "skills" edge with a given conditions (other predicates).
+func HasSkillsWith(preds ...predicate.Skill) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newSkillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmployeeSkills applies the HasEdge predicate on the "employee_skills" edge.
+func HasEmployeeSkills() predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EmployeeSkillsTable, EmployeeSkillsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeSkillsWith applies the HasEdge predicate on the "employee_skills" edge with a given conditions (other predicates).
+func HasEmployeeSkillsWith(preds ...predicate.EmployeeSkill) predicate.Employee {
+	return predicate.Employee(func(s *sql.Selector) {
+		step := newEmployeeSkillsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Employee) predicate.Employee {
 	return predicate.Employee(sql.AndPredicates(predicates...))
@@ -9,6 +9,8 @@ import (
 	"gin-crud-api/internal/ent/department"
 	"gin-crud-api/internal/ent/employee"
 	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/ent/task"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,6 +25,20 @@ type EmployeeCreate struct {
 	hooks    []Hook
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *EmployeeCreate) SetTenantID(v string) *EmployeeCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableTenantID(v *string) *EmployeeCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *EmployeeCreate) SetName(v string) *EmployeeCreate {
 	_c.mutation.SetName(v)
@@ -41,6 +57,104 @@ func (_c *EmployeeCreate) SetDepartmentID(v uuid.UUID) *EmployeeCreate {
 	return _c
 }
 
+// SetJobTitle sets the "job_title" field.
+func (_c *EmployeeCreate) SetJobTitle(v string) *EmployeeCreate {
+	_c.mutation.SetJobTitle(v)
+	return _c
+}
+
+// SetNillableJobTitle sets the "job_title" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableJobTitle(v *string) *EmployeeCreate {
+	if v != nil {
+		_c.SetJobTitle(*v)
+	}
+	return _c
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (_c *EmployeeCreate) SetEmploymentType(v employee.EmploymentType) *EmployeeCreate {
+	_c.mutation.SetEmploymentType(v)
+	return _c
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableEmploymentType(v *employee.EmploymentType) *EmployeeCreate {
+	if v != nil {
+		_c.SetEmploymentType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EmployeeCreate) SetStatus(v employee.Status) *EmployeeCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableStatus(v *employee.Status) *EmployeeCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetHireDate sets the "hire_date" field.
+func (_c *EmployeeCreate) SetHireDate(v time.Time) *EmployeeCreate {
+	_c.mutation.SetHireDate(v)
+	return _c
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableHireDate(v *time.Time) *EmployeeCreate {
+	if v != nil {
+		_c.SetHireDate(*v)
+	}
+	return _c
+}
+
+// SetTerminationDate sets the "termination_date" field.
+func (_c *EmployeeCreate) SetTerminationDate(v time.Time) *EmployeeCreate {
+	_c.mutation.SetTerminationDate(v)
+	return _c
+}
+
+// SetNillableTerminationDate sets the "termination_date" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableTerminationDate(v *time.Time) *EmployeeCreate {
+	if v != nil {
+		_c.SetTerminationDate(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *EmployeeCreate) SetPhone(v string) *EmployeeCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillablePhone(v *string) *EmployeeCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *EmployeeCreate) SetLocation(v string) *EmployeeCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *EmployeeCreate) SetNillableLocation(v *string) *EmployeeCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *EmployeeCreate) SetCreatedAt(v time.Time) *EmployeeCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -103,6 +217,51 @@ func (_c *EmployeeCreate) AddProjects(v ...*Project) *EmployeeCreate {
 	return _c.AddProjectIDs(ids...)
 }
 
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (_c *EmployeeCreate) AddAssignedTaskIDs(ids ...uuid.UUID) *EmployeeCreate {
+	_c.mutation.AddAssignedTaskIDs(ids...)
+	return _c
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (_c *EmployeeCreate) AddAssignedTasks(v ...*Task) *EmployeeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignedTaskIDs(ids...)
+}
+
+// AddLedProjectIDs adds the "led_projects" edge to the Project entity by IDs.
+func (_c *EmployeeCreate) AddLedProjectIDs(ids ...uuid.UUID) *EmployeeCreate {
+	_c.mutation.AddLedProjectIDs(ids...)
+	return _c
+}
+
+// AddLedProjects adds the "led_projects" edges to the Project entity.
+func (_c *EmployeeCreate) AddLedProjects(v ...*Project) *EmployeeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLedProjectIDs(ids...)
+}
+
+// AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
+func (_c *EmployeeCreate) AddSkillIDs(ids ...uuid.UUID) *EmployeeCreate {
+	_c.mutation.AddSkillIDs(ids...)
+	return _c
+}
+
+// AddSkills adds the "skills" edges to the Skill entity.
+func (_c *EmployeeCreate) AddSkills(v ...*Skill) *EmployeeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSkillIDs(ids...)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (_c *EmployeeCreate) Mutation() *EmployeeMutation {
 	return _c.mutation
@@ -110,7 +269,9 @@ func (_c *EmployeeCreate) Mutation() *EmployeeMutation {
 
 // Save creates the Employee in the database.
 func (_c *EmployeeCreate) Save(ctx context.Context) (*Employee, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -137,23 +298,53 @@ func (_c *EmployeeCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *EmployeeCreate) defaults() {
+func (_c *EmployeeCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := employee.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.EmploymentType(); !ok {
+		v := employee.DefaultEmploymentType
+		_c.mutation.SetEmploymentType(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := employee.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if employee.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employee.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := employee.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if employee.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employee.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := employee.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if employee.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized employee.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := employee.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *EmployeeCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Employee.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := employee.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Employee.tenant_id": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Employee.name"`)}
 	}
@@ -173,6 +364,22 @@ func (_c *EmployeeCreate) check() error {
 	if _, ok := _c.mutation.DepartmentID(); !ok {
 		return &ValidationError{Name: "department_id", err: errors.New(`ent: missing required field "Employee.department_id"`)}
 	}
+	if _, ok := _c.mutation.EmploymentType(); !ok {
+		return &ValidationError{Name: "employment_type", err: errors.New(`ent: missing required field "Employee.employment_type"`)}
+	}
+	if v, ok := _c.mutation.EmploymentType(); ok {
+		if err := employee.EmploymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "employment_type", err: fmt.Errorf(`ent: validator failed for field "Employee.employment_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Employee.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := employee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Employee.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Employee.created_at"`)}
 	}
@@ -217,6 +424,10 @@ func (_c *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(employee.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(employee.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -225,6 +436,34 @@ func (_c *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		_spec.SetField(employee.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := _c.mutation.JobTitle(); ok {
+		_spec.SetField(employee.FieldJobTitle, field.TypeString, value)
+		_node.JobTitle = value
+	}
+	if value, ok := _c.mutation.EmploymentType(); ok {
+		_spec.SetField(employee.FieldEmploymentType, field.TypeEnum, value)
+		_node.EmploymentType = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(employee.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.HireDate(); ok {
+		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
+		_node.HireDate = &value
+	}
+	if value, ok := _c.mutation.TerminationDate(); ok {
+		_spec.SetField(employee.FieldTerminationDate, field.TypeTime, value)
+		_node.TerminationDate = &value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(employee.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(employee.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(employee.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -266,6 +505,58 @@ func (_c *EmployeeCreate) createSpec() (*Employee, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &EmployeeSkillCreate{config: _c.config, mutation: newEmployeeSkillMutation(_c.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
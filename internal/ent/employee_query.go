@@ -5,11 +5,15 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"gin-crud-api/internal/ent/department"
 	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
 	"gin-crud-api/internal/ent/predicate"
 	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/ent/task"
 	"math"
 
 	"entgo.io/ent"
@@ -22,12 +26,16 @@ import (
 // EmployeeQuery is the builder for querying Employee entities.
 type EmployeeQuery struct {
 	config
-	ctx            *QueryContext
-	order          []employee.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Employee
-	withDepartment *DepartmentQuery
-	withProjects   *ProjectQuery
+	ctx                *QueryContext
+	order              []employee.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Employee
+	withDepartment     *DepartmentQuery
+	withProjects       *ProjectQuery
+	withAssignedTasks  *TaskQuery
+	withLedProjects    *ProjectQuery
+	withSkills         *SkillQuery
+	withEmployeeSkills *EmployeeSkillQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +116,94 @@ func (_q *EmployeeQuery) QueryProjects() *ProjectQuery {
 	return query
 }
 
+// QueryAssignedTasks chains the current query on the "assigned_tasks" edge.
+func (_q *EmployeeQuery) QueryAssignedTasks() *TaskQuery {
+	query := (&TaskClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.AssignedTasksTable, employee.AssignedTasksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLedProjects chains the current query on the "led_projects" edge.
+func (_q *EmployeeQuery) QueryLedProjects() *ProjectQuery {
+	query := (&ProjectClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, employee.LedProjectsTable, employee.LedProjectsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySkills chains the current query on the "skills" edge.
+func (_q *EmployeeQuery) QuerySkills() *SkillQuery {
+	query := (&SkillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(skill.Table, skill.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, employee.SkillsTable, employee.SkillsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmployeeSkills chains the current query on the "employee_skills" edge.
+func (_q *EmployeeQuery) QueryEmployeeSkills() *EmployeeSkillQuery {
+	query := (&EmployeeSkillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employee.Table, employee.FieldID, selector),
+			sqlgraph.To(employeeskill.Table, employeeskill.EmployeeColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, employee.EmployeeSkillsTable, employee.EmployeeSkillsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Employee entity from the query.
 // Returns a *NotFoundError when no Employee was found.
 func (_q *EmployeeQuery) First(ctx context.Context) (*Employee, error) {
@@ -295,13 +391,17 @@ func (_q *EmployeeQuery) Clone() *EmployeeQuery {
 		return nil
 	}
 	return &EmployeeQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]employee.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Employee{}, _q.predicates...),
-		withDepartment: _q.withDepartment.Clone(),
-		withProjects:   _q.withProjects.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]employee.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Employee{}, _q.predicates...),
+		withDepartment:     _q.withDepartment.Clone(),
+		withProjects:       _q.withProjects.Clone(),
+		withAssignedTasks:  _q.withAssignedTasks.Clone(),
+		withLedProjects:    _q.withLedProjects.Clone(),
+		withSkills:         _q.withSkills.Clone(),
+		withEmployeeSkills: _q.withEmployeeSkills.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,18 +430,62 @@ func (_q *EmployeeQuery) WithProjects(opts ...func(*ProjectQuery)) *EmployeeQuer
 	return _q
 }
 
+// WithAssignedTasks tells the query-builder to eager-load the nodes that are connected to
+// the "assigned_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeQuery) WithAssignedTasks(opts ...func(*TaskQuery)) *EmployeeQuery {
+	query := (&TaskClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignedTasks = query
+	return _q
+}
+
+// WithLedProjects tells the query-builder to eager-load the nodes that are connected to
+// the "led_projects" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeQuery) WithLedProjects(opts ...func(*ProjectQuery)) *EmployeeQuery {
+	query := (&ProjectClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLedProjects = query
+	return _q
+}
+
+// WithSkills tells the query-builder to eager-load the nodes that are connected to
+// the "skills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeQuery) WithSkills(opts ...func(*SkillQuery)) *EmployeeQuery {
+	query := (&SkillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSkills = query
+	return _q
+}
+
+// WithEmployeeSkills tells the query-builder to eager-load the nodes that are connected to
+// the "employee_skills" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeQuery) WithEmployeeSkills(opts ...func(*EmployeeSkillQuery)) *EmployeeQuery {
+	query := (&EmployeeSkillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEmployeeSkills = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		TenantID string `json:"tenant_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Employee.Query().
-//		GroupBy(employee.FieldName).
+//		GroupBy(employee.FieldTenantID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *EmployeeQuery) GroupBy(field string, fields ...string) *EmployeeGroupBy {
@@ -359,11 +503,11 @@ func (_q *EmployeeQuery) GroupBy(field string, fields ...string) *EmployeeGroupB
 // Example:
 //
 //	var v []struct {
-//		Name string `json:"name,omitempty"`
+//		TenantID string `json:"tenant_id,omitempty"`
 //	}
 //
 //	client.Employee.Query().
-//		Select(employee.FieldName).
+//		Select(employee.FieldTenantID).
 //		Scan(ctx, &v)
 func (_q *EmployeeQuery) Select(fields ...string) *EmployeeSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -401,6 +545,12 @@ func (_q *EmployeeQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if employee.Policy == nil {
+		return errors.New("ent: uninitialized employee.Policy (forgotten import ent/runtime?)")
+	}
+	if err := employee.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -408,9 +558,13 @@ func (_q *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 	var (
 		nodes       = []*Employee{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withDepartment != nil,
 			_q.withProjects != nil,
+			_q.withAssignedTasks != nil,
+			_q.withLedProjects != nil,
+			_q.withSkills != nil,
+			_q.withEmployeeSkills != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +598,34 @@ func (_q *EmployeeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Emp
 			return nil, err
 		}
 	}
+	if query := _q.withAssignedTasks; query != nil {
+		if err := _q.loadAssignedTasks(ctx, query, nodes,
+			func(n *Employee) { n.Edges.AssignedTasks = []*Task{} },
+			func(n *Employee, e *Task) { n.Edges.AssignedTasks = append(n.Edges.AssignedTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLedProjects; query != nil {
+		if err := _q.loadLedProjects(ctx, query, nodes,
+			func(n *Employee) { n.Edges.LedProjects = []*Project{} },
+			func(n *Employee, e *Project) { n.Edges.LedProjects = append(n.Edges.LedProjects, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSkills; query != nil {
+		if err := _q.loadSkills(ctx, query, nodes,
+			func(n *Employee) { n.Edges.Skills = []*Skill{} },
+			func(n *Employee, e *Skill) { n.Edges.Skills = append(n.Edges.Skills, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmployeeSkills; query != nil {
+		if err := _q.loadEmployeeSkills(ctx, query, nodes,
+			func(n *Employee) { n.Edges.EmployeeSkills = []*EmployeeSkill{} },
+			func(n *Employee, e *EmployeeSkill) { n.Edges.EmployeeSkills = append(n.Edges.EmployeeSkills, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -537,6 +719,163 @@ func (_q *EmployeeQuery) loadProjects(ctx context.Context, query *ProjectQuery,
 	}
 	return nil
 }
+func (_q *EmployeeQuery) loadAssignedTasks(ctx context.Context, query *TaskQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldAssigneeID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.AssignedTasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AssigneeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "assignee_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "assignee_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EmployeeQuery) loadLedProjects(ctx context.Context, query *ProjectQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Project)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(project.FieldLeadID)
+	}
+	query.Where(predicate.Project(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.LedProjectsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lead_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EmployeeQuery) loadSkills(ctx context.Context, query *SkillQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *Skill)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Employee)
+	nids := make(map[uuid.UUID]map[*Employee]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(employee.SkillsTable)
+		s.Join(joinT).On(s.C(skill.FieldID), joinT.C(employee.SkillsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(employee.SkillsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(employee.SkillsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Employee]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Skill](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "skills" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *EmployeeQuery) loadEmployeeSkills(ctx context.Context, query *EmployeeSkillQuery, nodes []*Employee, init func(*Employee), assign func(*Employee, *EmployeeSkill)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Employee)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(employeeskill.FieldEmployeeID)
+	}
+	query.Where(predicate.EmployeeSkill(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(employee.EmployeeSkillsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EmployeeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "employee_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EmployeeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,8 @@ import (
 	"gin-crud-api/internal/ent/employee"
 	"gin-crud-api/internal/ent/predicate"
 	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/ent/task"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -73,6 +75,134 @@ func (_u *EmployeeUpdate) SetNillableDepartmentID(v *uuid.UUID) *EmployeeUpdate
 	return _u
 }
 
+// SetJobTitle sets the "job_title" field.
+func (_u *EmployeeUpdate) SetJobTitle(v string) *EmployeeUpdate {
+	_u.mutation.SetJobTitle(v)
+	return _u
+}
+
+// SetNillableJobTitle sets the "job_title" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableJobTitle(v *string) *EmployeeUpdate {
+	if v != nil {
+		_u.SetJobTitle(*v)
+	}
+	return _u
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (_u *EmployeeUpdate) ClearJobTitle() *EmployeeUpdate {
+	_u.mutation.ClearJobTitle()
+	return _u
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (_u *EmployeeUpdate) SetEmploymentType(v employee.EmploymentType) *EmployeeUpdate {
+	_u.mutation.SetEmploymentType(v)
+	return _u
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableEmploymentType(v *employee.EmploymentType) *EmployeeUpdate {
+	if v != nil {
+		_u.SetEmploymentType(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmployeeUpdate) SetStatus(v employee.Status) *EmployeeUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableStatus(v *employee.Status) *EmployeeUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHireDate sets the "hire_date" field.
+func (_u *EmployeeUpdate) SetHireDate(v time.Time) *EmployeeUpdate {
+	_u.mutation.SetHireDate(v)
+	return _u
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableHireDate(v *time.Time) *EmployeeUpdate {
+	if v != nil {
+		_u.SetHireDate(*v)
+	}
+	return _u
+}
+
+// ClearHireDate clears the value of the "hire_date" field.
+func (_u *EmployeeUpdate) ClearHireDate() *EmployeeUpdate {
+	_u.mutation.ClearHireDate()
+	return _u
+}
+
+// SetTerminationDate sets the "termination_date" field.
+func (_u *EmployeeUpdate) SetTerminationDate(v time.Time) *EmployeeUpdate {
+	_u.mutation.SetTerminationDate(v)
+	return _u
+}
+
+// SetNillableTerminationDate sets the "termination_date" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableTerminationDate(v *time.Time) *EmployeeUpdate {
+	if v != nil {
+		_u.SetTerminationDate(*v)
+	}
+	return _u
+}
+
+// ClearTerminationDate clears the value of the "termination_date" field.
+func (_u *EmployeeUpdate) ClearTerminationDate() *EmployeeUpdate {
+	_u.mutation.ClearTerminationDate()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *EmployeeUpdate) SetPhone(v string) *EmployeeUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillablePhone(v *string) *EmployeeUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *EmployeeUpdate) ClearPhone() *EmployeeUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EmployeeUpdate) SetLocation(v string) *EmployeeUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EmployeeUpdate) SetNillableLocation(v *string) *EmployeeUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *EmployeeUpdate) ClearLocation() *EmployeeUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmployeeUpdate) SetUpdatedAt(v time.Time) *EmployeeUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -99,6 +229,51 @@ func (_u *EmployeeUpdate) AddProjects(v ...*Project) *EmployeeUpdate {
 	return _u.AddProjectIDs(ids...)
 }
 
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (_u *EmployeeUpdate) AddAssignedTaskIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.AddAssignedTaskIDs(ids...)
+	return _u
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (_u *EmployeeUpdate) AddAssignedTasks(v ...*Task) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedTaskIDs(ids...)
+}
+
+// AddLedProjectIDs adds the "led_projects" edge to the Project entity by IDs.
+func (_u *EmployeeUpdate) AddLedProjectIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.AddLedProjectIDs(ids...)
+	return _u
+}
+
+// AddLedProjects adds the "led_projects" edges to the Project entity.
+func (_u *EmployeeUpdate) AddLedProjects(v ...*Project) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLedProjectIDs(ids...)
+}
+
+// AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
+func (_u *EmployeeUpdate) AddSkillIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.AddSkillIDs(ids...)
+	return _u
+}
+
+// AddSkills adds the "skills" edges to the Skill entity.
+func (_u *EmployeeUpdate) AddSkills(v ...*Skill) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSkillIDs(ids...)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (_u *EmployeeUpdate) Mutation() *EmployeeMutation {
 	return _u.mutation
@@ -131,9 +306,74 @@ func (_u *EmployeeUpdate) RemoveProjects(v ...*Project) *EmployeeUpdate {
 	return _u.RemoveProjectIDs(ids...)
 }
 
+// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
+func (_u *EmployeeUpdate) ClearAssignedTasks() *EmployeeUpdate {
+	_u.mutation.ClearAssignedTasks()
+	return _u
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
+func (_u *EmployeeUpdate) RemoveAssignedTaskIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.RemoveAssignedTaskIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
+func (_u *EmployeeUpdate) RemoveAssignedTasks(v ...*Task) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedTaskIDs(ids...)
+}
+
+// ClearLedProjects clears all "led_projects" edges to the Project entity.
+func (_u *EmployeeUpdate) ClearLedProjects() *EmployeeUpdate {
+	_u.mutation.ClearLedProjects()
+	return _u
+}
+
+// RemoveLedProjectIDs removes the "led_projects" edge to Project entities by IDs.
+func (_u *EmployeeUpdate) RemoveLedProjectIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.RemoveLedProjectIDs(ids...)
+	return _u
+}
+
+// RemoveLedProjects removes "led_projects" edges to Project entities.
+func (_u *EmployeeUpdate) RemoveLedProjects(v ...*Project) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLedProjectIDs(ids...)
+}
+
+// ClearSkills clears all "skills" edges to the Skill entity.
+func (_u *EmployeeUpdate) ClearSkills() *EmployeeUpdate {
+	_u.mutation.ClearSkills()
+	return _u
+}
+
+// RemoveSkillIDs removes the "skills" edge to Skill entities by IDs.
+func (_u *EmployeeUpdate) RemoveSkillIDs(ids ...uuid.UUID) *EmployeeUpdate {
+	_u.mutation.RemoveSkillIDs(ids...)
+	return _u
+}
+
+// RemoveSkills removes "skills" edges to Skill entities.
+func (_u *EmployeeUpdate) RemoveSkills(v ...*Skill) *EmployeeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSkillIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EmployeeUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -160,11 +400,15 @@ func (_u *EmployeeUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EmployeeUpdate) defaults() {
+func (_u *EmployeeUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if employee.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employee.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := employee.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -179,6 +423,16 @@ func (_u *EmployeeUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Employee.email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EmploymentType(); ok {
+		if err := employee.EmploymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "employment_type", err: fmt.Errorf(`ent: validator failed for field "Employee.employment_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := employee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Employee.status": %w`, err)}
+		}
+	}
 	if _u.mutation.DepartmentCleared() && len(_u.mutation.DepartmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Employee.department"`)
 	}
@@ -203,6 +457,42 @@ func (_u *EmployeeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(employee.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.JobTitle(); ok {
+		_spec.SetField(employee.FieldJobTitle, field.TypeString, value)
+	}
+	if _u.mutation.JobTitleCleared() {
+		_spec.ClearField(employee.FieldJobTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmploymentType(); ok {
+		_spec.SetField(employee.FieldEmploymentType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(employee.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HireDate(); ok {
+		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
+	}
+	if _u.mutation.HireDateCleared() {
+		_spec.ClearField(employee.FieldHireDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TerminationDate(); ok {
+		_spec.SetField(employee.FieldTerminationDate, field.TypeTime, value)
+	}
+	if _u.mutation.TerminationDateCleared() {
+		_spec.ClearField(employee.FieldTerminationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(employee.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(employee.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(employee.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(employee.FieldLocation, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(employee.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -280,6 +570,153 @@ func (_u *EmployeeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !_u.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLedProjectsIDs(); len(nodes) > 0 && !_u.mutation.LedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSkillsIDs(); len(nodes) > 0 && !_u.mutation.SkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{employee.Label}
@@ -342,6 +779,134 @@ func (_u *EmployeeUpdateOne) SetNillableDepartmentID(v *uuid.UUID) *EmployeeUpda
 	return _u
 }
 
+// SetJobTitle sets the "job_title" field.
+func (_u *EmployeeUpdateOne) SetJobTitle(v string) *EmployeeUpdateOne {
+	_u.mutation.SetJobTitle(v)
+	return _u
+}
+
+// SetNillableJobTitle sets the "job_title" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableJobTitle(v *string) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetJobTitle(*v)
+	}
+	return _u
+}
+
+// ClearJobTitle clears the value of the "job_title" field.
+func (_u *EmployeeUpdateOne) ClearJobTitle() *EmployeeUpdateOne {
+	_u.mutation.ClearJobTitle()
+	return _u
+}
+
+// SetEmploymentType sets the "employment_type" field.
+func (_u *EmployeeUpdateOne) SetEmploymentType(v employee.EmploymentType) *EmployeeUpdateOne {
+	_u.mutation.SetEmploymentType(v)
+	return _u
+}
+
+// SetNillableEmploymentType sets the "employment_type" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableEmploymentType(v *employee.EmploymentType) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetEmploymentType(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *EmployeeUpdateOne) SetStatus(v employee.Status) *EmployeeUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableStatus(v *employee.Status) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHireDate sets the "hire_date" field.
+func (_u *EmployeeUpdateOne) SetHireDate(v time.Time) *EmployeeUpdateOne {
+	_u.mutation.SetHireDate(v)
+	return _u
+}
+
+// SetNillableHireDate sets the "hire_date" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableHireDate(v *time.Time) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetHireDate(*v)
+	}
+	return _u
+}
+
+// ClearHireDate clears the value of the "hire_date" field.
+func (_u *EmployeeUpdateOne) ClearHireDate() *EmployeeUpdateOne {
+	_u.mutation.ClearHireDate()
+	return _u
+}
+
+// SetTerminationDate sets the "termination_date" field.
+func (_u *EmployeeUpdateOne) SetTerminationDate(v time.Time) *EmployeeUpdateOne {
+	_u.mutation.SetTerminationDate(v)
+	return _u
+}
+
+// SetNillableTerminationDate sets the "termination_date" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableTerminationDate(v *time.Time) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetTerminationDate(*v)
+	}
+	return _u
+}
+
+// ClearTerminationDate clears the value of the "termination_date" field.
+func (_u *EmployeeUpdateOne) ClearTerminationDate() *EmployeeUpdateOne {
+	_u.mutation.ClearTerminationDate()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *EmployeeUpdateOne) SetPhone(v string) *EmployeeUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillablePhone(v *string) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *EmployeeUpdateOne) ClearPhone() *EmployeeUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EmployeeUpdateOne) SetLocation(v string) *EmployeeUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EmployeeUpdateOne) SetNillableLocation(v *string) *EmployeeUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *EmployeeUpdateOne) ClearLocation() *EmployeeUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *EmployeeUpdateOne) SetUpdatedAt(v time.Time) *EmployeeUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -368,6 +933,51 @@ func (_u *EmployeeUpdateOne) AddProjects(v ...*Project) *EmployeeUpdateOne {
 	return _u.AddProjectIDs(ids...)
 }
 
+// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
+func (_u *EmployeeUpdateOne) AddAssignedTaskIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.AddAssignedTaskIDs(ids...)
+	return _u
+}
+
+// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
+func (_u *EmployeeUpdateOne) AddAssignedTasks(v ...*Task) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedTaskIDs(ids...)
+}
+
+// AddLedProjectIDs adds the "led_projects" edge to the Project entity by IDs.
+func (_u *EmployeeUpdateOne) AddLedProjectIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.AddLedProjectIDs(ids...)
+	return _u
+}
+
+// AddLedProjects adds the "led_projects" edges to the Project entity.
+func (_u *EmployeeUpdateOne) AddLedProjects(v ...*Project) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLedProjectIDs(ids...)
+}
+
+// AddSkillIDs adds the "skills" edge to the Skill entity by IDs.
+func (_u *EmployeeUpdateOne) AddSkillIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.AddSkillIDs(ids...)
+	return _u
+}
+
+// AddSkills adds the "skills" edges to the Skill entity.
+func (_u *EmployeeUpdateOne) AddSkills(v ...*Skill) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSkillIDs(ids...)
+}
+
 // Mutation returns the EmployeeMutation object of the builder.
 func (_u *EmployeeUpdateOne) Mutation() *EmployeeMutation {
 	return _u.mutation
@@ -400,6 +1010,69 @@ func (_u *EmployeeUpdateOne) RemoveProjects(v ...*Project) *EmployeeUpdateOne {
 	return _u.RemoveProjectIDs(ids...)
 }
 
+// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
+func (_u *EmployeeUpdateOne) ClearAssignedTasks() *EmployeeUpdateOne {
+	_u.mutation.ClearAssignedTasks()
+	return _u
+}
+
+// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
+func (_u *EmployeeUpdateOne) RemoveAssignedTaskIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.RemoveAssignedTaskIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
+func (_u *EmployeeUpdateOne) RemoveAssignedTasks(v ...*Task) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedTaskIDs(ids...)
+}
+
+// ClearLedProjects clears all "led_projects" edges to the Project entity.
+func (_u *EmployeeUpdateOne) ClearLedProjects() *EmployeeUpdateOne {
+	_u.mutation.ClearLedProjects()
+	return _u
+}
+
+// RemoveLedProjectIDs removes the "led_projects" edge to Project entities by IDs.
+func (_u *EmployeeUpdateOne) RemoveLedProjectIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.RemoveLedProjectIDs(ids...)
+	return _u
+}
+
+// RemoveLedProjects removes "led_projects" edges to Project entities.
+func (_u *EmployeeUpdateOne) RemoveLedProjects(v ...*Project) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLedProjectIDs(ids...)
+}
+
+// ClearSkills clears all "skills" edges to the Skill entity.
+func (_u *EmployeeUpdateOne) ClearSkills() *EmployeeUpdateOne {
+	_u.mutation.ClearSkills()
+	return _u
+}
+
+// RemoveSkillIDs removes the "skills" edge to Skill entities by IDs.
+func (_u *EmployeeUpdateOne) RemoveSkillIDs(ids ...uuid.UUID) *EmployeeUpdateOne {
+	_u.mutation.RemoveSkillIDs(ids...)
+	return _u
+}
+
+// RemoveSkills removes "skills" edges to Skill entities.
+func (_u *EmployeeUpdateOne) RemoveSkills(v ...*Skill) *EmployeeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSkillIDs(ids...)
+}
+
 // Where appends a list predicates to the EmployeeUpdate builder.
 func (_u *EmployeeUpdateOne) Where(ps ...predicate.Employee) *EmployeeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -415,7 +1088,9 @@ func (_u *EmployeeUpdateOne) Select(field string, fields ...string) *EmployeeUpd
 
 // Save executes the query and returns the updated Employee entity.
 func (_u *EmployeeUpdateOne) Save(ctx context.Context) (*Employee, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -442,11 +1117,15 @@ func (_u *EmployeeUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *EmployeeUpdateOne) defaults() {
+func (_u *EmployeeUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if employee.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employee.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := employee.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -461,6 +1140,16 @@ func (_u *EmployeeUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Employee.email": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.EmploymentType(); ok {
+		if err := employee.EmploymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "employment_type", err: fmt.Errorf(`ent: validator failed for field "Employee.employment_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := employee.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Employee.status": %w`, err)}
+		}
+	}
 	if _u.mutation.DepartmentCleared() && len(_u.mutation.DepartmentIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Employee.department"`)
 	}
@@ -502,6 +1191,42 @@ func (_u *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(employee.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.JobTitle(); ok {
+		_spec.SetField(employee.FieldJobTitle, field.TypeString, value)
+	}
+	if _u.mutation.JobTitleCleared() {
+		_spec.ClearField(employee.FieldJobTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmploymentType(); ok {
+		_spec.SetField(employee.FieldEmploymentType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(employee.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HireDate(); ok {
+		_spec.SetField(employee.FieldHireDate, field.TypeTime, value)
+	}
+	if _u.mutation.HireDateCleared() {
+		_spec.ClearField(employee.FieldHireDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TerminationDate(); ok {
+		_spec.SetField(employee.FieldTerminationDate, field.TypeTime, value)
+	}
+	if _u.mutation.TerminationDateCleared() {
+		_spec.ClearField(employee.FieldTerminationDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(employee.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(employee.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(employee.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(employee.FieldLocation, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(employee.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -579,6 +1304,153 @@ func (_u *EmployeeUpdateOne) sqlSave(ctx context.Context) (_node *Employee, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !_u.mutation.AssignedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.AssignedTasksTable,
+			Columns: []string{employee.AssignedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLedProjectsIDs(); len(nodes) > 0 && !_u.mutation.LedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   employee.LedProjectsTable,
+			Columns: []string{employee.LedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSkillsIDs(); len(nodes) > 0 && !_u.mutation.SkillsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   employee.SkillsTable,
+			Columns: employee.SkillsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &EmployeeSkillCreate{config: _u.config, mutation: newEmployeeSkillMutation(_u.config, OpCreate)}
+		_ = createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Employee{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
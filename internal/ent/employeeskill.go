@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/skill"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EmployeeSkill is the model entity for the EmployeeSkill schema.
+type EmployeeSkill struct {
+	config `json:"-"`
+	// Foreign key reference to the employee
+	EmployeeID uuid.UUID `json:"employee_id,omitempty"`
+	// Foreign key reference to the skill
+	SkillID uuid.UUID `json:"skill_id,omitempty"`
+	// Proficiency level from 1 (beginner) to 5 (expert)
+	Level int `json:"level,omitempty"`
+	// Timestamp when the level was last set
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmployeeSkillQuery when eager-loading is set.
+	Edges        EmployeeSkillEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EmployeeSkillEdges holds the relations/edges for other nodes in the graph.
+type EmployeeSkillEdges struct {
+	// Employee holds the value of the employee edge.
+	Employee *Employee `json:"employee,omitempty"`
+	// Skill holds the value of the skill edge.
+	Skill *Skill `json:"skill,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeeOrErr returns the Employee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmployeeSkillEdges) EmployeeOrErr() (*Employee, error) {
+	if e.Employee != nil {
+		return e.Employee, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "employee"}
+}
+
+// SkillOrErr returns the Skill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmployeeSkillEdges) SkillOrErr() (*Skill, error) {
+	if e.Skill != nil {
+		return e.Skill, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: skill.Label}
+	}
+	return nil, &NotLoadedError{edge: "skill"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmployeeSkill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case employeeskill.FieldLevel:
+			values[i] = new(sql.NullInt64)
+		case employeeskill.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case employeeskill.FieldEmployeeID, employeeskill.FieldSkillID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmployeeSkill fields.
+func (_m *EmployeeSkill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case employeeskill.FieldEmployeeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field employee_id", values[i])
+			} else if value != nil {
+				_m.EmployeeID = *value
+			}
+		case employeeskill.FieldSkillID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field skill_id", values[i])
+			} else if value != nil {
+				_m.SkillID = *value
+			}
+		case employeeskill.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = int(value.Int64)
+			}
+		case employeeskill.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmployeeSkill.
+// This includes values selected through modifiers, order, etc.
+func (_m *EmployeeSkill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEmployee queries the "employee" edge of the EmployeeSkill entity.
+func (_m *EmployeeSkill) QueryEmployee() *EmployeeQuery {
+	return NewEmployeeSkillClient(_m.config).QueryEmployee(_m)
+}
+
+// QuerySkill queries the "skill" edge of the EmployeeSkill entity.
+func (_m *EmployeeSkill) QuerySkill() *SkillQuery {
+	return NewEmployeeSkillClient(_m.config).QuerySkill(_m)
+}
+
+// Update returns a builder for updating this EmployeeSkill.
+// Note that you need to call EmployeeSkill.Unwrap() before calling this method if this EmployeeSkill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EmployeeSkill) Update() *EmployeeSkillUpdateOne {
+	return NewEmployeeSkillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EmployeeSkill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EmployeeSkill) Unwrap() *EmployeeSkill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmployeeSkill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EmployeeSkill) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmployeeSkill(")
+	builder.WriteString("employee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmployeeID))
+	builder.WriteString(", ")
+	builder.WriteString("skill_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkillID))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Level))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmployeeSkills is a parsable slice of EmployeeSkill.
+type EmployeeSkills []*EmployeeSkill
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package employeeskill
+
+import (
+	"gin-crud-api/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// EmployeeID applies equality check predicate on the "employee_id" field. It's identical to EmployeeIDEQ.
+func EmployeeID(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// SkillID applies equality check predicate on the "skill_id" field. It's identical to SkillIDEQ.
+func SkillID(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldSkillID, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldLevel, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmployeeIDEQ applies the EQ predicate on the "employee_id" field.
+func EmployeeIDEQ(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDNEQ applies the NEQ predicate on the "employee_id" field.
+func EmployeeIDNEQ(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNEQ(FieldEmployeeID, v))
+}
+
+// EmployeeIDIn applies the In predicate on the "employee_id" field.
+func EmployeeIDIn(vs ...uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldIn(FieldEmployeeID, vs...))
+}
+
+// EmployeeIDNotIn applies the NotIn predicate on the "employee_id" field.
+func EmployeeIDNotIn(vs ...uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNotIn(FieldEmployeeID, vs...))
+}
+
+// SkillIDEQ applies the EQ predicate on the "skill_id" field.
+func SkillIDEQ(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldSkillID, v))
+}
+
+// SkillIDNEQ applies the NEQ predicate on the "skill_id" field.
+func SkillIDNEQ(v uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNEQ(FieldSkillID, v))
+}
+
+// SkillIDIn applies the In predicate on the "skill_id" field.
+func SkillIDIn(vs ...uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldIn(FieldSkillID, vs...))
+}
+
+// SkillIDNotIn applies the NotIn predicate on the "skill_id" field.
+func SkillIDNotIn(vs ...uuid.UUID) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNotIn(FieldSkillID, vs...))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldLTE(FieldLevel, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasEmployee applies the HasEdge predicate on the "employee" edge.
+func HasEmployee() predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, EmployeeColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, EmployeeTable, EmployeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmployeeWith applies the HasEdge predicate on the "employee" edge with a given conditions (other predicates).
+func HasEmployeeWith(preds ...predicate.Employee) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(func(s *sql.Selector) {
+		step := newEmployeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSkill applies the HasEdge predicate on the "skill" edge.
+func HasSkill() predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, SkillColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, SkillTable, SkillColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSkillWith applies the HasEdge predicate on the "skill" edge with a given conditions (other predicates).
+func HasSkillWith(preds ...predicate.Skill) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(func(s *sql.Selector) {
+		step := newSkillStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmployeeSkill) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmployeeSkill) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmployeeSkill) predicate.EmployeeSkill {
+	return predicate.EmployeeSkill(sql.NotPredicates(p))
+}
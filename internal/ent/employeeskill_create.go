@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/skill"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmployeeSkillCreate is the builder for creating a EmployeeSkill entity.
+type EmployeeSkillCreate struct {
+	config
+	mutation *EmployeeSkillMutation
+	hooks    []Hook
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (_c *EmployeeSkillCreate) SetEmployeeID(v uuid.UUID) *EmployeeSkillCreate {
+	_c.mutation.SetEmployeeID(v)
+	return _c
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_c *EmployeeSkillCreate) SetSkillID(v uuid.UUID) *EmployeeSkillCreate {
+	_c.mutation.SetSkillID(v)
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *EmployeeSkillCreate) SetLevel(v int) *EmployeeSkillCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EmployeeSkillCreate) SetUpdatedAt(v time.Time) *EmployeeSkillCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EmployeeSkillCreate) SetNillableUpdatedAt(v *time.Time) *EmployeeSkillCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (_c *EmployeeSkillCreate) SetEmployee(v *Employee) *EmployeeSkillCreate {
+	return _c.SetEmployeeID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_c *EmployeeSkillCreate) SetSkill(v *Skill) *EmployeeSkillCreate {
+	return _c.SetSkillID(v.ID)
+}
+
+// Mutation returns the EmployeeSkillMutation object of the builder.
+func (_c *EmployeeSkillCreate) Mutation() *EmployeeSkillMutation {
+	return _c.mutation
+}
+
+// Save creates the EmployeeSkill in the database.
+func (_c *EmployeeSkillCreate) Save(ctx context.Context) (*EmployeeSkill, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmployeeSkillCreate) SaveX(ctx context.Context) *EmployeeSkill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmployeeSkillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmployeeSkillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmployeeSkillCreate) defaults() error {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if employeeskill.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employeeskill.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := employeeskill.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmployeeSkillCreate) check() error {
+	if _, ok := _c.mutation.EmployeeID(); !ok {
+		return &ValidationError{Name: "employee_id", err: errors.New(`ent: missing required field "EmployeeSkill.employee_id"`)}
+	}
+	if _, ok := _c.mutation.SkillID(); !ok {
+		return &ValidationError{Name: "skill_id", err: errors.New(`ent: missing required field "EmployeeSkill.skill_id"`)}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "EmployeeSkill.level"`)}
+	}
+	if v, ok := _c.mutation.Level(); ok {
+		if err := employeeskill.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "EmployeeSkill.level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmployeeSkill.updated_at"`)}
+	}
+	if len(_c.mutation.EmployeeIDs()) == 0 {
+		return &ValidationError{Name: "employee", err: errors.New(`ent: missing required edge "EmployeeSkill.employee"`)}
+	}
+	if len(_c.mutation.SkillIDs()) == 0 {
+		return &ValidationError{Name: "skill", err: errors.New(`ent: missing required edge "EmployeeSkill.skill"`)}
+	}
+	return nil
+}
+
+func (_c *EmployeeSkillCreate) sqlSave(ctx context.Context) (*EmployeeSkill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *EmployeeSkillCreate) createSpec() (*EmployeeSkill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmployeeSkill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(employeeskill.Table, nil)
+	)
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(employeeskill.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeeskill.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.EmployeeTable,
+			Columns: []string{employeeskill.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EmployeeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.SkillTable,
+			Columns: []string{employeeskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SkillID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EmployeeSkillCreateBulk is the builder for creating many EmployeeSkill entities in bulk.
+type EmployeeSkillCreateBulk struct {
+	config
+	err      error
+	builders []*EmployeeSkillCreate
+}
+
+// Save creates the EmployeeSkill entities in the database.
+func (_c *EmployeeSkillCreateBulk) Save(ctx context.Context) ([]*EmployeeSkill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmployeeSkill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmployeeSkillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmployeeSkillCreateBulk) SaveX(ctx context.Context) []*EmployeeSkill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmployeeSkillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmployeeSkillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/skill"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// EmployeeSkillQuery is the builder for querying EmployeeSkill entities.
+type EmployeeSkillQuery struct {
+	config
+	ctx          *QueryContext
+	order        []employeeskill.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.EmployeeSkill
+	withEmployee *EmployeeQuery
+	withSkill    *SkillQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmployeeSkillQuery builder.
+func (_q *EmployeeSkillQuery) Where(ps ...predicate.EmployeeSkill) *EmployeeSkillQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *EmployeeSkillQuery) Limit(limit int) *EmployeeSkillQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *EmployeeSkillQuery) Offset(offset int) *EmployeeSkillQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *EmployeeSkillQuery) Unique(unique bool) *EmployeeSkillQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *EmployeeSkillQuery) Order(o ...employeeskill.OrderOption) *EmployeeSkillQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryEmployee chains the current query on the "employee" edge.
+func (_q *EmployeeSkillQuery) QueryEmployee() *EmployeeQuery {
+	query := (&EmployeeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeeskill.Table, employeeskill.EmployeeColumn, selector),
+			sqlgraph.To(employee.Table, employee.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, employeeskill.EmployeeTable, employeeskill.EmployeeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySkill chains the current query on the "skill" edge.
+func (_q *EmployeeSkillQuery) QuerySkill() *SkillQuery {
+	query := (&SkillClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(employeeskill.Table, employeeskill.SkillColumn, selector),
+			sqlgraph.To(skill.Table, skill.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, employeeskill.SkillTable, employeeskill.SkillColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EmployeeSkill entity from the query.
+// Returns a *NotFoundError when no EmployeeSkill was found.
+func (_q *EmployeeSkillQuery) First(ctx context.Context) (*EmployeeSkill, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{employeeskill.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *EmployeeSkillQuery) FirstX(ctx context.Context) *EmployeeSkill {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single EmployeeSkill entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmployeeSkill entity is found.
+// Returns a *NotFoundError when no EmployeeSkill entities are found.
+func (_q *EmployeeSkillQuery) Only(ctx context.Context) (*EmployeeSkill, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{employeeskill.Label}
+	default:
+		return nil, &NotSingularError{employeeskill.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *EmployeeSkillQuery) OnlyX(ctx context.Context) *EmployeeSkill {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of EmployeeSkills.
+func (_q *EmployeeSkillQuery) All(ctx context.Context) ([]*EmployeeSkill, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmployeeSkill, *EmployeeSkillQuery]()
+	return withInterceptors[[]*EmployeeSkill](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *EmployeeSkillQuery) AllX(ctx context.Context) []*EmployeeSkill {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (_q *EmployeeSkillQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*EmployeeSkillQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *EmployeeSkillQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *EmployeeSkillQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *EmployeeSkillQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmployeeSkillQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *EmployeeSkillQuery) Clone() *EmployeeSkillQuery {
+	if _q == nil {
+		return nil
+	}
+	return &EmployeeSkillQuery{
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]employeeskill.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.EmployeeSkill{}, _q.predicates...),
+		withEmployee: _q.withEmployee.Clone(),
+		withSkill:    _q.withSkill.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithEmployee tells the query-builder to eager-load the nodes that are connected to
+// the "employee" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeSkillQuery) WithEmployee(opts ...func(*EmployeeQuery)) *EmployeeSkillQuery {
+	query := (&EmployeeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEmployee = query
+	return _q
+}
+
+// WithSkill tells the query-builder to eager-load the nodes that are connected to
+// the "skill" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EmployeeSkillQuery) WithSkill(opts ...func(*SkillQuery)) *EmployeeSkillQuery {
+	query := (&SkillClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSkill = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID uuid.UUID `json:"employee_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmployeeSkill.Query().
+//		GroupBy(employeeskill.FieldEmployeeID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *EmployeeSkillQuery) GroupBy(field string, fields ...string) *EmployeeSkillGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmployeeSkillGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = employeeskill.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EmployeeID uuid.UUID `json:"employee_id,omitempty"`
+//	}
+//
+//	client.EmployeeSkill.Query().
+//		Select(employeeskill.FieldEmployeeID).
+//		Scan(ctx, &v)
+func (_q *EmployeeSkillQuery) Select(fields ...string) *EmployeeSkillSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &EmployeeSkillSelect{EmployeeSkillQuery: _q}
+	sbuild.label = employeeskill.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmployeeSkillSelect configured with the given aggregations.
+func (_q *EmployeeSkillQuery) Aggregate(fns ...AggregateFunc) *EmployeeSkillSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *EmployeeSkillQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !employeeskill.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *EmployeeSkillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmployeeSkill, error) {
+	var (
+		nodes       = []*EmployeeSkill{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withEmployee != nil,
+			_q.withSkill != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmployeeSkill).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmployeeSkill{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withEmployee; query != nil {
+		if err := _q.loadEmployee(ctx, query, nodes, nil,
+			func(n *EmployeeSkill, e *Employee) { n.Edges.Employee = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSkill; query != nil {
+		if err := _q.loadSkill(ctx, query, nodes, nil,
+			func(n *EmployeeSkill, e *Skill) { n.Edges.Skill = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *EmployeeSkillQuery) loadEmployee(ctx context.Context, query *EmployeeQuery, nodes []*EmployeeSkill, init func(*EmployeeSkill), assign func(*EmployeeSkill, *Employee)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EmployeeSkill)
+	for i := range nodes {
+		fk := nodes[i].EmployeeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(employee.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "employee_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EmployeeSkillQuery) loadSkill(ctx context.Context, query *SkillQuery, nodes []*EmployeeSkill, init func(*EmployeeSkill), assign func(*EmployeeSkill, *Skill)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EmployeeSkill)
+	for i := range nodes {
+		fk := nodes[i].SkillID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(skill.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "skill_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *EmployeeSkillQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *EmployeeSkillQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(employeeskill.Table, employeeskill.Columns, nil)
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if _q.withEmployee != nil {
+			_spec.Node.AddColumnOnce(employeeskill.FieldEmployeeID)
+		}
+		if _q.withSkill != nil {
+			_spec.Node.AddColumnOnce(employeeskill.FieldSkillID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *EmployeeSkillQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(employeeskill.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = employeeskill.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EmployeeSkillGroupBy is the group-by builder for EmployeeSkill entities.
+type EmployeeSkillGroupBy struct {
+	selector
+	build *EmployeeSkillQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *EmployeeSkillGroupBy) Aggregate(fns ...AggregateFunc) *EmployeeSkillGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *EmployeeSkillGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeSkillQuery, *EmployeeSkillGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *EmployeeSkillGroupBy) sqlScan(ctx context.Context, root *EmployeeSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmployeeSkillSelect is the builder for selecting fields of EmployeeSkill entities.
+type EmployeeSkillSelect struct {
+	*EmployeeSkillQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *EmployeeSkillSelect) Aggregate(fns ...AggregateFunc) *EmployeeSkillSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *EmployeeSkillSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmployeeSkillQuery, *EmployeeSkillSelect](ctx, _s.EmployeeSkillQuery, _s, _s.inters, v)
+}
+
+func (_s *EmployeeSkillSelect) sqlScan(ctx context.Context, root *EmployeeSkillQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,537 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/skill"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmployeeSkillUpdate is the builder for updating EmployeeSkill entities.
+type EmployeeSkillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmployeeSkillMutation
+}
+
+// Where appends a list predicates to the EmployeeSkillUpdate builder.
+func (_u *EmployeeSkillUpdate) Where(ps ...predicate.EmployeeSkill) *EmployeeSkillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (_u *EmployeeSkillUpdate) SetEmployeeID(v uuid.UUID) *EmployeeSkillUpdate {
+	_u.mutation.SetEmployeeID(v)
+	return _u
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (_u *EmployeeSkillUpdate) SetNillableEmployeeID(v *uuid.UUID) *EmployeeSkillUpdate {
+	if v != nil {
+		_u.SetEmployeeID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *EmployeeSkillUpdate) SetSkillID(v uuid.UUID) *EmployeeSkillUpdate {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *EmployeeSkillUpdate) SetNillableSkillID(v *uuid.UUID) *EmployeeSkillUpdate {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *EmployeeSkillUpdate) SetLevel(v int) *EmployeeSkillUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *EmployeeSkillUpdate) SetNillableLevel(v *int) *EmployeeSkillUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *EmployeeSkillUpdate) AddLevel(v int) *EmployeeSkillUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmployeeSkillUpdate) SetUpdatedAt(v time.Time) *EmployeeSkillUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (_u *EmployeeSkillUpdate) SetEmployee(v *Employee) *EmployeeSkillUpdate {
+	return _u.SetEmployeeID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_u *EmployeeSkillUpdate) SetSkill(v *Skill) *EmployeeSkillUpdate {
+	return _u.SetSkillID(v.ID)
+}
+
+// Mutation returns the EmployeeSkillMutation object of the builder.
+func (_u *EmployeeSkillUpdate) Mutation() *EmployeeSkillMutation {
+	return _u.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (_u *EmployeeSkillUpdate) ClearEmployee() *EmployeeSkillUpdate {
+	_u.mutation.ClearEmployee()
+	return _u
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (_u *EmployeeSkillUpdate) ClearSkill() *EmployeeSkillUpdate {
+	_u.mutation.ClearSkill()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmployeeSkillUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmployeeSkillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmployeeSkillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmployeeSkillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmployeeSkillUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if employeeskill.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employeeskill.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := employeeskill.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmployeeSkillUpdate) check() error {
+	if v, ok := _u.mutation.Level(); ok {
+		if err := employeeskill.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "EmployeeSkill.level": %w`, err)}
+		}
+	}
+	if _u.mutation.EmployeeCleared() && len(_u.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeSkill.employee"`)
+	}
+	if _u.mutation.SkillCleared() && len(_u.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeSkill.skill"`)
+	}
+	return nil
+}
+
+func (_u *EmployeeSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeeskill.Table, employeeskill.Columns, sqlgraph.NewFieldSpec(employeeskill.FieldEmployeeID, field.TypeUUID), sqlgraph.NewFieldSpec(employeeskill.FieldSkillID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(employeeskill.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(employeeskill.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeeskill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.EmployeeTable,
+			Columns: []string{employeeskill.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.EmployeeTable,
+			Columns: []string{employeeskill.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.SkillTable,
+			Columns: []string{employeeskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.SkillTable,
+			Columns: []string{employeeskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeeskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmployeeSkillUpdateOne is the builder for updating a single EmployeeSkill entity.
+type EmployeeSkillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmployeeSkillMutation
+}
+
+// SetEmployeeID sets the "employee_id" field.
+func (_u *EmployeeSkillUpdateOne) SetEmployeeID(v uuid.UUID) *EmployeeSkillUpdateOne {
+	_u.mutation.SetEmployeeID(v)
+	return _u
+}
+
+// SetNillableEmployeeID sets the "employee_id" field if the given value is not nil.
+func (_u *EmployeeSkillUpdateOne) SetNillableEmployeeID(v *uuid.UUID) *EmployeeSkillUpdateOne {
+	if v != nil {
+		_u.SetEmployeeID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *EmployeeSkillUpdateOne) SetSkillID(v uuid.UUID) *EmployeeSkillUpdateOne {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *EmployeeSkillUpdateOne) SetNillableSkillID(v *uuid.UUID) *EmployeeSkillUpdateOne {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *EmployeeSkillUpdateOne) SetLevel(v int) *EmployeeSkillUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *EmployeeSkillUpdateOne) SetNillableLevel(v *int) *EmployeeSkillUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *EmployeeSkillUpdateOne) AddLevel(v int) *EmployeeSkillUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EmployeeSkillUpdateOne) SetUpdatedAt(v time.Time) *EmployeeSkillUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEmployee sets the "employee" edge to the Employee entity.
+func (_u *EmployeeSkillUpdateOne) SetEmployee(v *Employee) *EmployeeSkillUpdateOne {
+	return _u.SetEmployeeID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_u *EmployeeSkillUpdateOne) SetSkill(v *Skill) *EmployeeSkillUpdateOne {
+	return _u.SetSkillID(v.ID)
+}
+
+// Mutation returns the EmployeeSkillMutation object of the builder.
+func (_u *EmployeeSkillUpdateOne) Mutation() *EmployeeSkillMutation {
+	return _u.mutation
+}
+
+// ClearEmployee clears the "employee" edge to the Employee entity.
+func (_u *EmployeeSkillUpdateOne) ClearEmployee() *EmployeeSkillUpdateOne {
+	_u.mutation.ClearEmployee()
+	return _u
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (_u *EmployeeSkillUpdateOne) ClearSkill() *EmployeeSkillUpdateOne {
+	_u.mutation.ClearSkill()
+	return _u
+}
+
+// Where appends a list predicates to the EmployeeSkillUpdate builder.
+func (_u *EmployeeSkillUpdateOne) Where(ps ...predicate.EmployeeSkill) *EmployeeSkillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmployeeSkillUpdateOne) Select(field string, fields ...string) *EmployeeSkillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmployeeSkill entity.
+func (_u *EmployeeSkillUpdateOne) Save(ctx context.Context) (*EmployeeSkill, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmployeeSkillUpdateOne) SaveX(ctx context.Context) *EmployeeSkill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmployeeSkillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmployeeSkillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EmployeeSkillUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if employeeskill.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized employeeskill.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := employeeskill.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EmployeeSkillUpdateOne) check() error {
+	if v, ok := _u.mutation.Level(); ok {
+		if err := employeeskill.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "EmployeeSkill.level": %w`, err)}
+		}
+	}
+	if _u.mutation.EmployeeCleared() && len(_u.mutation.EmployeeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeSkill.employee"`)
+	}
+	if _u.mutation.SkillCleared() && len(_u.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EmployeeSkill.skill"`)
+	}
+	return nil
+}
+
+func (_u *EmployeeSkillUpdateOne) sqlSave(ctx context.Context) (_node *EmployeeSkill, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(employeeskill.Table, employeeskill.Columns, sqlgraph.NewFieldSpec(employeeskill.FieldEmployeeID, field.TypeUUID), sqlgraph.NewFieldSpec(employeeskill.FieldSkillID, field.TypeUUID))
+	if id, ok := _u.mutation.EmployeeID(); !ok {
+		return nil, &ValidationError{Name: "employee_id", err: errors.New(`ent: missing "EmployeeSkill.employee_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.SkillID(); !ok {
+		return nil, &ValidationError{Name: "skill_id", err: errors.New(`ent: missing "EmployeeSkill.skill_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !employeeskill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(employeeskill.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(employeeskill.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(employeeskill.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmployeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.EmployeeTable,
+			Columns: []string{employeeskill.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmployeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.EmployeeTable,
+			Columns: []string{employeeskill.EmployeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(employee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.SkillTable,
+			Columns: []string{employeeskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   employeeskill.SkillTable,
+			Columns: []string{employeeskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmployeeSkill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employeeskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,7 +8,18 @@ import (
 	"fmt"
 	"gin-crud-api/internal/ent/department"
 	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/idempotencykey"
+	"gin-crud-api/internal/ent/milestone"
+	"gin-crud-api/internal/ent/outboxevent"
+	"gin-crud-api/internal/ent/outboxoffset"
 	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/ent/webhookdelivery"
+	"gin-crud-api/internal/ent/webhooksubscription"
 	"reflect"
 	"sync"
 
@@ -75,9 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			department.Table: department.ValidColumn,
-			employee.Table:   employee.ValidColumn,
-			project.Table:    project.ValidColumn,
+			department.Table:          department.ValidColumn,
+			employee.Table:            employee.ValidColumn,
+			employeeskill.Table:       employeeskill.ValidColumn,
+			idempotencykey.Table:      idempotencykey.ValidColumn,
+			milestone.Table:           milestone.ValidColumn,
+			outboxevent.Table:         outboxevent.ValidColumn,
+			outboxoffset.Table:        outboxoffset.ValidColumn,
+			project.Table:             project.ValidColumn,
+			projectskill.Table:        projectskill.ValidColumn,
+			projectstatuschange.Table: projectstatuschange.ValidColumn,
+			skill.Table:               skill.ValidColumn,
+			task.Table:                task.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
+			webhooksubscription.Table: webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -11,21 +11,51 @@ var (
 	// DepartmentsColumns holds the columns for the "departments" table.
 	DepartmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// DepartmentsTable holds the schema information for the "departments" table.
 	DepartmentsTable = &schema.Table{
 		Name:       "departments",
 		Columns:    DepartmentsColumns,
 		PrimaryKey: []*schema.Column{DepartmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "departments_departments_children",
+				Columns:    []*schema.Column{DepartmentsColumns[5]},
+				RefColumns: []*schema.Column{DepartmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "department_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{DepartmentsColumns[1]},
+			},
+			{
+				Name:    "department_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{DepartmentsColumns[5]},
+			},
+		},
 	}
 	// EmployeesColumns holds the columns for the "employees" table.
 	EmployeesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
-		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "job_title", Type: field.TypeString, Nullable: true},
+		{Name: "employment_type", Type: field.TypeEnum, Enums: []string{"FULL_TIME", "CONTRACTOR", "INTERN"}, Default: "FULL_TIME"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "ON_LEAVE", "TERMINATED"}, Default: "ACTIVE"},
+		{Name: "hire_date", Type: field.TypeTime, Nullable: true},
+		{Name: "termination_date", Type: field.TypeTime, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "department_id", Type: field.TypeUUID},
@@ -38,57 +68,415 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "employees_departments_employees",
-				Columns:    []*schema.Column{EmployeesColumns[5]},
+				Columns:    []*schema.Column{EmployeesColumns[13]},
 				RefColumns: []*schema.Column{DepartmentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "employee_email",
+				Name:    "employee_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmployeesColumns[1]},
+			},
+			{
+				Name:    "employee_tenant_id_email",
 				Unique:  true,
-				Columns: []*schema.Column{EmployeesColumns[2]},
+				Columns: []*schema.Column{EmployeesColumns[1], EmployeesColumns[3]},
 			},
 			{
 				Name:    "employee_department_id",
 				Unique:  false,
-				Columns: []*schema.Column{EmployeesColumns[5]},
+				Columns: []*schema.Column{EmployeesColumns[13]},
+			},
+			{
+				Name:    "employee_status",
+				Unique:  false,
+				Columns: []*schema.Column{EmployeesColumns[6]},
+			},
+		},
+	}
+	// EmployeeSkillsColumns holds the columns for the "employee_skills" table.
+	EmployeeSkillsColumns = []*schema.Column{
+		{Name: "level", Type: field.TypeInt},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "employee_id", Type: field.TypeUUID},
+		{Name: "skill_id", Type: field.TypeUUID},
+	}
+	// EmployeeSkillsTable holds the schema information for the "employee_skills" table.
+	EmployeeSkillsTable = &schema.Table{
+		Name:       "employee_skills",
+		Columns:    EmployeeSkillsColumns,
+		PrimaryKey: []*schema.Column{EmployeeSkillsColumns[2], EmployeeSkillsColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "employee_skills_employees_employee",
+				Columns:    []*schema.Column{EmployeeSkillsColumns[2]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "employee_skills_skills_skill",
+				Columns:    []*schema.Column{EmployeeSkillsColumns[3]},
+				RefColumns: []*schema.Column{SkillsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "principal", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"IN_PROGRESS", "COMPLETED"}, Default: "IN_PROGRESS"},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_principal_key_operation",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2], IdempotencyKeysColumns[3]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[8]},
+			},
+		},
+	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"OPEN", "COMPLETED"}, Default: "OPEN"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeUUID},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_projects_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{MilestonesColumns[6]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "aggregate_type", Type: field.TypeEnum, Enums: []string{"Department", "Employee", "Project"}},
+		{Name: "aggregate_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[5]},
 			},
 		},
 	}
+	// OutboxOffsetsColumns holds the columns for the "outbox_offsets" table.
+	OutboxOffsetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OutboxOffsetsTable holds the schema information for the "outbox_offsets" table.
+	OutboxOffsetsTable = &schema.Table{
+		Name:       "outbox_offsets",
+		Columns:    OutboxOffsetsColumns,
+		PrimaryKey: []*schema.Column{OutboxOffsetsColumns[0]},
+	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeString, Default: "default"},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "COMPLETED", "ON_HOLD"}, Default: "ACTIVE"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PLANNED", "ACTIVE", "ON_HOLD", "COMPLETED", "CANCELLED"}, Default: "ACTIVE"},
 		{Name: "priority", Type: field.TypeEnum, Enums: []string{"HIGH", "MEDIUM", "LOW"}, Default: "MEDIUM"},
 		{Name: "start_date", Type: field.TypeTime},
 		{Name: "end_date", Type: field.TypeTime},
 		{Name: "budget", Type: field.TypeFloat64},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "lead_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
 		Name:       "projects",
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_employees_led_projects",
+				Columns:    []*schema.Column{ProjectsColumns[11]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "project_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[1]},
+			},
 			{
 				Name:    "project_status",
 				Unique:  false,
-				Columns: []*schema.Column{ProjectsColumns[3]},
+				Columns: []*schema.Column{ProjectsColumns[4]},
 			},
 			{
 				Name:    "project_priority",
 				Unique:  false,
-				Columns: []*schema.Column{ProjectsColumns[4]},
+				Columns: []*schema.Column{ProjectsColumns[5]},
+			},
+			{
+				Name:    "project_lead_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[11]},
 			},
 			{
 				Name:    "project_start_date_end_date",
 				Unique:  false,
-				Columns: []*schema.Column{ProjectsColumns[5], ProjectsColumns[6]},
+				Columns: []*schema.Column{ProjectsColumns[6], ProjectsColumns[7]},
+			},
+		},
+	}
+	// ProjectSkillsColumns holds the columns for the "project_skills" table.
+	ProjectSkillsColumns = []*schema.Column{
+		{Name: "min_level", Type: field.TypeInt, Default: 1},
+		{Name: "project_id", Type: field.TypeUUID},
+		{Name: "skill_id", Type: field.TypeUUID},
+	}
+	// ProjectSkillsTable holds the schema information for the "project_skills" table.
+	ProjectSkillsTable = &schema.Table{
+		Name:       "project_skills",
+		Columns:    ProjectSkillsColumns,
+		PrimaryKey: []*schema.Column{ProjectSkillsColumns[1], ProjectSkillsColumns[2]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_skills_projects_project",
+				Columns:    []*schema.Column{ProjectSkillsColumns[1]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_skills_skills_skill",
+				Columns:    []*schema.Column{ProjectSkillsColumns[2]},
+				RefColumns: []*schema.Column{SkillsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProjectStatusChangesColumns holds the columns for the "project_status_changes" table.
+	ProjectStatusChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "from_status", Type: field.TypeEnum, Enums: []string{"PLANNED", "ACTIVE", "ON_HOLD", "COMPLETED", "CANCELLED"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"PLANNED", "ACTIVE", "ON_HOLD", "COMPLETED", "CANCELLED"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "changed_by", Type: field.TypeString, Nullable: true},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeUUID},
+	}
+	// ProjectStatusChangesTable holds the schema information for the "project_status_changes" table.
+	ProjectStatusChangesTable = &schema.Table{
+		Name:       "project_status_changes",
+		Columns:    ProjectStatusChangesColumns,
+		PrimaryKey: []*schema.Column{ProjectStatusChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_status_changes_projects_status_changes",
+				Columns:    []*schema.Column{ProjectStatusChangesColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectstatuschange_project_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectStatusChangesColumns[6], ProjectStatusChangesColumns[5]},
+			},
+		},
+	}
+	// SkillsColumns holds the columns for the "skills" table.
+	SkillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeString, Default: "default"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SkillsTable holds the schema information for the "skills" table.
+	SkillsTable = &schema.Table{
+		Name:       "skills",
+		Columns:    SkillsColumns,
+		PrimaryKey: []*schema.Column{SkillsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "skill_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SkillsColumns[1]},
+			},
+			{
+				Name:    "skill_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{SkillsColumns[1], SkillsColumns[2]},
+			},
+		},
+	}
+	// TasksColumns holds the columns for the "tasks" table.
+	TasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"TODO", "IN_PROGRESS", "DONE"}, Default: "TODO"},
+		{Name: "estimate_hours", Type: field.TypeFloat64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "assignee_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "milestone_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "project_id", Type: field.TypeUUID},
+	}
+	// TasksTable holds the schema information for the "tasks" table.
+	TasksTable = &schema.Table{
+		Name:       "tasks",
+		Columns:    TasksColumns,
+		PrimaryKey: []*schema.Column{TasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tasks_employees_assigned_tasks",
+				Columns:    []*schema.Column{TasksColumns[7]},
+				RefColumns: []*schema.Column{EmployeesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_milestones_tasks",
+				Columns:    []*schema.Column{TasksColumns[8]},
+				RefColumns: []*schema.Column{MilestonesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tasks_projects_tasks",
+				Columns:    []*schema.Column{TasksColumns[9]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "task_project_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[9], TasksColumns[3]},
+			},
+			{
+				Name:    "task_assignee_id",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[7]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED", "DEAD"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeInt64},
+		{Name: "subscription_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_outbox_events_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[8]},
+				RefColumns: []*schema.Column{OutboxEventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_event_id_subscription_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[8], WebhookDeliveriesColumns[9]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeString, Default: "default"},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooksubscription_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookSubscriptionsColumns[1]},
 			},
 		},
 	}
@@ -121,13 +509,37 @@ var (
 	Tables = []*schema.Table{
 		DepartmentsTable,
 		EmployeesTable,
+		EmployeeSkillsTable,
+		IdempotencyKeysTable,
+		MilestonesTable,
+		OutboxEventsTable,
+		OutboxOffsetsTable,
 		ProjectsTable,
+		ProjectSkillsTable,
+		ProjectStatusChangesTable,
+		SkillsTable,
+		TasksTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 		ProjectTeamMembersTable,
 	}
 )
 
 func init() {
+	DepartmentsTable.ForeignKeys[0].RefTable = DepartmentsTable
 	EmployeesTable.ForeignKeys[0].RefTable = DepartmentsTable
+	EmployeeSkillsTable.ForeignKeys[0].RefTable = EmployeesTable
+	EmployeeSkillsTable.ForeignKeys[1].RefTable = SkillsTable
+	MilestonesTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectsTable.ForeignKeys[0].RefTable = EmployeesTable
+	ProjectSkillsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectSkillsTable.ForeignKeys[1].RefTable = SkillsTable
+	ProjectStatusChangesTable.ForeignKeys[0].RefTable = ProjectsTable
+	TasksTable.ForeignKeys[0].RefTable = EmployeesTable
+	TasksTable.ForeignKeys[1].RefTable = MilestonesTable
+	TasksTable.ForeignKeys[2].RefTable = ProjectsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = OutboxEventsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = WebhookSubscriptionsTable
 	ProjectTeamMembersTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectTeamMembersTable.ForeignKeys[1].RefTable = EmployeesTable
 }
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud-api/internal/ent/outboxoffset"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OutboxOffset is the model entity for the OutboxOffset schema.
+type OutboxOffset struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Name of the consumer
+	ID string `json:"id,omitempty"`
+	// ID of the last published event
+	Position int64 `json:"position,omitempty"`
+	// Timestamp when the offset was last committed
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxOffset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxoffset.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case outboxoffset.FieldID:
+			values[i] = new(sql.NullString)
+		case outboxoffset.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxOffset fields.
+func (_m *OutboxOffset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxoffset.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case outboxoffset.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = value.Int64
+			}
+		case outboxoffset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxOffset.
+// This includes values selected through modifiers, order, etc.
+func (_m *OutboxOffset) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxOffset.
+// Note that you need to call OutboxOffset.Unwrap() before calling this method if this OutboxOffset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OutboxOffset) Update() *OutboxOffsetUpdateOne {
+	return NewOutboxOffsetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OutboxOffset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OutboxOffset) Unwrap() *OutboxOffset {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxOffset is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OutboxOffset) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxOffset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxOffsets is a parsable slice of OutboxOffset.
+type OutboxOffsets []*OutboxOffset
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/outboxoffset"
+	"gin-crud-api/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxOffsetUpdate is the builder for updating OutboxOffset entities.
+type OutboxOffsetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxOffsetMutation
+}
+
+// Where appends a list predicates to the OutboxOffsetUpdate builder.
+func (_u *OutboxOffsetUpdate) Where(ps ...predicate.OutboxOffset) *OutboxOffsetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *OutboxOffsetUpdate) SetPosition(v int64) *OutboxOffsetUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *OutboxOffsetUpdate) SetNillablePosition(v *int64) *OutboxOffsetUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *OutboxOffsetUpdate) AddPosition(v int64) *OutboxOffsetUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OutboxOffsetUpdate) SetUpdatedAt(v time.Time) *OutboxOffsetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OutboxOffsetMutation object of the builder.
+func (_u *OutboxOffsetUpdate) Mutation() *OutboxOffsetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OutboxOffsetUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxOffsetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OutboxOffsetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxOffsetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OutboxOffsetUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := outboxoffset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboxOffsetUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := outboxoffset.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "OutboxOffset.position": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboxOffsetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxoffset.Table, outboxoffset.Columns, sqlgraph.NewFieldSpec(outboxoffset.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(outboxoffset.FieldPosition, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(outboxoffset.FieldPosition, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxoffset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxoffset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OutboxOffsetUpdateOne is the builder for updating a single OutboxOffset entity.
+type OutboxOffsetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxOffsetMutation
+}
+
+// SetPosition sets the "position" field.
+func (_u *OutboxOffsetUpdateOne) SetPosition(v int64) *OutboxOffsetUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *OutboxOffsetUpdateOne) SetNillablePosition(v *int64) *OutboxOffsetUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *OutboxOffsetUpdateOne) AddPosition(v int64) *OutboxOffsetUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OutboxOffsetUpdateOne) SetUpdatedAt(v time.Time) *OutboxOffsetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OutboxOffsetMutation object of the builder.
+func (_u *OutboxOffsetUpdateOne) Mutation() *OutboxOffsetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OutboxOffsetUpdate builder.
+func (_u *OutboxOffsetUpdateOne) Where(ps ...predicate.OutboxOffset) *OutboxOffsetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OutboxOffsetUpdateOne) Select(field string, fields ...string) *OutboxOffsetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OutboxOffset entity.
+func (_u *OutboxOffsetUpdateOne) Save(ctx context.Context) (*OutboxOffset, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxOffsetUpdateOne) SaveX(ctx context.Context) *OutboxOffset {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OutboxOffsetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxOffsetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OutboxOffsetUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := outboxoffset.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboxOffsetUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := outboxoffset.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "OutboxOffset.position": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboxOffsetUpdateOne) sqlSave(ctx context.Context) (_node *OutboxOffset, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxoffset.Table, outboxoffset.Columns, sqlgraph.NewFieldSpec(outboxoffset.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxOffset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxoffset.FieldID)
+		for _, f := range fields {
+			if !outboxoffset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxoffset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(outboxoffset.FieldPosition, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(outboxoffset.FieldPosition, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxoffset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &OutboxOffset{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxoffset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"gin-crud-api/internal/ent/employee"
 	"gin-crud-api/internal/ent/project"
 	"strings"
 	"time"
@@ -19,6 +20,8 @@ type Project struct {
 	// ID of the ent.
 	// Unique identifier for the project
 	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant (subsidiary) the row belongs to
+	TenantID string `json:"tenant_id,omitempty"`
 	// Name of the project
 	Name string `json:"name,omitempty"`
 	// Detailed description of the project
@@ -33,6 +36,8 @@ type Project struct {
 	EndDate time.Time `json:"end_date,omitempty"`
 	// Project budget amount
 	Budget float64 `json:"budget,omitempty"`
+	// Foreign key reference to the employee leading the project
+	LeadID *uuid.UUID `json:"lead_id,omitempty"`
 	// Timestamp when project was created
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Timestamp when project was last updated
@@ -47,9 +52,21 @@ type Project struct {
 type ProjectEdges struct {
 	// Employees assigned to this project (team members)
 	TeamMembers []*Employee `json:"team_members,omitempty"`
+	// The employee leading this project
+	Lead *Employee `json:"lead,omitempty"`
+	// Milestones of this project
+	Milestones []*Milestone `json:"milestones,omitempty"`
+	// Tasks of this project
+	Tasks []*Task `json:"tasks,omitempty"`
+	// History of status transitions
+	StatusChanges []*ProjectStatusChange `json:"status_changes,omitempty"`
+	// Skills team members should have
+	RequiredSkills []*Skill `json:"required_skills,omitempty"`
+	// ProjectSkills holds the value of the project_skills edge.
+	ProjectSkills []*ProjectSkill `json:"project_skills,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // TeamMembersOrErr returns the TeamMembers value or an error if the edge
@@ -61,14 +78,72 @@ func (e ProjectEdges) TeamMembersOrErr() ([]*Employee, error) {
 	return nil, &NotLoadedError{edge: "team_members"}
 }
 
+// LeadOrErr returns the Lead value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectEdges) LeadOrErr() (*Employee, error) {
+	if e.Lead != nil {
+		return e.Lead, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: employee.Label}
+	}
+	return nil, &NotLoadedError{edge: "lead"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[2] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[3] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) StatusChangesOrErr() ([]*ProjectStatusChange, error) {
+	if e.loadedTypes[4] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// RequiredSkillsOrErr returns the RequiredSkills value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) RequiredSkillsOrErr() ([]*Skill, error) {
+	if e.loadedTypes[5] {
+		return e.RequiredSkills, nil
+	}
+	return nil, &NotLoadedError{edge: "required_skills"}
+}
+
+// ProjectSkillsOrErr returns the ProjectSkills value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ProjectSkillsOrErr() ([]*ProjectSkill, error) {
+	if e.loadedTypes[6] {
+		return e.ProjectSkills, nil
+	}
+	return nil, &NotLoadedError{edge: "project_skills"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case project.FieldLeadID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case project.FieldBudget:
 			values[i] = new(sql.NullFloat64)
-		case project.FieldName, project.FieldDescription, project.FieldStatus, project.FieldPriority:
+		case project.FieldTenantID, project.FieldName, project.FieldDescription, project.FieldStatus, project.FieldPriority:
 			values[i] = new(sql.NullString)
 		case project.FieldStartDate, project.FieldEndDate, project.FieldCreatedAt, project.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -95,6 +170,12 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case project.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
 		case project.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -137,6 +218,13 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Budget = value.Float64
 			}
+		case project.FieldLeadID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = new(uuid.UUID)
+				*_m.LeadID = *value.S.(*uuid.UUID)
+			}
 		case project.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -167,6 +255,36 @@ func (_m *Project) QueryTeamMembers() *EmployeeQuery {
 	return NewProjectClient(_m.config).QueryTeamMembers(_m)
 }
 
+// QueryLead queries the "lead" edge of the Project entity.
+func (_m *Project) QueryLead() *EmployeeQuery {
+	return NewProjectClient(_m.config).QueryLead(_m)
+}
+
+// QueryMilestones queries the "milestones" edge of the Project entity.
+func (_m *Project) QueryMilestones() *MilestoneQuery {
+	return NewProjectClient(_m.config).QueryMilestones(_m)
+}
+
+// QueryTasks queries the "tasks" edge of the Project entity.
+func (_m *Project) QueryTasks() *TaskQuery {
+	return NewProjectClient(_m.config).QueryTasks(_m)
+}
+
+// QueryStatusChanges queries the "status_changes" edge of the Project entity.
+func (_m *Project) QueryStatusChanges() *ProjectStatusChangeQuery {
+	return NewProjectClient(_m.config).QueryStatusChanges(_m)
+}
+
+// QueryRequiredSkills queries the "required_skills" edge of the Project entity.
+func (_m *Project) QueryRequiredSkills() *SkillQuery {
+	return NewProjectClient(_m.config).QueryRequiredSkills(_m)
+}
+
+// QueryProjectSkills queries the "project_skills" edge of the Project entity.
+func (_m *Project) QueryProjectSkills() *ProjectSkillQuery {
+	return NewProjectClient(_m.config).QueryProjectSkills(_m)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -190,6 +308,9 @@ func (_m *Project) String() string {
 	var builder strings.Builder
 	builder.WriteString("Project(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -211,6 +332,11 @@ func (_m *Project) String() string {
 	builder.WriteString("budget=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Budget))
 	builder.WriteString(", ")
+	if v := _m.LeadID; v != nil {
+		builder.WriteString("lead_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
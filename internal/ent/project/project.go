@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -16,6 +17,8 @@ const (
 	Label = "project"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -30,12 +33,26 @@ const (
 	FieldEndDate = "end_date"
 	// FieldBudget holds the string denoting the budget field in the database.
 	FieldBudget = "budget"
+	// FieldLeadID holds the string denoting the lead_id field in the database.
+	FieldLeadID = "lead_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
 	EdgeTeamMembers = "team_members"
+	// EdgeLead holds the string denoting the lead edge name in mutations.
+	EdgeLead = "lead"
+	// EdgeMilestones holds the string denoting the milestones edge name in mutations.
+	EdgeMilestones = "milestones"
+	// EdgeTasks holds the string denoting the tasks edge name in mutations.
+	EdgeTasks = "tasks"
+	// EdgeStatusChanges holds the string denoting the status_changes edge name in mutations.
+	EdgeStatusChanges = "status_changes"
+	// EdgeRequiredSkills holds the string denoting the required_skills edge name in mutations.
+	EdgeRequiredSkills = "required_skills"
+	// EdgeProjectSkills holds the string denoting the project_skills edge name in mutations.
+	EdgeProjectSkills = "project_skills"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// TeamMembersTable is the table that holds the team_members relation/edge. The primary key declared below.
@@ -43,11 +60,52 @@ const (
 	// TeamMembersInverseTable is the table name for the Employee entity.
 	// It exists in this package in order to avoid circular dependency with the "employee" package.
 	TeamMembersInverseTable = "employees"
+	// LeadTable is the table that holds the lead relation/edge.
+	LeadTable = "projects"
+	// LeadInverseTable is the table name for the Employee entity.
+	// It exists in this package in order to avoid circular dependency with the "employee" package.
+	LeadInverseTable = "employees"
+	// LeadColumn is the table column denoting the lead relation/edge.
+	LeadColumn = "lead_id"
+	// MilestonesTable is the table that holds the milestones relation/edge.
+	MilestonesTable = "milestones"
+	// MilestonesInverseTable is the table name for the Milestone entity.
+	// It exists in this package in order to avoid circular dependency with the "milestone" package.
+	MilestonesInverseTable = "milestones"
+	// MilestonesColumn is the table column denoting the milestones relation/edge.
+	MilestonesColumn = "project_id"
+	// TasksTable is the table that holds the tasks relation/edge.
+	TasksTable = "tasks"
+	// TasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TasksInverseTable = "tasks"
+	// TasksColumn is the table column denoting the tasks relation/edge.
+	TasksColumn = "project_id"
+	// StatusChangesTable is the table that holds the status_changes relation/edge.
+	StatusChangesTable = "project_status_changes"
+	// StatusChangesInverseTable is the table name for the ProjectStatusChange entity.
+	// It exists in this package in order to avoid circular dependency with the "projectstatuschange" package.
+	StatusChangesInverseTable = "project_status_changes"
+	// StatusChangesColumn is the table column denoting the status_changes relation/edge.
+	StatusChangesColumn = "project_id"
+	// RequiredSkillsTable is the table that holds the required_skills relation/edge. The primary key declared below.
+	RequiredSkillsTable = "project_skills"
+	// RequiredSkillsInverseTable is the table name for the Skill entity.
+	// It exists in this package in order to avoid circular dependency with the "skill" package.
+	RequiredSkillsInverseTable = "skills"
+	// ProjectSkillsTable is the table that holds the project_skills relation/edge.
+	ProjectSkillsTable = "project_skills"
+	// ProjectSkillsInverseTable is the table name for the ProjectSkill entity.
+	// It exists in this package in order to avoid circular dependency with the "projectskill" package.
+	ProjectSkillsInverseTable = "project_skills"
+	// ProjectSkillsColumn is the table column denoting the project_skills relation/edge.
+	ProjectSkillsColumn = "project_id"
 )
 
 // Columns holds all SQL columns for project fields.
 var Columns = []string{
 	FieldID,
+	FieldTenantID,
 	FieldName,
 	FieldDescription,
 	FieldStatus,
@@ -55,6 +113,7 @@ var Columns = []string{
 	FieldStartDate,
 	FieldEndDate,
 	FieldBudget,
+	FieldLeadID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -63,6 +122,9 @@ var (
 	// TeamMembersPrimaryKey and TeamMembersColumn2 are the table columns denoting the
 	// primary key for the team_members relation (M2M).
 	TeamMembersPrimaryKey = []string{"project_id", "employee_id"}
+	// RequiredSkillsPrimaryKey and RequiredSkillsColumn2 are the table columns denoting the
+	// primary key for the required_skills relation (M2M).
+	RequiredSkillsPrimaryKey = []string{"project_id", "skill_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,7 +137,19 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "gin-crud-api/internal/ent/runtime"
 var (
+	Hooks        [4]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID string
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// BudgetValidator is a validator for the "budget" field. It is called by the builders before save.
@@ -98,9 +172,11 @@ const DefaultStatus = StatusACTIVE
 
 // Status values.
 const (
+	StatusPLANNED   Status = "PLANNED"
 	StatusACTIVE    Status = "ACTIVE"
-	StatusCOMPLETED Status = "COMPLETED"
 	StatusON_HOLD   Status = "ON_HOLD"
+	StatusCOMPLETED Status = "COMPLETED"
+	StatusCANCELLED Status = "CANCELLED"
 )
 
 func (s Status) String() string {
@@ -110,7 +186,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusACTIVE, StatusCOMPLETED, StatusON_HOLD:
+	case StatusPLANNED, StatusACTIVE, StatusON_HOLD, StatusCOMPLETED, StatusCANCELLED:
 		return nil
 	default:
 		return fmt.Errorf("project: invalid enum value for status field: %q", s)
@@ -152,6 +228,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -187,6 +268,11 @@ func ByBudget(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBudget, opts...).ToFunc()
 }
 
+// ByLeadID orders the results by the lead_id field.
+func ByLeadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -210,6 +296,83 @@ func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByLeadField orders the results by lead field.
+func ByLeadField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMilestonesCount orders the results by milestones count.
+func ByMilestonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMilestonesStep(), opts...)
+	}
+}
+
+// ByMilestones orders the results by milestones terms.
+func ByMilestones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTasksCount orders the results by tasks count.
+func ByTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTasksStep(), opts...)
+	}
+}
+
+// ByTasks orders the results by tasks terms.
+func ByTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusChangesCount orders the results by status_changes count.
+func ByStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusChangesStep(), opts...)
+	}
+}
+
+// ByStatusChanges orders the results by status_changes terms.
+func ByStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequiredSkillsCount orders the results by required_skills count.
+func ByRequiredSkillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequiredSkillsStep(), opts...)
+	}
+}
+
+// ByRequiredSkills orders the results by required_skills terms.
+func ByRequiredSkills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequiredSkillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProjectSkillsCount orders the results by project_skills count.
+func ByProjectSkillsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProjectSkillsStep(), opts...)
+	}
+}
+
+// ByProjectSkills orders the results by project_skills terms.
+func ByProjectSkills(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectSkillsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTeamMembersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -217,3 +380,45 @@ func newTeamMembersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TeamMembersTable, TeamMembersPrimaryKey...),
 	)
 }
+func newLeadStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LeadTable, LeadColumn),
+	)
+}
+func newMilestonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestonesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+	)
+}
+func newTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+	)
+}
+func newStatusChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+	)
+}
+func newRequiredSkillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequiredSkillsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, RequiredSkillsTable, RequiredSkillsPrimaryKey...),
+	)
+}
+func newProjectSkillsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectSkillsInverseTable, ProjectSkillsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, ProjectSkillsTable, ProjectSkillsColumn),
+	)
+}
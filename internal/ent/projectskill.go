@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/skill"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProjectSkill is the model entity for the ProjectSkill schema.
+type ProjectSkill struct {
+	config `json:"-"`
+	// Foreign key reference to the project
+	ProjectID uuid.UUID `json:"project_id,omitempty"`
+	// Foreign key reference to the skill
+	SkillID uuid.UUID `json:"skill_id,omitempty"`
+	// Minimum proficiency level from 1 (beginner) to 5 (expert)
+	MinLevel int `json:"min_level,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectSkillQuery when eager-loading is set.
+	Edges        ProjectSkillEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectSkillEdges holds the relations/edges for other nodes in the graph.
+type ProjectSkillEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Skill holds the value of the skill edge.
+	Skill *Skill `json:"skill,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectSkillEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// SkillOrErr returns the Skill value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectSkillEdges) SkillOrErr() (*Skill, error) {
+	if e.Skill != nil {
+		return e.Skill, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: skill.Label}
+	}
+	return nil, &NotLoadedError{edge: "skill"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectSkill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projectskill.FieldMinLevel:
+			values[i] = new(sql.NullInt64)
+		case projectskill.FieldProjectID, projectskill.FieldSkillID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectSkill fields.
+func (_m *ProjectSkill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projectskill.FieldProjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				_m.ProjectID = *value
+			}
+		case projectskill.FieldSkillID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field skill_id", values[i])
+			} else if value != nil {
+				_m.SkillID = *value
+			}
+		case projectskill.FieldMinLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_level", values[i])
+			} else if value.Valid {
+				_m.MinLevel = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectSkill.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProjectSkill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ProjectSkill entity.
+func (_m *ProjectSkill) QueryProject() *ProjectQuery {
+	return NewProjectSkillClient(_m.config).QueryProject(_m)
+}
+
+// QuerySkill queries the "skill" edge of the ProjectSkill entity.
+func (_m *ProjectSkill) QuerySkill() *SkillQuery {
+	return NewProjectSkillClient(_m.config).QuerySkill(_m)
+}
+
+// Update returns a builder for updating this ProjectSkill.
+// Note that you need to call ProjectSkill.Unwrap() before calling this method if this ProjectSkill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProjectSkill) Update() *ProjectSkillUpdateOne {
+	return NewProjectSkillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProjectSkill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProjectSkill) Unwrap() *ProjectSkill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectSkill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProjectSkill) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectSkill(")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("skill_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkillID))
+	builder.WriteString(", ")
+	builder.WriteString("min_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinLevel))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProjectSkills is a parsable slice of ProjectSkill.
+type ProjectSkills []*ProjectSkill
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projectskill
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the projectskill type in the database.
+	Label = "project_skill"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldSkillID holds the string denoting the skill_id field in the database.
+	FieldSkillID = "skill_id"
+	// FieldMinLevel holds the string denoting the min_level field in the database.
+	FieldMinLevel = "min_level"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// EdgeSkill holds the string denoting the skill edge name in mutations.
+	EdgeSkill = "skill"
+	// ProjectFieldID holds the string denoting the ID field of the Project.
+	ProjectFieldID = "id"
+	// SkillFieldID holds the string denoting the ID field of the Skill.
+	SkillFieldID = "id"
+	// Table holds the table name of the projectskill in the database.
+	Table = "project_skills"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "project_skills"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+	// SkillTable is the table that holds the skill relation/edge.
+	SkillTable = "project_skills"
+	// SkillInverseTable is the table name for the Skill entity.
+	// It exists in this package in order to avoid circular dependency with the "skill" package.
+	SkillInverseTable = "skills"
+	// SkillColumn is the table column denoting the skill relation/edge.
+	SkillColumn = "skill_id"
+)
+
+// Columns holds all SQL columns for projectskill fields.
+var Columns = []string{
+	FieldProjectID,
+	FieldSkillID,
+	FieldMinLevel,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "gin-crud-api/internal/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultMinLevel holds the default value on creation for the "min_level" field.
+	DefaultMinLevel int
+	// MinLevelValidator is a validator for the "min_level" field. It is called by the builders before save.
+	MinLevelValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the ProjectSkill queries.
+type OrderOption func(*sql.Selector)
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// BySkillID orders the results by the skill_id field.
+func BySkillID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkillID, opts...).ToFunc()
+}
+
+// ByMinLevel orders the results by the min_level field.
+func ByMinLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinLevel, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySkillField orders the results by skill field.
+func BySkillField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSkillStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, ProjectColumn),
+		sqlgraph.To(ProjectInverseTable, ProjectFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ProjectTable, ProjectColumn),
+	)
+}
+func newSkillStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, SkillColumn),
+		sqlgraph.To(SkillInverseTable, SkillFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SkillTable, SkillColumn),
+	)
+}
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package projectskill
+
+import (
+	"gin-crud-api/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldProjectID, v))
+}
+
+// SkillID applies equality check predicate on the "skill_id" field. It's identical to SkillIDEQ.
+func SkillID(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldSkillID, v))
+}
+
+// MinLevel applies equality check predicate on the "min_level" field. It's identical to MinLevelEQ.
+func MinLevel(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldMinLevel, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// SkillIDEQ applies the EQ predicate on the "skill_id" field.
+func SkillIDEQ(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldSkillID, v))
+}
+
+// SkillIDNEQ applies the NEQ predicate on the "skill_id" field.
+func SkillIDNEQ(v uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNEQ(FieldSkillID, v))
+}
+
+// SkillIDIn applies the In predicate on the "skill_id" field.
+func SkillIDIn(vs ...uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldIn(FieldSkillID, vs...))
+}
+
+// SkillIDNotIn applies the NotIn predicate on the "skill_id" field.
+func SkillIDNotIn(vs ...uuid.UUID) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNotIn(FieldSkillID, vs...))
+}
+
+// MinLevelEQ applies the EQ predicate on the "min_level" field.
+func MinLevelEQ(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldEQ(FieldMinLevel, v))
+}
+
+// MinLevelNEQ applies the NEQ predicate on the "min_level" field.
+func MinLevelNEQ(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNEQ(FieldMinLevel, v))
+}
+
+// MinLevelIn applies the In predicate on the "min_level" field.
+func MinLevelIn(vs ...int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldIn(FieldMinLevel, vs...))
+}
+
+// MinLevelNotIn applies the NotIn predicate on the "min_level" field.
+func MinLevelNotIn(vs ...int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldNotIn(FieldMinLevel, vs...))
+}
+
+// MinLevelGT applies the GT predicate on the "min_level" field.
+func MinLevelGT(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldGT(FieldMinLevel, v))
+}
+
+// MinLevelGTE applies the GTE predicate on the "min_level" field.
+func MinLevelGTE(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldGTE(FieldMinLevel, v))
+}
+
+// MinLevelLT applies the LT predicate on the "min_level" field.
+func MinLevelLT(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldLT(FieldMinLevel, v))
+}
+
+// MinLevelLTE applies the LTE predicate on the "min_level" field.
+func MinLevelLTE(v int) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.FieldLTE(FieldMinLevel, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ProjectSkill {
+	return predicate.ProjectSkill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, ProjectColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ProjectSkill {
+	return predicate.ProjectSkill(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSkill applies the HasEdge predicate on the "skill" edge.
+func HasSkill() predicate.ProjectSkill {
+	return predicate.ProjectSkill(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, SkillColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, SkillTable, SkillColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSkillWith applies the HasEdge predicate on the "skill" edge with a given conditions (other predicates).
+func HasSkillWith(preds ...predicate.Skill) predicate.ProjectSkill {
+	return predicate.ProjectSkill(func(s *sql.Selector) {
+		step := newSkillStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProjectSkill) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProjectSkill) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProjectSkill) predicate.ProjectSkill {
+	return predicate.ProjectSkill(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/skill"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProjectSkillCreate is the builder for creating a ProjectSkill entity.
+type ProjectSkillCreate struct {
+	config
+	mutation *ProjectSkillMutation
+	hooks    []Hook
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *ProjectSkillCreate) SetProjectID(v uuid.UUID) *ProjectSkillCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_c *ProjectSkillCreate) SetSkillID(v uuid.UUID) *ProjectSkillCreate {
+	_c.mutation.SetSkillID(v)
+	return _c
+}
+
+// SetMinLevel sets the "min_level" field.
+func (_c *ProjectSkillCreate) SetMinLevel(v int) *ProjectSkillCreate {
+	_c.mutation.SetMinLevel(v)
+	return _c
+}
+
+// SetNillableMinLevel sets the "min_level" field if the given value is not nil.
+func (_c *ProjectSkillCreate) SetNillableMinLevel(v *int) *ProjectSkillCreate {
+	if v != nil {
+		_c.SetMinLevel(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *ProjectSkillCreate) SetProject(v *Project) *ProjectSkillCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_c *ProjectSkillCreate) SetSkill(v *Skill) *ProjectSkillCreate {
+	return _c.SetSkillID(v.ID)
+}
+
+// Mutation returns the ProjectSkillMutation object of the builder.
+func (_c *ProjectSkillCreate) Mutation() *ProjectSkillMutation {
+	return _c.mutation
+}
+
+// Save creates the ProjectSkill in the database.
+func (_c *ProjectSkillCreate) Save(ctx context.Context) (*ProjectSkill, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProjectSkillCreate) SaveX(ctx context.Context) *ProjectSkill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectSkillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectSkillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProjectSkillCreate) defaults() error {
+	if _, ok := _c.mutation.MinLevel(); !ok {
+		v := projectskill.DefaultMinLevel
+		_c.mutation.SetMinLevel(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProjectSkillCreate) check() error {
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "ProjectSkill.project_id"`)}
+	}
+	if _, ok := _c.mutation.SkillID(); !ok {
+		return &ValidationError{Name: "skill_id", err: errors.New(`ent: missing required field "ProjectSkill.skill_id"`)}
+	}
+	if _, ok := _c.mutation.MinLevel(); !ok {
+		return &ValidationError{Name: "min_level", err: errors.New(`ent: missing required field "ProjectSkill.min_level"`)}
+	}
+	if v, ok := _c.mutation.MinLevel(); ok {
+		if err := projectskill.MinLevelValidator(v); err != nil {
+			return &ValidationError{Name: "min_level", err: fmt.Errorf(`ent: validator failed for field "ProjectSkill.min_level": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "ProjectSkill.project"`)}
+	}
+	if len(_c.mutation.SkillIDs()) == 0 {
+		return &ValidationError{Name: "skill", err: errors.New(`ent: missing required edge "ProjectSkill.skill"`)}
+	}
+	return nil
+}
+
+func (_c *ProjectSkillCreate) sqlSave(ctx context.Context) (*ProjectSkill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *ProjectSkillCreate) createSpec() (*ProjectSkill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectSkill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(projectskill.Table, nil)
+	)
+	if value, ok := _c.mutation.MinLevel(); ok {
+		_spec.SetField(projectskill.FieldMinLevel, field.TypeInt, value)
+		_node.MinLevel = value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.ProjectTable,
+			Columns: []string{projectskill.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.SkillTable,
+			Columns: []string{projectskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SkillID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectSkillCreateBulk is the builder for creating many ProjectSkill entities in bulk.
+type ProjectSkillCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectSkillCreate
+}
+
+// Save creates the ProjectSkill entities in the database.
+func (_c *ProjectSkillCreateBulk) Save(ctx context.Context) ([]*ProjectSkill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProjectSkill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectSkillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProjectSkillCreateBulk) SaveX(ctx context.Context) []*ProjectSkill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectSkillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectSkillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
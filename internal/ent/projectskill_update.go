@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/skill"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProjectSkillUpdate is the builder for updating ProjectSkill entities.
+type ProjectSkillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectSkillMutation
+}
+
+// Where appends a list predicates to the ProjectSkillUpdate builder.
+func (_u *ProjectSkillUpdate) Where(ps ...predicate.ProjectSkill) *ProjectSkillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *ProjectSkillUpdate) SetProjectID(v uuid.UUID) *ProjectSkillUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *ProjectSkillUpdate) SetNillableProjectID(v *uuid.UUID) *ProjectSkillUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *ProjectSkillUpdate) SetSkillID(v uuid.UUID) *ProjectSkillUpdate {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *ProjectSkillUpdate) SetNillableSkillID(v *uuid.UUID) *ProjectSkillUpdate {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetMinLevel sets the "min_level" field.
+func (_u *ProjectSkillUpdate) SetMinLevel(v int) *ProjectSkillUpdate {
+	_u.mutation.ResetMinLevel()
+	_u.mutation.SetMinLevel(v)
+	return _u
+}
+
+// SetNillableMinLevel sets the "min_level" field if the given value is not nil.
+func (_u *ProjectSkillUpdate) SetNillableMinLevel(v *int) *ProjectSkillUpdate {
+	if v != nil {
+		_u.SetMinLevel(*v)
+	}
+	return _u
+}
+
+// AddMinLevel adds value to the "min_level" field.
+func (_u *ProjectSkillUpdate) AddMinLevel(v int) *ProjectSkillUpdate {
+	_u.mutation.AddMinLevel(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ProjectSkillUpdate) SetProject(v *Project) *ProjectSkillUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_u *ProjectSkillUpdate) SetSkill(v *Skill) *ProjectSkillUpdate {
+	return _u.SetSkillID(v.ID)
+}
+
+// Mutation returns the ProjectSkillMutation object of the builder.
+func (_u *ProjectSkillUpdate) Mutation() *ProjectSkillMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ProjectSkillUpdate) ClearProject() *ProjectSkillUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (_u *ProjectSkillUpdate) ClearSkill() *ProjectSkillUpdate {
+	_u.mutation.ClearSkill()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProjectSkillUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectSkillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProjectSkillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectSkillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProjectSkillUpdate) check() error {
+	if v, ok := _u.mutation.MinLevel(); ok {
+		if err := projectskill.MinLevelValidator(v); err != nil {
+			return &ValidationError{Name: "min_level", err: fmt.Errorf(`ent: validator failed for field "ProjectSkill.min_level": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectSkill.project"`)
+	}
+	if _u.mutation.SkillCleared() && len(_u.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectSkill.skill"`)
+	}
+	return nil
+}
+
+func (_u *ProjectSkillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectskill.Table, projectskill.Columns, sqlgraph.NewFieldSpec(projectskill.FieldProjectID, field.TypeUUID), sqlgraph.NewFieldSpec(projectskill.FieldSkillID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MinLevel(); ok {
+		_spec.SetField(projectskill.FieldMinLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinLevel(); ok {
+		_spec.AddField(projectskill.FieldMinLevel, field.TypeInt, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.ProjectTable,
+			Columns: []string{projectskill.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.ProjectTable,
+			Columns: []string{projectskill.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.SkillTable,
+			Columns: []string{projectskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.SkillTable,
+			Columns: []string{projectskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProjectSkillUpdateOne is the builder for updating a single ProjectSkill entity.
+type ProjectSkillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectSkillMutation
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *ProjectSkillUpdateOne) SetProjectID(v uuid.UUID) *ProjectSkillUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *ProjectSkillUpdateOne) SetNillableProjectID(v *uuid.UUID) *ProjectSkillUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetSkillID sets the "skill_id" field.
+func (_u *ProjectSkillUpdateOne) SetSkillID(v uuid.UUID) *ProjectSkillUpdateOne {
+	_u.mutation.SetSkillID(v)
+	return _u
+}
+
+// SetNillableSkillID sets the "skill_id" field if the given value is not nil.
+func (_u *ProjectSkillUpdateOne) SetNillableSkillID(v *uuid.UUID) *ProjectSkillUpdateOne {
+	if v != nil {
+		_u.SetSkillID(*v)
+	}
+	return _u
+}
+
+// SetMinLevel sets the "min_level" field.
+func (_u *ProjectSkillUpdateOne) SetMinLevel(v int) *ProjectSkillUpdateOne {
+	_u.mutation.ResetMinLevel()
+	_u.mutation.SetMinLevel(v)
+	return _u
+}
+
+// SetNillableMinLevel sets the "min_level" field if the given value is not nil.
+func (_u *ProjectSkillUpdateOne) SetNillableMinLevel(v *int) *ProjectSkillUpdateOne {
+	if v != nil {
+		_u.SetMinLevel(*v)
+	}
+	return _u
+}
+
+// AddMinLevel adds value to the "min_level" field.
+func (_u *ProjectSkillUpdateOne) AddMinLevel(v int) *ProjectSkillUpdateOne {
+	_u.mutation.AddMinLevel(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ProjectSkillUpdateOne) SetProject(v *Project) *ProjectSkillUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// SetSkill sets the "skill" edge to the Skill entity.
+func (_u *ProjectSkillUpdateOne) SetSkill(v *Skill) *ProjectSkillUpdateOne {
+	return _u.SetSkillID(v.ID)
+}
+
+// Mutation returns the ProjectSkillMutation object of the builder.
+func (_u *ProjectSkillUpdateOne) Mutation() *ProjectSkillMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ProjectSkillUpdateOne) ClearProject() *ProjectSkillUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearSkill clears the "skill" edge to the Skill entity.
+func (_u *ProjectSkillUpdateOne) ClearSkill() *ProjectSkillUpdateOne {
+	_u.mutation.ClearSkill()
+	return _u
+}
+
+// Where appends a list predicates to the ProjectSkillUpdate builder.
+func (_u *ProjectSkillUpdateOne) Where(ps ...predicate.ProjectSkill) *ProjectSkillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProjectSkillUpdateOne) Select(field string, fields ...string) *ProjectSkillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProjectSkill entity.
+func (_u *ProjectSkillUpdateOne) Save(ctx context.Context) (*ProjectSkill, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectSkillUpdateOne) SaveX(ctx context.Context) *ProjectSkill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProjectSkillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectSkillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProjectSkillUpdateOne) check() error {
+	if v, ok := _u.mutation.MinLevel(); ok {
+		if err := projectskill.MinLevelValidator(v); err != nil {
+			return &ValidationError{Name: "min_level", err: fmt.Errorf(`ent: validator failed for field "ProjectSkill.min_level": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectSkill.project"`)
+	}
+	if _u.mutation.SkillCleared() && len(_u.mutation.SkillIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectSkill.skill"`)
+	}
+	return nil
+}
+
+func (_u *ProjectSkillUpdateOne) sqlSave(ctx context.Context) (_node *ProjectSkill, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectskill.Table, projectskill.Columns, sqlgraph.NewFieldSpec(projectskill.FieldProjectID, field.TypeUUID), sqlgraph.NewFieldSpec(projectskill.FieldSkillID, field.TypeUUID))
+	if id, ok := _u.mutation.ProjectID(); !ok {
+		return nil, &ValidationError{Name: "project_id", err: errors.New(`ent: missing "ProjectSkill.project_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.SkillID(); !ok {
+		return nil, &ValidationError{Name: "skill_id", err: errors.New(`ent: missing "ProjectSkill.skill_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !projectskill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MinLevel(); ok {
+		_spec.SetField(projectskill.FieldMinLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinLevel(); ok {
+		_spec.AddField(projectskill.FieldMinLevel, field.TypeInt, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.ProjectTable,
+			Columns: []string{projectskill.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.ProjectTable,
+			Columns: []string{projectskill.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SkillCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.SkillTable,
+			Columns: []string{projectskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SkillIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   projectskill.SkillTable,
+			Columns: []string{projectskill.SkillColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(skill.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProjectSkill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectskill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProjectStatusChange is the model entity for the ProjectStatusChange schema.
+type ProjectStatusChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the status change
+	ID uuid.UUID `json:"id,omitempty"`
+	// Foreign key reference to the project
+	ProjectID uuid.UUID `json:"project_id,omitempty"`
+	// Status before the transition
+	FromStatus projectstatuschange.FromStatus `json:"from_status,omitempty"`
+	// Status after the transition
+	ToStatus projectstatuschange.ToStatus `json:"to_status,omitempty"`
+	// Reason given for the transition
+	Reason string `json:"reason,omitempty"`
+	// Principal that made the transition
+	ChangedBy string `json:"changed_by,omitempty"`
+	// Timestamp of the transition
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectStatusChangeQuery when eager-loading is set.
+	Edges        ProjectStatusChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectStatusChangeEdges holds the relations/edges for other nodes in the graph.
+type ProjectStatusChangeEdges struct {
+	// The project whose status changed
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectStatusChangeEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectStatusChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projectstatuschange.FieldFromStatus, projectstatuschange.FieldToStatus, projectstatuschange.FieldReason, projectstatuschange.FieldChangedBy:
+			values[i] = new(sql.NullString)
+		case projectstatuschange.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		case projectstatuschange.FieldID, projectstatuschange.FieldProjectID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectStatusChange fields.
+func (_m *ProjectStatusChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projectstatuschange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case projectstatuschange.FieldProjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				_m.ProjectID = *value
+			}
+		case projectstatuschange.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = projectstatuschange.FromStatus(value.String)
+			}
+		case projectstatuschange.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = projectstatuschange.ToStatus(value.String)
+			}
+		case projectstatuschange.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case projectstatuschange.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				_m.ChangedBy = value.String
+			}
+		case projectstatuschange.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectStatusChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProjectStatusChange) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ProjectStatusChange entity.
+func (_m *ProjectStatusChange) QueryProject() *ProjectQuery {
+	return NewProjectStatusChangeClient(_m.config).QueryProject(_m)
+}
+
+// Update returns a builder for updating this ProjectStatusChange.
+// Note that you need to call ProjectStatusChange.Unwrap() before calling this method if this ProjectStatusChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProjectStatusChange) Update() *ProjectStatusChangeUpdateOne {
+	return NewProjectStatusChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProjectStatusChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProjectStatusChange) Unwrap() *ProjectStatusChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectStatusChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProjectStatusChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectStatusChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromStatus))
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(_m.ChangedBy)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(_m.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProjectStatusChanges is a parsable slice of ProjectStatusChange.
+type ProjectStatusChanges []*ProjectStatusChange
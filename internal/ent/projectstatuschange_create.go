@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProjectStatusChangeCreate is the builder for creating a ProjectStatusChange entity.
+type ProjectStatusChangeCreate struct {
+	config
+	mutation *ProjectStatusChangeMutation
+	hooks    []Hook
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *ProjectStatusChangeCreate) SetProjectID(v uuid.UUID) *ProjectStatusChangeCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *ProjectStatusChangeCreate) SetFromStatus(v projectstatuschange.FromStatus) *ProjectStatusChangeCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *ProjectStatusChangeCreate) SetToStatus(v projectstatuschange.ToStatus) *ProjectStatusChangeCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ProjectStatusChangeCreate) SetReason(v string) *ProjectStatusChangeCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ProjectStatusChangeCreate) SetNillableReason(v *string) *ProjectStatusChangeCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *ProjectStatusChangeCreate) SetChangedBy(v string) *ProjectStatusChangeCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_c *ProjectStatusChangeCreate) SetNillableChangedBy(v *string) *ProjectStatusChangeCreate {
+	if v != nil {
+		_c.SetChangedBy(*v)
+	}
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *ProjectStatusChangeCreate) SetChangedAt(v time.Time) *ProjectStatusChangeCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *ProjectStatusChangeCreate) SetNillableChangedAt(v *time.Time) *ProjectStatusChangeCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProjectStatusChangeCreate) SetID(v uuid.UUID) *ProjectStatusChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ProjectStatusChangeCreate) SetNillableID(v *uuid.UUID) *ProjectStatusChangeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *ProjectStatusChangeCreate) SetProject(v *Project) *ProjectStatusChangeCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// Mutation returns the ProjectStatusChangeMutation object of the builder.
+func (_c *ProjectStatusChangeCreate) Mutation() *ProjectStatusChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the ProjectStatusChange in the database.
+func (_c *ProjectStatusChangeCreate) Save(ctx context.Context) (*ProjectStatusChange, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProjectStatusChangeCreate) SaveX(ctx context.Context) *ProjectStatusChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectStatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectStatusChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProjectStatusChangeCreate) defaults() error {
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		if projectstatuschange.DefaultChangedAt == nil {
+			return fmt.Errorf("ent: uninitialized projectstatuschange.DefaultChangedAt (forgotten import ent/runtime?)")
+		}
+		v := projectstatuschange.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if projectstatuschange.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized projectstatuschange.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := projectstatuschange.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProjectStatusChangeCreate) check() error {
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "ProjectStatusChange.project_id"`)}
+	}
+	if _, ok := _c.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "ProjectStatusChange.from_status"`)}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := projectstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ProjectStatusChange.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ProjectStatusChange.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := projectstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ProjectStatusChange.to_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "ProjectStatusChange.changed_at"`)}
+	}
+	if len(_c.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "ProjectStatusChange.project"`)}
+	}
+	return nil
+}
+
+func (_c *ProjectStatusChangeCreate) sqlSave(ctx context.Context) (*ProjectStatusChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProjectStatusChangeCreate) createSpec() (*ProjectStatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProjectStatusChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(projectstatuschange.Table, sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(projectstatuschange.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(projectstatuschange.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(projectstatuschange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(projectstatuschange.FieldChangedBy, field.TypeString, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(projectstatuschange.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   projectstatuschange.ProjectTable,
+			Columns: []string{projectstatuschange.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProjectStatusChangeCreateBulk is the builder for creating many ProjectStatusChange entities in bulk.
+type ProjectStatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ProjectStatusChangeCreate
+}
+
+// Save creates the ProjectStatusChange entities in the database.
+func (_c *ProjectStatusChangeCreateBulk) Save(ctx context.Context) ([]*ProjectStatusChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProjectStatusChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProjectStatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProjectStatusChangeCreateBulk) SaveX(ctx context.Context) []*ProjectStatusChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProjectStatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProjectStatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
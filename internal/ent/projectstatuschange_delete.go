@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/projectstatuschange"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectStatusChangeDelete is the builder for deleting a ProjectStatusChange entity.
+type ProjectStatusChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProjectStatusChangeMutation
+}
+
+// Where appends a list predicates to the ProjectStatusChangeDelete builder.
+func (_d *ProjectStatusChangeDelete) Where(ps ...predicate.ProjectStatusChange) *ProjectStatusChangeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ProjectStatusChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProjectStatusChangeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ProjectStatusChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(projectstatuschange.Table, sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ProjectStatusChangeDeleteOne is the builder for deleting a single ProjectStatusChange entity.
+type ProjectStatusChangeDeleteOne struct {
+	_d *ProjectStatusChangeDelete
+}
+
+// Where appends a list predicates to the ProjectStatusChangeDelete builder.
+func (_d *ProjectStatusChangeDeleteOne) Where(ps ...predicate.ProjectStatusChange) *ProjectStatusChangeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ProjectStatusChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{projectstatuschange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProjectStatusChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/ent/predicate"
+	"gin-crud-api/internal/ent/projectstatuschange"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProjectStatusChangeUpdate is the builder for updating ProjectStatusChange entities.
+type ProjectStatusChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProjectStatusChangeMutation
+}
+
+// Where appends a list predicates to the ProjectStatusChangeUpdate builder.
+func (_u *ProjectStatusChangeUpdate) Where(ps ...predicate.ProjectStatusChange) *ProjectStatusChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ProjectStatusChangeMutation object of the builder.
+func (_u *ProjectStatusChangeUpdate) Mutation() *ProjectStatusChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProjectStatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectStatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProjectStatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectStatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProjectStatusChangeUpdate) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectStatusChange.project"`)
+	}
+	return nil
+}
+
+func (_u *ProjectStatusChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectstatuschange.Table, projectstatuschange.Columns, sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(projectstatuschange.FieldReason, field.TypeString)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(projectstatuschange.FieldChangedBy, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProjectStatusChangeUpdateOne is the builder for updating a single ProjectStatusChange entity.
+type ProjectStatusChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectStatusChangeMutation
+}
+
+// Mutation returns the ProjectStatusChangeMutation object of the builder.
+func (_u *ProjectStatusChangeUpdateOne) Mutation() *ProjectStatusChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProjectStatusChangeUpdate builder.
+func (_u *ProjectStatusChangeUpdateOne) Where(ps ...predicate.ProjectStatusChange) *ProjectStatusChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProjectStatusChangeUpdateOne) Select(field string, fields ...string) *ProjectStatusChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProjectStatusChange entity.
+func (_u *ProjectStatusChangeUpdateOne) Save(ctx context.Context) (*ProjectStatusChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectStatusChangeUpdateOne) SaveX(ctx context.Context) *ProjectStatusChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProjectStatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectStatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProjectStatusChangeUpdateOne) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProjectStatusChange.project"`)
+	}
+	return nil
+}
+
+func (_u *ProjectStatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *ProjectStatusChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(projectstatuschange.Table, projectstatuschange.Columns, sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProjectStatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, projectstatuschange.FieldID)
+		for _, f := range fields {
+			if !projectstatuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != projectstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(projectstatuschange.FieldReason, field.TypeString)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(projectstatuschange.FieldChangedBy, field.TypeString)
+	}
+	_node = &ProjectStatusChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{projectstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -2,86 +2,4 @@
 
 package ent
 
-import (
-	"gin-crud-api/internal/ent/department"
-	"gin-crud-api/internal/ent/employee"
-	"gin-crud-api/internal/ent/project"
-	"gin-crud-api/internal/ent/schema"
-	"time"
-
-	"github.com/google/uuid"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	departmentFields := schema.Department{}.Fields()
-	_ = departmentFields
-	// departmentDescName is the schema descriptor for name field.
-	departmentDescName := departmentFields[1].Descriptor()
-	// department.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	department.NameValidator = departmentDescName.Validators[0].(func(string) error)
-	// departmentDescCreatedAt is the schema descriptor for created_at field.
-	departmentDescCreatedAt := departmentFields[2].Descriptor()
-	// department.DefaultCreatedAt holds the default value on creation for the created_at field.
-	department.DefaultCreatedAt = departmentDescCreatedAt.Default.(func() time.Time)
-	// departmentDescUpdatedAt is the schema descriptor for updated_at field.
-	departmentDescUpdatedAt := departmentFields[3].Descriptor()
-	// department.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	department.DefaultUpdatedAt = departmentDescUpdatedAt.Default.(func() time.Time)
-	// department.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	department.UpdateDefaultUpdatedAt = departmentDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// departmentDescID is the schema descriptor for id field.
-	departmentDescID := departmentFields[0].Descriptor()
-	// department.DefaultID holds the default value on creation for the id field.
-	department.DefaultID = departmentDescID.Default.(func() uuid.UUID)
-	employeeFields := schema.Employee{}.Fields()
-	_ = employeeFields
-	// employeeDescName is the schema descriptor for name field.
-	employeeDescName := employeeFields[1].Descriptor()
-	// employee.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	employee.NameValidator = employeeDescName.Validators[0].(func(string) error)
-	// employeeDescEmail is the schema descriptor for email field.
-	employeeDescEmail := employeeFields[2].Descriptor()
-	// employee.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	employee.EmailValidator = employeeDescEmail.Validators[0].(func(string) error)
-	// employeeDescCreatedAt is the schema descriptor for created_at field.
-	employeeDescCreatedAt := employeeFields[4].Descriptor()
-	// employee.DefaultCreatedAt holds the default value on creation for the created_at field.
-	employee.DefaultCreatedAt = employeeDescCreatedAt.Default.(func() time.Time)
-	// employeeDescUpdatedAt is the schema descriptor for updated_at field.
-	employeeDescUpdatedAt := employeeFields[5].Descriptor()
-	// employee.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	employee.DefaultUpdatedAt = employeeDescUpdatedAt.Default.(func() time.Time)
-	// employee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	employee.UpdateDefaultUpdatedAt = employeeDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// employeeDescID is the schema descriptor for id field.
-	employeeDescID := employeeFields[0].Descriptor()
-	// employee.DefaultID holds the default value on creation for the id field.
-	employee.DefaultID = employeeDescID.Default.(func() uuid.UUID)
-	projectFields := schema.Project{}.Fields()
-	_ = projectFields
-	// projectDescName is the schema descriptor for name field.
-	projectDescName := projectFields[1].Descriptor()
-	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	project.NameValidator = projectDescName.Validators[0].(func(string) error)
-	// projectDescBudget is the schema descriptor for budget field.
-	projectDescBudget := projectFields[7].Descriptor()
-	// project.BudgetValidator is a validator for the "budget" field. It is called by the builders before save.
-	project.BudgetValidator = projectDescBudget.Validators[0].(func(float64) error)
-	// projectDescCreatedAt is the schema descriptor for created_at field.
-	projectDescCreatedAt := projectFields[8].Descriptor()
-	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
-	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
-	// projectDescUpdatedAt is the schema descriptor for updated_at field.
-	projectDescUpdatedAt := projectFields[9].Descriptor()
-	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
-	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// projectDescID is the schema descriptor for id field.
-	projectDescID := projectFields[0].Descriptor()
-	// project.DefaultID holds the default value on creation for the id field.
-	project.DefaultID = projectDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in gin-crud-api/internal/ent/runtime/runtime.go
@@ -2,7 +2,474 @@
 
 package runtime
 
-// The schema-stitching logic is generated in gin-crud-api/internal/ent/runtime.go
+import (
+	"context"
+	"gin-crud-api/internal/ent/department"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/employeeskill"
+	"gin-crud-api/internal/ent/idempotencykey"
+	"gin-crud-api/internal/ent/milestone"
+	"gin-crud-api/internal/ent/outboxevent"
+	"gin-crud-api/internal/ent/outboxoffset"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectskill"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"gin-crud-api/internal/ent/schema"
+	"gin-crud-api/internal/ent/skill"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/ent/webhookdelivery"
+	"gin-crud-api/internal/ent/webhooksubscription"
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	departmentMixin := schema.Department{}.Mixin()
+	department.Policy = privacy.NewPolicies(schema.Department{})
+	department.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := department.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	departmentMixinHooks0 := departmentMixin[0].Hooks()
+
+	department.Hooks[1] = departmentMixinHooks0[0]
+
+	department.Hooks[2] = departmentMixinHooks0[1]
+	departmentMixinInters0 := departmentMixin[0].Interceptors()
+	department.Interceptors[0] = departmentMixinInters0[0]
+	departmentMixinFields0 := departmentMixin[0].Fields()
+	_ = departmentMixinFields0
+	departmentFields := schema.Department{}.Fields()
+	_ = departmentFields
+	// departmentDescTenantID is the schema descriptor for tenant_id field.
+	departmentDescTenantID := departmentMixinFields0[0].Descriptor()
+	// department.DefaultTenantID holds the default value on creation for the tenant_id field.
+	department.DefaultTenantID = departmentDescTenantID.Default.(string)
+	// department.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	department.TenantIDValidator = departmentDescTenantID.Validators[0].(func(string) error)
+	// departmentDescName is the schema descriptor for name field.
+	departmentDescName := departmentFields[1].Descriptor()
+	// department.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	department.NameValidator = departmentDescName.Validators[0].(func(string) error)
+	// departmentDescCreatedAt is the schema descriptor for created_at field.
+	departmentDescCreatedAt := departmentFields[3].Descriptor()
+	// department.DefaultCreatedAt holds the default value on creation for the created_at field.
+	department.DefaultCreatedAt = departmentDescCreatedAt.Default.(func() time.Time)
+	// departmentDescUpdatedAt is the schema descriptor for updated_at field.
+	departmentDescUpdatedAt := departmentFields[4].Descriptor()
+	// department.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	department.DefaultUpdatedAt = departmentDescUpdatedAt.Default.(func() time.Time)
+	// department.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	department.UpdateDefaultUpdatedAt = departmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// departmentDescID is the schema descriptor for id field.
+	departmentDescID := departmentFields[0].Descriptor()
+	// department.DefaultID holds the default value on creation for the id field.
+	department.DefaultID = departmentDescID.Default.(func() uuid.UUID)
+	employeeMixin := schema.Employee{}.Mixin()
+	employee.Policy = privacy.NewPolicies(schema.Employee{})
+	employee.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := employee.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	employeeMixinHooks0 := employeeMixin[0].Hooks()
+
+	employee.Hooks[1] = employeeMixinHooks0[0]
+
+	employee.Hooks[2] = employeeMixinHooks0[1]
+	employeeMixinInters0 := employeeMixin[0].Interceptors()
+	employee.Interceptors[0] = employeeMixinInters0[0]
+	employeeMixinFields0 := employeeMixin[0].Fields()
+	_ = employeeMixinFields0
+	employeeFields := schema.Employee{}.Fields()
+	_ = employeeFields
+	// employeeDescTenantID is the schema descriptor for tenant_id field.
+	employeeDescTenantID := employeeMixinFields0[0].Descriptor()
+	// employee.DefaultTenantID holds the default value on creation for the tenant_id field.
+	employee.DefaultTenantID = employeeDescTenantID.Default.(string)
+	// employee.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	employee.TenantIDValidator = employeeDescTenantID.Validators[0].(func(string) error)
+	// employeeDescName is the schema descriptor for name field.
+	employeeDescName := employeeFields[1].Descriptor()
+	// employee.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	employee.NameValidator = employeeDescName.Validators[0].(func(string) error)
+	// employeeDescEmail is the schema descriptor for email field.
+	employeeDescEmail := employeeFields[2].Descriptor()
+	// employee.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	employee.EmailValidator = employeeDescEmail.Validators[0].(func(string) error)
+	// employeeDescCreatedAt is the schema descriptor for created_at field.
+	employeeDescCreatedAt := employeeFields[11].Descriptor()
+	// employee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	employee.DefaultCreatedAt = employeeDescCreatedAt.Default.(func() time.Time)
+	// employeeDescUpdatedAt is the schema descriptor for updated_at field.
+	employeeDescUpdatedAt := employeeFields[12].Descriptor()
+	// employee.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	employee.DefaultUpdatedAt = employeeDescUpdatedAt.Default.(func() time.Time)
+	// employee.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	employee.UpdateDefaultUpdatedAt = employeeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// employeeDescID is the schema descriptor for id field.
+	employeeDescID := employeeFields[0].Descriptor()
+	// employee.DefaultID holds the default value on creation for the id field.
+	employee.DefaultID = employeeDescID.Default.(func() uuid.UUID)
+	employeeskillMixin := schema.EmployeeSkill{}.Mixin()
+	employeeskillMixinHooks0 := employeeskillMixin[0].Hooks()
+	employeeskill.Hooks[0] = employeeskillMixinHooks0[0]
+	employeeskillMixinInters0 := employeeskillMixin[0].Interceptors()
+	employeeskill.Interceptors[0] = employeeskillMixinInters0[0]
+	employeeskillFields := schema.EmployeeSkill{}.Fields()
+	_ = employeeskillFields
+	// employeeskillDescLevel is the schema descriptor for level field.
+	employeeskillDescLevel := employeeskillFields[2].Descriptor()
+	// employeeskill.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	employeeskill.LevelValidator = employeeskillDescLevel.Validators[0].(func(int) error)
+	// employeeskillDescUpdatedAt is the schema descriptor for updated_at field.
+	employeeskillDescUpdatedAt := employeeskillFields[3].Descriptor()
+	// employeeskill.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	employeeskill.DefaultUpdatedAt = employeeskillDescUpdatedAt.Default.(func() time.Time)
+	// employeeskill.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	employeeskill.UpdateDefaultUpdatedAt = employeeskillDescUpdatedAt.UpdateDefault.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescPrincipal is the schema descriptor for principal field.
+	idempotencykeyDescPrincipal := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.PrincipalValidator is a validator for the "principal" field. It is called by the builders before save.
+	idempotencykey.PrincipalValidator = idempotencykeyDescPrincipal.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescOperation is the schema descriptor for operation field.
+	idempotencykeyDescOperation := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	idempotencykey.OperationValidator = idempotencykeyDescOperation.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	milestoneMixin := schema.Milestone{}.Mixin()
+	milestone.Policy = privacy.NewPolicies(schema.Milestone{})
+	milestone.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := milestone.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	milestoneMixinHooks0 := milestoneMixin[0].Hooks()
+
+	milestone.Hooks[1] = milestoneMixinHooks0[0]
+	milestoneMixinInters0 := milestoneMixin[0].Interceptors()
+	milestone.Interceptors[0] = milestoneMixinInters0[0]
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescTitle is the schema descriptor for title field.
+	milestoneDescTitle := milestoneFields[1].Descriptor()
+	// milestone.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	milestone.TitleValidator = milestoneDescTitle.Validators[0].(func(string) error)
+	// milestoneDescCreatedAt is the schema descriptor for created_at field.
+	milestoneDescCreatedAt := milestoneFields[5].Descriptor()
+	// milestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	milestone.DefaultCreatedAt = milestoneDescCreatedAt.Default.(func() time.Time)
+	// milestoneDescUpdatedAt is the schema descriptor for updated_at field.
+	milestoneDescUpdatedAt := milestoneFields[6].Descriptor()
+	// milestone.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	milestone.DefaultUpdatedAt = milestoneDescUpdatedAt.Default.(func() time.Time)
+	// milestone.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	milestone.UpdateDefaultUpdatedAt = milestoneDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// milestoneDescID is the schema descriptor for id field.
+	milestoneDescID := milestoneFields[0].Descriptor()
+	// milestone.DefaultID holds the default value on creation for the id field.
+	milestone.DefaultID = milestoneDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescEventID is the schema descriptor for event_id field.
+	outboxeventDescEventID := outboxeventFields[1].Descriptor()
+	// outboxevent.DefaultEventID holds the default value on creation for the event_id field.
+	outboxevent.DefaultEventID = outboxeventDescEventID.Default.(func() uuid.UUID)
+	// outboxeventDescType is the schema descriptor for type field.
+	outboxeventDescType := outboxeventFields[2].Descriptor()
+	// outboxevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	outboxevent.TypeValidator = outboxeventDescType.Validators[0].(func(string) error)
+	// outboxeventDescTenantID is the schema descriptor for tenant_id field.
+	outboxeventDescTenantID := outboxeventFields[3].Descriptor()
+	// outboxevent.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	outboxevent.TenantIDValidator = outboxeventDescTenantID.Validators[0].(func(string) error)
+	// outboxeventDescOccurredAt is the schema descriptor for occurred_at field.
+	outboxeventDescOccurredAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	outboxevent.DefaultOccurredAt = outboxeventDescOccurredAt.Default.(func() time.Time)
+	outboxoffsetFields := schema.OutboxOffset{}.Fields()
+	_ = outboxoffsetFields
+	// outboxoffsetDescPosition is the schema descriptor for position field.
+	outboxoffsetDescPosition := outboxoffsetFields[1].Descriptor()
+	// outboxoffset.DefaultPosition holds the default value on creation for the position field.
+	outboxoffset.DefaultPosition = outboxoffsetDescPosition.Default.(int64)
+	// outboxoffset.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	outboxoffset.PositionValidator = outboxoffsetDescPosition.Validators[0].(func(int64) error)
+	// outboxoffsetDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxoffsetDescUpdatedAt := outboxoffsetFields[2].Descriptor()
+	// outboxoffset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxoffset.DefaultUpdatedAt = outboxoffsetDescUpdatedAt.Default.(func() time.Time)
+	// outboxoffset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxoffset.UpdateDefaultUpdatedAt = outboxoffsetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxoffsetDescID is the schema descriptor for id field.
+	outboxoffsetDescID := outboxoffsetFields[0].Descriptor()
+	// outboxoffset.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outboxoffset.IDValidator = outboxoffsetDescID.Validators[0].(func(string) error)
+	projectMixin := schema.Project{}.Mixin()
+	project.Policy = privacy.NewPolicies(schema.Project{})
+	project.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := project.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	projectMixinHooks0 := projectMixin[0].Hooks()
+	projectHooks := schema.Project{}.Hooks()
+
+	project.Hooks[1] = projectMixinHooks0[0]
+
+	project.Hooks[2] = projectMixinHooks0[1]
+
+	project.Hooks[3] = projectHooks[0]
+	projectMixinInters0 := projectMixin[0].Interceptors()
+	project.Interceptors[0] = projectMixinInters0[0]
+	projectMixinFields0 := projectMixin[0].Fields()
+	_ = projectMixinFields0
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescTenantID is the schema descriptor for tenant_id field.
+	projectDescTenantID := projectMixinFields0[0].Descriptor()
+	// project.DefaultTenantID holds the default value on creation for the tenant_id field.
+	project.DefaultTenantID = projectDescTenantID.Default.(string)
+	// project.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	project.TenantIDValidator = projectDescTenantID.Validators[0].(func(string) error)
+	// projectDescName is the schema descriptor for name field.
+	projectDescName := projectFields[1].Descriptor()
+	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	project.NameValidator = projectDescName.Validators[0].(func(string) error)
+	// projectDescBudget is the schema descriptor for budget field.
+	projectDescBudget := projectFields[7].Descriptor()
+	// project.BudgetValidator is a validator for the "budget" field. It is called by the builders before save.
+	project.BudgetValidator = projectDescBudget.Validators[0].(func(float64) error)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[9].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[10].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// projectDescID is the schema descriptor for id field.
+	projectDescID := projectFields[0].Descriptor()
+	// project.DefaultID holds the default value on creation for the id field.
+	project.DefaultID = projectDescID.Default.(func() uuid.UUID)
+	projectskillMixin := schema.ProjectSkill{}.Mixin()
+	projectskillMixinHooks0 := projectskillMixin[0].Hooks()
+	projectskill.Hooks[0] = projectskillMixinHooks0[0]
+	projectskillMixinInters0 := projectskillMixin[0].Interceptors()
+	projectskill.Interceptors[0] = projectskillMixinInters0[0]
+	projectskillFields := schema.ProjectSkill{}.Fields()
+	_ = projectskillFields
+	// projectskillDescMinLevel is the schema descriptor for min_level field.
+	projectskillDescMinLevel := projectskillFields[2].Descriptor()
+	// projectskill.DefaultMinLevel holds the default value on creation for the min_level field.
+	projectskill.DefaultMinLevel = projectskillDescMinLevel.Default.(int)
+	// projectskill.MinLevelValidator is a validator for the "min_level" field. It is called by the builders before save.
+	projectskill.MinLevelValidator = projectskillDescMinLevel.Validators[0].(func(int) error)
+	projectstatuschangeMixin := schema.ProjectStatusChange{}.Mixin()
+	projectstatuschangeMixinHooks0 := projectstatuschangeMixin[0].Hooks()
+	projectstatuschange.Hooks[0] = projectstatuschangeMixinHooks0[0]
+	projectstatuschangeMixinInters0 := projectstatuschangeMixin[0].Interceptors()
+	projectstatuschange.Interceptors[0] = projectstatuschangeMixinInters0[0]
+	projectstatuschangeFields := schema.ProjectStatusChange{}.Fields()
+	_ = projectstatuschangeFields
+	// projectstatuschangeDescChangedAt is the schema descriptor for changed_at field.
+	projectstatuschangeDescChangedAt := projectstatuschangeFields[6].Descriptor()
+	// projectstatuschange.DefaultChangedAt holds the default value on creation for the changed_at field.
+	projectstatuschange.DefaultChangedAt = projectstatuschangeDescChangedAt.Default.(func() time.Time)
+	// projectstatuschangeDescID is the schema descriptor for id field.
+	projectstatuschangeDescID := projectstatuschangeFields[0].Descriptor()
+	// projectstatuschange.DefaultID holds the default value on creation for the id field.
+	projectstatuschange.DefaultID = projectstatuschangeDescID.Default.(func() uuid.UUID)
+	skillMixin := schema.Skill{}.Mixin()
+	skillMixinHooks0 := skillMixin[0].Hooks()
+	skill.Hooks[0] = skillMixinHooks0[0]
+	skill.Hooks[1] = skillMixinHooks0[1]
+	skillMixinInters0 := skillMixin[0].Interceptors()
+	skill.Interceptors[0] = skillMixinInters0[0]
+	skillMixinFields0 := skillMixin[0].Fields()
+	_ = skillMixinFields0
+	skillFields := schema.Skill{}.Fields()
+	_ = skillFields
+	// skillDescTenantID is the schema descriptor for tenant_id field.
+	skillDescTenantID := skillMixinFields0[0].Descriptor()
+	// skill.DefaultTenantID holds the default value on creation for the tenant_id field.
+	skill.DefaultTenantID = skillDescTenantID.Default.(string)
+	// skill.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	skill.TenantIDValidator = skillDescTenantID.Validators[0].(func(string) error)
+	// skillDescName is the schema descriptor for name field.
+	skillDescName := skillFields[1].Descriptor()
+	// skill.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	skill.NameValidator = skillDescName.Validators[0].(func(string) error)
+	// skillDescCreatedAt is the schema descriptor for created_at field.
+	skillDescCreatedAt := skillFields[3].Descriptor()
+	// skill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	skill.DefaultCreatedAt = skillDescCreatedAt.Default.(func() time.Time)
+	// skillDescUpdatedAt is the schema descriptor for updated_at field.
+	skillDescUpdatedAt := skillFields[4].Descriptor()
+	// skill.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	skill.DefaultUpdatedAt = skillDescUpdatedAt.Default.(func() time.Time)
+	// skill.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	skill.UpdateDefaultUpdatedAt = skillDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// skillDescID is the schema descriptor for id field.
+	skillDescID := skillFields[0].Descriptor()
+	// skill.DefaultID holds the default value on creation for the id field.
+	skill.DefaultID = skillDescID.Default.(func() uuid.UUID)
+	taskMixin := schema.Task{}.Mixin()
+	task.Policy = privacy.NewPolicies(schema.Task{})
+	task.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := task.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskMixinHooks0 := taskMixin[0].Hooks()
+
+	task.Hooks[1] = taskMixinHooks0[0]
+	taskMixinInters0 := taskMixin[0].Interceptors()
+	task.Interceptors[0] = taskMixinInters0[0]
+	taskFields := schema.Task{}.Fields()
+	_ = taskFields
+	// taskDescTitle is the schema descriptor for title field.
+	taskDescTitle := taskFields[1].Descriptor()
+	// task.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	task.TitleValidator = taskDescTitle.Validators[0].(func(string) error)
+	// taskDescEstimateHours is the schema descriptor for estimate_hours field.
+	taskDescEstimateHours := taskFields[4].Descriptor()
+	// task.EstimateHoursValidator is a validator for the "estimate_hours" field. It is called by the builders before save.
+	task.EstimateHoursValidator = taskDescEstimateHours.Validators[0].(func(float64) error)
+	// taskDescCreatedAt is the schema descriptor for created_at field.
+	taskDescCreatedAt := taskFields[8].Descriptor()
+	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
+	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
+	// taskDescUpdatedAt is the schema descriptor for updated_at field.
+	taskDescUpdatedAt := taskFields[9].Descriptor()
+	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
+	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskDescID is the schema descriptor for id field.
+	taskDescID := taskFields[0].Descriptor()
+	// task.DefaultID holds the default value on creation for the id field.
+	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdeliveryMixinInters0 := webhookdeliveryMixin[0].Interceptors()
+	webhookdelivery.Interceptors[0] = webhookdeliveryMixinInters0[0]
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscriptionMixinHooks0 := webhooksubscriptionMixin[0].Hooks()
+	webhooksubscription.Hooks[0] = webhooksubscriptionMixinHooks0[0]
+	webhooksubscription.Hooks[1] = webhooksubscriptionMixinHooks0[1]
+	webhooksubscriptionMixinInters0 := webhooksubscriptionMixin[0].Interceptors()
+	webhooksubscription.Interceptors[0] = webhooksubscriptionMixinInters0[0]
+	webhooksubscriptionMixinFields0 := webhooksubscriptionMixin[0].Fields()
+	_ = webhooksubscriptionMixinFields0
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescTenantID is the schema descriptor for tenant_id field.
+	webhooksubscriptionDescTenantID := webhooksubscriptionMixinFields0[0].Descriptor()
+	// webhooksubscription.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhooksubscription.DefaultTenantID = webhooksubscriptionDescTenantID.Default.(string)
+	// webhooksubscription.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhooksubscription.TenantIDValidator = webhooksubscriptionDescTenantID.Validators[0].(func(string) error)
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = webhooksubscriptionDescURL.Validators[0].(func(string) error)
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescActive is the schema descriptor for active field.
+	webhooksubscriptionDescActive := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultActive holds the default value on creation for the active field.
+	webhooksubscription.DefaultActive = webhooksubscriptionDescActive.Default.(bool)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionFields[6].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.DefaultID holds the default value on creation for the id field.
+	webhooksubscription.DefaultID = webhooksubscriptionDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -3,9 +3,12 @@ package schema
 import (
 	"time"
 
+	"gin-crud-api/internal/ent/privacy"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -14,6 +17,14 @@ type Department struct {
 	ent.Schema
 }
 
+// Mixin of the Department.
+func (Department) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Every row belongs to one tenant
+		TenantMixin{},
+	}
+}
+
 // Fields of the Department.
 func (Department) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,6 +39,12 @@ func (Department) Fields() []ent.Field {
 			NotEmpty().
 			Comment("Name of the department"),
 
+		// Foreign key to the parent department (optional, top-level when unset)
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Foreign key reference to the parent department"),
+
 		// Timestamps for tracking creation and updates
 		field.Time("created_at").
 			Default(time.Now).
@@ -48,5 +65,39 @@ func (Department) Edges() []ent.Edge {
 		// This creates a one-to-many relationship
 		edge.To("employees", Employee.Type).
 			Comment("Employees belonging to this department"),
+
+		// Departments form a tree; a department's subtree is itself and all its descendants
+		edge.To("children", Department.Type).
+			Comment("Departments directly below this department"),
+
+		edge.From("parent", Department.Type).
+			Ref("children").
+			Field("parent_id").
+			Unique().
+			Comment("The department this department belongs to"),
+	}
+}
+
+// Indexes of the Department.
+func (Department) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index on parent_id for walking department subtrees
+		index.Fields("parent_id"),
+	}
+}
+
+// Policy of the Department: managers see and change only the departments of
+// their subtree and add departments only below them.
+func (Department) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			allowUnrestricted(),
+			filterManagedQuery("id"),
+		},
+		Mutation: privacy.MutationPolicy{
+			allowUnrestricted(),
+			filterManagedMutation("id"),
+			denyUnmanagedDepartment("parent_id", true),
+		},
 	}
 }
@@ -3,6 +3,9 @@ package schema
 import (
 	"time"
 
+	"gin-crud-api/internal/ent/privacy"
+	"gin-crud-api/internal/pii"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +18,14 @@ type Employee struct {
 	ent.Schema
 }
 
+// Mixin of the Employee.
+func (Employee) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Every row belongs to one tenant
+		TenantMixin{},
+	}
+}
+
 // Fields of the Employee.
 func (Employee) Fields() []ent.Field {
 	return []ent.Field{
@@ -29,16 +40,55 @@ func (Employee) Fields() []ent.Field {
 			NotEmpty().
 			Comment("Name of the employee"),
 
-		// Employee email - required and unique
+		// Employee email - required and unique within the tenant
 		field.String("email").
 			NotEmpty().
-			Unique().
-			Comment("Email address of the employee (must be unique)"),
+			Annotations(pii.Annotation{}).
+			Comment("Email address of the employee (unique per tenant)"),
 
 		// Foreign key to Department
 		field.UUID("department_id", uuid.UUID{}).
 			Comment("ID of the department this employee belongs to"),
 
+		// Job title - optional
+		field.String("job_title").
+			Optional().
+			Comment("Job title of the employee"),
+
+		// Employment type - enum field
+		field.Enum("employment_type").
+			Values("FULL_TIME", "CONTRACTOR", "INTERN").
+			Default("FULL_TIME").
+			Comment("Type of employment contract"),
+
+		// Employment status - changed to TERMINATED only by terminating the employee
+		field.Enum("status").
+			Values("ACTIVE", "ON_LEAVE", "TERMINATED").
+			Default("ACTIVE").
+			Comment("Current employment status"),
+
+		// Hire date - optional, reset when an employee is rehired
+		field.Time("hire_date").
+			Optional().
+			Nillable().
+			Comment("Date the employee was (last) hired"),
+
+		// Termination date - set while the employee is terminated
+		field.Time("termination_date").
+			Optional().
+			Nillable().
+			Comment("Date the employment ended"),
+
+		// Contact info - optional
+		field.String("phone").
+			Optional().
+			Comment("Phone number of the employee").
+			Annotations(pii.Annotation{}),
+
+		field.String("location").
+			Optional().
+			Comment("Office or city the employee works from"),
+
 		// Timestamps
 		field.Time("created_at").
 			Default(time.Now).
@@ -70,15 +120,46 @@ func (Employee) Edges() []ent.Edge {
 		edge.From("projects", Project.Type).
 			Ref("team_members").        // References the "team_members" edge in Project
 			Comment("Projects that this employee is working on"),
+
+		// One employee can be assigned many tasks (unassigned when the employee is deleted)
+		edge.To("assigned_tasks", Task.Type).
+			Comment("Tasks assigned to this employee"),
+
+		// One employee can lead many projects (lead unset when the employee is deleted)
+		edge.To("led_projects", Project.Type).
+			Comment("Projects this employee leads"),
+
+		// Many-to-many relationship with Skill through EmployeeSkill (proficiency level)
+		edge.To("skills", Skill.Type).
+			Through("employee_skills", EmployeeSkill.Type).
+			Comment("Skills of this employee"),
 	}
 }
 
 // Indexes of the Employee.
 func (Employee) Indexes() []ent.Index {
 	return []ent.Index{
-		// Index on email for faster lookups
-		index.Fields("email").Unique(),
+		// Email is unique per tenant; the index also serves lookups by email
+		index.Fields("tenant_id", "email").Unique(),
 		// Index on department_id for faster joins
 		index.Fields("department_id"),
+		// Index on status for filtering active employees
+		index.Fields("status"),
+	}
+}
+
+// Policy of the Employee: managers see and change only the employees of their
+// department subtree and cannot move anyone outside it.
+func (Employee) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			allowUnrestricted(),
+			filterManagedQuery("department_id"),
+		},
+		Mutation: privacy.MutationPolicy{
+			allowUnrestricted(),
+			filterManagedMutation("department_id"),
+			denyUnmanagedDepartment("department_id", true),
+		},
 	}
 }
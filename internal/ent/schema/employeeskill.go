@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmployeeSkill holds the schema definition for the EmployeeSkill edge entity.
+// It joins an employee to a skill with the employee's proficiency level.
+type EmployeeSkill struct {
+	ent.Schema
+}
+
+// Mixin of the EmployeeSkill.
+func (EmployeeSkill) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its employee
+		parentTenantMixin{column: "employee_id", parentTable: "employees"},
+	}
+}
+
+// Annotations of the EmployeeSkill.
+func (EmployeeSkill) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// Composite primary key: one level per employee and skill
+		field.ID("employee_id", "skill_id"),
+	}
+}
+
+// Fields of the EmployeeSkill.
+func (EmployeeSkill) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("employee_id", uuid.UUID{}).
+			Comment("Foreign key reference to the employee"),
+
+		field.UUID("skill_id", uuid.UUID{}).
+			Comment("Foreign key reference to the skill"),
+
+		// Proficiency from 1 (beginner) to 5 (expert)
+		field.Int("level").
+			Range(1, 5).
+			Comment("Proficiency level from 1 (beginner) to 5 (expert)"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the level was last set"),
+	}
+}
+
+// Edges of the EmployeeSkill.
+func (EmployeeSkill) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Rows are deleted with their employee or skill
+		edge.To("employee", Employee.Type).
+			Field("employee_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("skill", Skill.Type).
+			Field("skill_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
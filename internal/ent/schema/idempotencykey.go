@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// Each row records one mutation executed under a client-supplied key so a
+// retry with the same key returns the stored response instead of running again.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the idempotency record"),
+
+		// Caller that supplied the key (principal, or client IP when anonymous)
+		field.String("principal").
+			NotEmpty().
+			Immutable().
+			Comment("Caller that supplied the key"),
+
+		// Client-supplied key (Idempotency-Key header or clientMutationId)
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("Client-supplied idempotency key"),
+
+		// Response key of the mutation field, so one key can cover several fields of a request
+		field.String("operation").
+			NotEmpty().
+			Immutable().
+			Comment("Mutation field (alias) the key was used for"),
+
+		// Fingerprint of field, arguments and selection to detect key reuse
+		field.String("request_hash").
+			Immutable().
+			Comment("SHA-256 of the mutation field, arguments and selection"),
+
+		// Execution state
+		field.Enum("status").
+			Values("IN_PROGRESS", "COMPLETED").
+			Default("IN_PROGRESS").
+			Comment("Whether the mutation is still executing"),
+
+		// Marshaled field result replayed on retries
+		field.Bytes("response").
+			Optional().
+			Nillable().
+			Comment("JSON result of the mutation field"),
+
+		// Timestamps for tracking creation and expiry
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the key was first used"),
+
+		field.Time("expires_at").
+			Comment("Timestamp after which the key may be reused"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// A key is unique per caller and mutation field
+		index.Fields("principal", "key", "operation").
+			Unique(),
+		// Index on expiry for the cleanup job
+		index.Fields("expires_at"),
+	}
+}
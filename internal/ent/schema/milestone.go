@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"time"
+
+	"gin-crud-api/internal/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Milestone holds the schema definition for the Milestone entity.
+// Milestones group the tasks of a project around a due date.
+type Milestone struct {
+	ent.Schema
+}
+
+// Mixin of the Milestone.
+func (Milestone) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its project
+		parentTenantMixin{column: "project_id", parentTable: "projects"},
+	}
+}
+
+// Fields of the Milestone.
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the milestone"),
+
+		// Milestone title - required field
+		field.String("title").
+			NotEmpty().
+			Comment("Title of the milestone"),
+
+		// Due date - optional
+		field.Time("due_date").
+			Optional().
+			Nillable().
+			Comment("Date the milestone should be reached"),
+
+		// Milestone status - enum field
+		field.Enum("status").
+			Values("OPEN", "COMPLETED").
+			Default("OPEN").
+			Comment("Whether the milestone has been reached"),
+
+		// Foreign key to project (required)
+		field.UUID("project_id", uuid.UUID{}).
+			Immutable().
+			Comment("Foreign key reference to the project"),
+
+		// Timestamps for tracking creation and updates
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when milestone was created"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when milestone was last updated"),
+	}
+}
+
+// Edges of the Milestone.
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Many milestones belong to one project
+		edge.From("project", Project.Type).
+			Ref("milestones").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The project this milestone belongs to"),
+
+		// One milestone groups many tasks (tasks are kept when it is deleted)
+		edge.To("tasks", Task.Type).
+			Comment("Tasks scheduled for this milestone"),
+	}
+}
+
+// Indexes of the Milestone.
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index on project_id for listing a project's milestones
+		index.Fields("project_id"),
+	}
+}
+
+// Policy of the Milestone: every authenticated caller sees all milestones,
+// project leads change only those of the projects they lead.
+func (Milestone) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowUnrestricted(),
+			filterLedProjectRows(),
+		},
+		Query: privacy.QueryPolicy{
+			allowUnrestricted(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
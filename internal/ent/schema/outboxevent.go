@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// Every committed change of a department, employee or project appends one
+// domain event in the same transaction; the relay publishes them in ID order
+// and the webhook dispatcher queues deliveries from them.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - auto-increment sequence, the position consumers track
+		field.Int64("id").
+			Immutable().
+			Comment("Position of the event in the stream"),
+
+		// Stable identifier consumers deduplicate on
+		field.UUID("event_id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable().
+			Comment("Unique identifier of the event"),
+
+		// Event type, e.g. EmployeeHired
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("Type of the event"),
+
+		// Tenant of the changed entity; the relay publishes every tenant's events
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable().
+			Comment("Tenant of the changed entity"),
+
+		// Entity the event belongs to
+		field.Enum("aggregate_type").
+			Values("Department", "Employee", "Project").
+			Immutable().
+			Comment("Type of the changed entity"),
+
+		field.UUID("aggregate_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the changed entity"),
+
+		// JSON event data
+		field.Bytes("payload").
+			Immutable().
+			Comment("JSON data of the event"),
+
+		field.Time("occurred_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the change was made"),
+	}
+}
+
+// Edges of the OutboxEvent.
+func (OutboxEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Webhook deliveries the dispatcher queued for the event
+		edge.To("webhook_deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Webhook deliveries of this event"),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for replaying the events of one entity
+		index.Fields("aggregate_type", "aggregate_id"),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxOffset holds the schema definition for the OutboxOffset entity.
+// Each row is the position up to which a consumer has published the outbox.
+type OutboxOffset struct {
+	ent.Schema
+}
+
+// Fields of the OutboxOffset.
+func (OutboxOffset) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - consumer name
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			Comment("Name of the consumer"),
+
+		// ID of the last event the consumer has published
+		field.Int64("position").
+			NonNegative().
+			Default(0).
+			Comment("ID of the last published event"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when the offset was last committed"),
+	}
+}
@@ -0,0 +1,202 @@
+package schema
+
+import (
+	"context"
+
+	"gin-crud-api/internal/auth"
+	gen "gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/department"
+	"gin-crud-api/internal/ent/intercept"
+	"gin-crud-api/internal/ent/privacy"
+	"gin-crud-api/internal/ent/project"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Row-level access rules shared by the Department, Employee, Project,
+// Milestone and Task policies.
+// The viewer is the authenticated principal of the context; for managers and
+// project leads its ID is their employee ID. Contexts without a principal are
+// only allowed when marked as the system (auth disabled, background jobs);
+// anonymous requests to a server with optional authentication are denied.
+
+// allowUnrestricted allows admins and system contexts, denies other contexts
+// without a principal and skips everyone else
+func allowUnrestricted() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		p := auth.FromContext(ctx)
+		if p.HasRole(auth.RoleAdmin) || (p == nil && auth.IsSystem(ctx)) {
+			return privacy.Allow
+		}
+		if p == nil {
+			return privacy.Denyf("authentication required")
+		}
+		return privacy.Skip
+	})
+}
+
+// viewerEmployeeID returns the employee ID of a viewer holding role
+func viewerEmployeeID(ctx context.Context, role string) (uuid.UUID, bool, error) {
+	p := auth.FromContext(ctx)
+	if !p.HasRole(role) {
+		return uuid.Nil, false, nil
+	}
+	id, err := uuid.Parse(p.ID)
+	if err != nil {
+		return uuid.Nil, true, privacy.Denyf("%s %q is not an employee", role, p.ID)
+	}
+	return id, true, nil
+}
+
+// inManagedSubtree matches rows whose column holds a department of the
+// subtree headed by the manager's own department
+func inManagedSubtree(column string, managerID uuid.UUID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			// UNION (not UNION ALL) ends the recursion even if the tree has a cycle
+			b.WriteString(s.C(column)).
+				WriteString(" IN (WITH RECURSIVE subtree(id) AS (SELECT department_id FROM employees WHERE id = ").
+				Arg(managerID).
+				WriteString(" UNION SELECT departments.id FROM departments JOIN subtree ON departments.parent_id = subtree.id)").
+				WriteString(" SELECT id FROM subtree)")
+		}))
+	}
+}
+
+// filterManagedQuery limits the queries of managers to their department subtree
+func filterManagedQuery(column string) privacy.QueryRule {
+	return privacy.QueryRuleFunc(func(ctx context.Context, q ent.Query) error {
+		managerID, ok, err := viewerEmployeeID(ctx, auth.RoleManager)
+		if !ok || err != nil {
+			return privacyDecision(err)
+		}
+		w, err := intercept.NewQuery(q)
+		if err != nil {
+			return privacy.Denyf("%v", err)
+		}
+		w.WhereP(inManagedSubtree(column, managerID))
+		return privacy.Skip
+	})
+}
+
+// filterManagedMutation limits the updates and deletes of managers to their
+// department subtree, so rows outside it fail with not found
+func filterManagedMutation(column string) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		managerID, ok, err := viewerEmployeeID(ctx, auth.RoleManager)
+		if !ok || err != nil {
+			return privacyDecision(err)
+		}
+		w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+		w.WhereP(inManagedSubtree(column, managerID))
+		return privacy.Skip
+	})
+}
+
+// denyUnmanagedDepartment denies managers setting a department reference
+// (field) to a department outside their subtree. required also denies
+// creating rows without one, e.g. a top-level department.
+func denyUnmanagedDepartment(field string, required bool) privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		_, ok, err := viewerEmployeeID(ctx, auth.RoleManager)
+		if !ok || err != nil {
+			return privacyDecision(err)
+		}
+		value, set := m.Field(field)
+		deptID, _ := value.(uuid.UUID)
+		if !set || deptID == uuid.Nil {
+			if required && m.Op().Is(ent.OpCreate) {
+				return privacy.Denyf("managers can only add to departments they manage")
+			}
+			return privacy.Skip
+		}
+
+		// The department policy hides departments outside the subtree
+		client, ok := m.(interface{ Client() *gen.Client })
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+		visible, err := client.Client().Department.Query().Where(department.ID(deptID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !visible {
+			return privacy.Denyf("department %s is not managed by the viewer", deptID)
+		}
+		return privacy.Skip
+	})
+}
+
+// filterLedProjects limits the updates and deletes of project leads to the
+// projects they lead
+func filterLedProjects() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		if m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		leadID, ok, err := viewerEmployeeID(ctx, auth.RoleProjectLead)
+		if !ok || err != nil {
+			return privacyDecision(err)
+		}
+		w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+		w.WhereP(sql.FieldEQ("lead_id", leadID))
+		return privacy.Skip
+	})
+}
+
+// filterLedProjectRows limits the milestones and tasks project leads change
+// to those of the projects they lead: creates must name such a project, and
+// updates and deletes of other rows fail with not found
+func filterLedProjectRows() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		leadID, ok, err := viewerEmployeeID(ctx, auth.RoleProjectLead)
+		if !ok || err != nil {
+			return privacyDecision(err)
+		}
+		if m.Op().Is(ent.OpCreate) {
+			value, _ := m.Field("project_id")
+			projectID, _ := value.(uuid.UUID)
+			client, ok := m.(interface{ Client() *gen.Client })
+			if !ok {
+				return privacy.Denyf("unexpected mutation type %T", m)
+			}
+			led, err := client.Client().Project.Query().Where(project.ID(projectID), project.LeadID(leadID)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !led {
+				return privacy.Denyf("project %s is not led by the viewer", projectID)
+			}
+			return privacy.Skip
+		}
+		w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+		w.WhereP(func(s *sql.Selector) {
+			projects := sql.Table(project.Table)
+			s.Where(sql.In(s.C("project_id"),
+				sql.Select(projects.C(project.FieldID)).From(projects).Where(sql.EQ(projects.C(project.FieldLeadID), leadID))))
+		})
+		return privacy.Skip
+	})
+}
+
+// privacyDecision skips rules that do not apply and passes denials on
+func privacyDecision(err error) error {
+	if err != nil {
+		return err
+	}
+	return privacy.Skip
+}
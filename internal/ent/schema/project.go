@@ -1,9 +1,16 @@
 package schema
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	gen "gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/hook"
+	"gin-crud-api/internal/ent/privacy"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -15,6 +22,29 @@ type Project struct {
 	ent.Schema
 }
 
+// Mixin of the Project.
+func (Project) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Every row belongs to one tenant
+		TenantMixin{},
+	}
+}
+
+// projectStatuses are the values of Project.status and of the status history
+var projectStatuses = []string{"PLANNED", "ACTIVE", "ON_HOLD", "COMPLETED", "CANCELLED"}
+
+// ErrDirectStatusChange is returned when a project's status is updated
+// without going through the status workflow
+var ErrDirectStatusChange = errors.New("project status can only be changed through a status transition")
+
+type statusTransitionKey struct{}
+
+// WithStatusTransition marks ctx as applying a workflow transition, allowing
+// the update to change a project's status
+func WithStatusTransition(ctx context.Context) context.Context {
+	return context.WithValue(ctx, statusTransitionKey{}, true)
+}
+
 // Fields of the Project.
 func (Project) Fields() []ent.Field {
 	return []ent.Field{
@@ -34,9 +64,9 @@ func (Project) Fields() []ent.Field {
 			Optional().
 			Comment("Detailed description of the project"),
 
-		// Project status - enum field
+		// Project status - enum field, changed only through the status workflow
 		field.Enum("status").
-			Values("ACTIVE", "COMPLETED", "ON_HOLD").
+			Values(projectStatuses...).
 			Default("ACTIVE").
 			Comment("Current status of the project"),
 
@@ -59,6 +89,12 @@ func (Project) Fields() []ent.Field {
 			Positive().
 			Comment("Project budget amount"),
 
+		// Foreign key to the project lead (optional)
+		field.UUID("lead_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Foreign key reference to the employee leading the project"),
+
 		// Timestamps for tracking creation and updates
 		field.Time("created_at").
 			Default(time.Now).
@@ -80,6 +116,31 @@ func (Project) Edges() []ent.Edge {
 		// One employee can work on many projects
 		edge.To("team_members", Employee.Type).
 			Comment("Employees assigned to this project (team members)"),
+
+		// Many projects can be led by one employee
+		edge.From("lead", Employee.Type).
+			Ref("led_projects").
+			Field("lead_id").
+			Unique().
+			Comment("The employee leading this project"),
+
+		// One project has many milestones and tasks, deleted with the project
+		edge.To("milestones", Milestone.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Milestones of this project"),
+
+		edge.To("tasks", Task.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Tasks of this project"),
+
+		edge.To("status_changes", ProjectStatusChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("History of status transitions"),
+
+		// Many-to-many relationship with Skill through ProjectSkill (minimum level)
+		edge.To("required_skills", Skill.Type).
+			Through("project_skills", ProjectSkill.Type).
+			Comment("Skills team members should have"),
 	}
 }
 
@@ -90,7 +151,42 @@ func (Project) Indexes() []ent.Index {
 		index.Fields("status"),
 		// Index on priority for faster filtering by priority
 		index.Fields("priority"),
+		// Index on lead_id for a lead's projects
+		index.Fields("lead_id"),
 		// Composite index on dates for range queries
 		index.Fields("start_date", "end_date"),
 	}
 }
+
+// Hooks of the Project.
+func (Project) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Reject status edits that bypass the workflow; creation may set any status
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.ProjectFunc(func(ctx context.Context, m *gen.ProjectMutation) (ent.Value, error) {
+					if _, set := m.Status(); set && ctx.Value(statusTransitionKey{}) == nil {
+						return nil, ErrDirectStatusChange
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne,
+		),
+	}
+}
+
+// Policy of the Project: every authenticated caller sees all projects,
+// project leads change only the projects they lead.
+func (Project) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowUnrestricted(),
+			filterLedProjects(),
+		},
+		Query: privacy.QueryPolicy{
+			allowUnrestricted(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProjectSkill holds the schema definition for the ProjectSkill edge entity.
+// It joins a project to a skill it requires with the minimum proficiency level.
+type ProjectSkill struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectSkill.
+func (ProjectSkill) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its project
+		parentTenantMixin{column: "project_id", parentTable: "projects"},
+	}
+}
+
+// Annotations of the ProjectSkill.
+func (ProjectSkill) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// Composite primary key: one requirement per project and skill
+		field.ID("project_id", "skill_id"),
+	}
+}
+
+// Fields of the ProjectSkill.
+func (ProjectSkill) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("project_id", uuid.UUID{}).
+			Comment("Foreign key reference to the project"),
+
+		field.UUID("skill_id", uuid.UUID{}).
+			Comment("Foreign key reference to the skill"),
+
+		// Minimum proficiency a team member should have
+		field.Int("min_level").
+			Range(1, 5).
+			Default(1).
+			Comment("Minimum proficiency level from 1 (beginner) to 5 (expert)"),
+	}
+}
+
+// Edges of the ProjectSkill.
+func (ProjectSkill) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Rows are deleted with their project or skill
+		edge.To("project", Project.Type).
+			Field("project_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		edge.To("skill", Skill.Type).
+			Field("skill_id").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ProjectStatusChange holds the schema definition for the ProjectStatusChange entity.
+// Each row records one transition of a project's status; rows are never updated.
+type ProjectStatusChange struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectStatusChange.
+func (ProjectStatusChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its project
+		parentTenantMixin{column: "project_id", parentTable: "projects"},
+	}
+}
+
+// Fields of the ProjectStatusChange.
+func (ProjectStatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the status change"),
+
+		// Foreign key to project (required)
+		field.UUID("project_id", uuid.UUID{}).
+			Immutable().
+			Comment("Foreign key reference to the project"),
+
+		field.Enum("from_status").
+			Values(projectStatuses...).
+			Immutable().
+			Comment("Status before the transition"),
+
+		field.Enum("to_status").
+			Values(projectStatuses...).
+			Immutable().
+			Comment("Status after the transition"),
+
+		// Why the status changed - optional unless the workflow requires it
+		field.String("reason").
+			Optional().
+			Immutable().
+			Comment("Reason given for the transition"),
+
+		// Who made the change (principal ID, empty for anonymous callers)
+		field.String("changed_by").
+			Optional().
+			Immutable().
+			Comment("Principal that made the transition"),
+
+		field.Time("changed_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp of the transition"),
+	}
+}
+
+// Edges of the ProjectStatusChange.
+func (ProjectStatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Many status changes belong to one project
+		edge.From("project", Project.Type).
+			Ref("status_changes").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The project whose status changed"),
+	}
+}
+
+// Indexes of the ProjectStatusChange.
+func (ProjectStatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for reading a project's history in order
+		index.Fields("project_id", "changed_at"),
+	}
+}
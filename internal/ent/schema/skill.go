@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Skill holds the schema definition for the Skill entity.
+// Skills form a shared catalog that employees have and projects require.
+type Skill struct {
+	ent.Schema
+}
+
+// Mixin of the Skill.
+func (Skill) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Every row belongs to one tenant
+		TenantMixin{},
+	}
+}
+
+// Fields of the Skill.
+func (Skill) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the skill"),
+
+		// Skill name - required and unique within the tenant (e.g. Go, Terraform)
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the skill"),
+
+		// Grouping such as language, cloud or soft skill - optional
+		field.String("category").
+			Optional().
+			Comment("Category of the skill"),
+
+		// Timestamps for tracking creation and updates
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when skill was created"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when skill was last updated"),
+	}
+}
+
+// Edges of the Skill.
+func (Skill) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Employees that have this skill (proficiency in EmployeeSkill)
+		edge.From("employees", Employee.Type).
+			Ref("skills").
+			Comment("Employees with this skill"),
+
+		// Projects that require this skill (minimum level in ProjectSkill)
+		edge.From("projects", Project.Type).
+			Ref("required_skills").
+			Comment("Projects requiring this skill"),
+	}
+}
+
+// Indexes of the Skill.
+func (Skill) Indexes() []ent.Index {
+	return []ent.Index{
+		// Each tenant has its own catalog
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
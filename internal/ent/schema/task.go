@@ -0,0 +1,144 @@
+package schema
+
+import (
+	"time"
+
+	"gin-crud-api/internal/ent/privacy"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Task holds the schema definition for the Task entity.
+// Tasks are units of project work, optionally scheduled for a milestone and
+// assigned to a member of the project team.
+type Task struct {
+	ent.Schema
+}
+
+// Mixin of the Task.
+func (Task) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its project
+		parentTenantMixin{column: "project_id", parentTable: "projects"},
+	}
+}
+
+// Fields of the Task.
+func (Task) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the task"),
+
+		// Task title - required field
+		field.String("title").
+			NotEmpty().
+			Comment("Title of the task"),
+
+		// Due date - optional
+		field.Time("due_date").
+			Optional().
+			Nillable().
+			Comment("Date the task should be done"),
+
+		// Task status - enum field
+		field.Enum("status").
+			Values("TODO", "IN_PROGRESS", "DONE").
+			Default("TODO").
+			Comment("Current state of the task"),
+
+		// Estimated effort - optional
+		field.Float("estimate_hours").
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("Estimated effort in hours"),
+
+		// Foreign key to project (required)
+		field.UUID("project_id", uuid.UUID{}).
+			Immutable().
+			Comment("Foreign key reference to the project"),
+
+		// Foreign key to milestone (optional)
+		field.UUID("milestone_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Foreign key reference to the milestone"),
+
+		// Foreign key to the assigned employee (optional)
+		field.UUID("assignee_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Foreign key reference to the assigned employee"),
+
+		// Timestamps for tracking creation and updates
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when task was created"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when task was last updated"),
+	}
+}
+
+// Edges of the Task.
+func (Task) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Many tasks belong to one project
+		edge.From("project", Project.Type).
+			Ref("tasks").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The project this task belongs to"),
+
+		// Many tasks can be scheduled for one milestone
+		edge.From("milestone", Milestone.Type).
+			Ref("tasks").
+			Field("milestone_id").
+			Unique().
+			Comment("The milestone this task is scheduled for"),
+
+		// Many tasks can be assigned to one employee
+		edge.From("assignee", Employee.Type).
+			Ref("assigned_tasks").
+			Field("assignee_id").
+			Unique().
+			Comment("The team member working on this task"),
+	}
+}
+
+// Indexes of the Task.
+func (Task) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index on project_id for listing a project's tasks and progress
+		index.Fields("project_id", "status"),
+		// Index on assignee_id for an employee's tasks
+		index.Fields("assignee_id"),
+	}
+}
+
+// Policy of the Task: every authenticated caller sees all tasks, project
+// leads change only those of the projects they lead.
+func (Task) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			allowUnrestricted(),
+			filterLedProjectRows(),
+		},
+		Query: privacy.QueryPolicy{
+			allowUnrestricted(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
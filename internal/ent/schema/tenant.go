@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"gin-crud-api/internal/ent/hook"
+	"gin-crud-api/internal/ent/intercept"
+	"gin-crud-api/internal/tenant"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TenantMixin adds a tenant_id to an entity and scopes every query, update
+// and delete of it to the tenant of the context. Rows of other tenants are
+// never returned, and updating or deleting them fails with not found.
+type TenantMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TenantMixin.
+func (TenantMixin) Fields() []ent.Field {
+	return []ent.Field{
+		// Set from the context on create; existing rows belong to the default tenant
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable().
+			Default(tenant.Default).
+			Comment("Tenant (subsidiary) the row belongs to"),
+	}
+}
+
+// Indexes of the TenantMixin.
+func (TenantMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+	}
+}
+
+// Interceptors of the TenantMixin.
+func (TenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{scopeQueries(ownTenant)}
+}
+
+// Hooks of the TenantMixin.
+func (TenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// New rows belong to the tenant of the context
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if !tenant.IsUnscoped(ctx) {
+						if err := m.SetField("tenant_id", tenant.FromContext(ctx)); err != nil {
+							return nil, err
+						}
+					}
+					return next.Mutate(ctx, m)
+				})
+			},
+			ent.OpCreate,
+		),
+		scopeMutations(ownTenant),
+	}
+}
+
+// parentTenantMixin scopes an entity without a tenant_id of its own by the
+// tenant of the parent row its foreign key points to
+type parentTenantMixin struct {
+	mixin.Schema
+	column      string // Foreign key column of the entity
+	parentTable string // Table of the parent, which has a tenant_id
+}
+
+// Interceptors of the parentTenantMixin.
+func (m parentTenantMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{scopeQueries(m.scope)}
+}
+
+// Hooks of the parentTenantMixin.
+func (m parentTenantMixin) Hooks() []ent.Hook {
+	return []ent.Hook{scopeMutations(m.scope)}
+}
+
+// scope matches rows whose parent belongs to tenantID
+func (m parentTenantMixin) scope(tenantID string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		parent := sql.Table(m.parentTable)
+		s.Where(sql.In(
+			s.C(m.column),
+			sql.Select(parent.C("id")).From(parent).Where(sql.EQ(parent.C("tenant_id"), tenantID)),
+		))
+	}
+}
+
+// ownTenant matches rows whose tenant_id is tenantID
+func ownTenant(tenantID string) func(*sql.Selector) {
+	return sql.FieldEQ("tenant_id", tenantID)
+}
+
+// scopeQueries adds the tenant predicate to every query, including eager loading
+func scopeQueries(scope func(tenantID string) func(*sql.Selector)) ent.Interceptor {
+	return intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		if !tenant.IsUnscoped(ctx) {
+			q.WhereP(scope(tenant.FromContext(ctx)))
+		}
+		return nil
+	})
+}
+
+// scopeMutations adds the tenant predicate to updates and deletes
+func scopeMutations(scope func(tenantID string) func(*sql.Selector)) ent.Hook {
+	return hook.On(
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if tenant.IsUnscoped(ctx) {
+					return next.Mutate(ctx, m)
+				}
+				w, ok := m.(interface{ WhereP(...func(*sql.Selector)) })
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				w.WhereP(scope(tenant.FromContext(ctx)))
+				return next.Mutate(ctx, m)
+			})
+		},
+		ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+	)
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each row tracks one event sent to one subscription and doubles as the
+// delivery log: attempts, the last response and dead-lettered failures.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Visible only to the tenant of its subscription
+		parentTenantMixin{column: "subscription_id", parentTable: "webhook_subscriptions"},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the delivery"),
+
+		// Foreign keys
+		field.Int64("event_id").
+			Immutable().
+			Comment("Foreign key reference to the outbox event"),
+
+		field.UUID("subscription_id", uuid.UUID{}).
+			Immutable().
+			Comment("Foreign key reference to the subscription"),
+
+		// PENDING until delivered, DEAD once retries are exhausted
+		field.Enum("status").
+			Values("PENDING", "DELIVERED", "DEAD").
+			Default("PENDING").
+			Comment("Delivery state"),
+
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of attempts made"),
+
+		// When the dispatcher should (re)try a PENDING delivery
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("Earliest time of the next attempt"),
+
+		// Outcome of the last attempt
+		field.Int("last_status_code").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the last attempt"),
+
+		field.String("last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("Timestamp of the successful attempt"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when the delivery was queued"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", OutboxEvent.Type).
+			Ref("webhook_deliveries").
+			Field("event_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Field("subscription_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for the dispatcher picking due deliveries
+		index.Fields("status", "next_attempt_at"),
+
+		// An event is queued once per subscription, even when read again
+		index.Fields("event_id", "subscription_id").
+			Unique(),
+	}
+}
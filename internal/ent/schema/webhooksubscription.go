@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity.
+// A subscription receives a signed HTTP POST for every event of its types.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookSubscription.
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		// Every row belongs to one tenant
+		TenantMixin{},
+	}
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		// Primary key - UUID type
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("Unique identifier for the subscription"),
+
+		// Receiver endpoint
+		field.String("url").
+			NotEmpty().
+			Comment("URL events are posted to"),
+
+		// Shared secret for the HMAC signature, never returned by the API
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("Secret used to sign deliveries"),
+
+		// Event types the receiver wants (e.g. EMPLOYEE_CREATED)
+		field.Strings("event_types").
+			Comment("Event types delivered to this subscription"),
+
+		// Inactive subscriptions receive no new events
+		field.Bool("active").
+			Default(true).
+			Comment("Whether new events are delivered"),
+
+		// Timestamps for tracking creation and updates
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Timestamp when subscription was created"),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Timestamp when subscription was last updated"),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Deliveries are deleted with their subscription
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Deliveries to this subscription"),
+	}
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"gin-crud-api/internal/ent/skill"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Skill is the model entity for the Skill schema.
+type Skill struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier for the skill
+	ID uuid.UUID `json:"id,omitempty"`
+	// Tenant (subsidiary) the row belongs to
+	TenantID string `json:"tenant_id,omitempty"`
+	// Name of the skill
+	Name string `json:"name,omitempty"`
+	// Category of the skill
+	Category string `json:"category,omitempty"`
+	// Timestamp when skill was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Timestamp when skill was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SkillQuery when eager-loading is set.
+	Edges        SkillEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SkillEdges holds the relations/edges for other nodes in the graph.
+type SkillEdges struct {
+	// Employees with this skill
+	Employees []*Employee `json:"employees,omitempty"`
+	// Projects requiring this skill
+	Projects []*Project `json:"projects,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EmployeesOrErr returns the Employees value or an error if the edge
+// was not loaded in eager-loading.
+func (e SkillEdges) EmployeesOrErr() ([]*Employee, error) {
+	if e.loadedTypes[0] {
+		return e.Employees, nil
+	}
+	return nil, &NotLoadedError{edge: "employees"}
+}
+
+// ProjectsOrErr returns the Projects value or an error if the edge
+// was not loaded in eager-loading.
+func (e SkillEdges) ProjectsOrErr() ([]*Project, error) {
+	if e.loadedTypes[1] {
+		return e.Projects, nil
+	}
+	return nil, &NotLoadedError{edge: "projects"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Skill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case skill.FieldTenantID, skill.FieldName, skill.FieldCategory:
+			values[i] = new(sql.NullString)
+		case skill.FieldCreatedAt, skill.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case skill.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Skill fields.
+func (_m *Skill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case skill.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case skill.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case skill.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case skill.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case skill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case skill.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Skill.
+// This includes values selected through modifiers, order, etc.
+func (_m *Skill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEmployees queries the "employees" edge of the Skill entity.
+func (_m *Skill) QueryEmployees() *EmployeeQuery {
+	return NewSkillClient(_m.config).QueryEmployees(_m)
+}
+
+// QueryProjects queries the "projects" edge of the Skill entity.
+func (_m *Skill) QueryProjects() *ProjectQuery {
+	return NewSkillClient(_m.config).QueryProjects(_m)
+}
+
+// Update returns a builder for updating this Skill.
+// Note that you need to call Skill.Unwrap() before calling this method if this Skill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Skill) Update() *SkillUpdateOne {
+	return NewSkillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Skill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Skill) Unwrap() *Skill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Skill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Skill) String() string {
+	var builder strings.Builder
+	builder.WriteString("Skill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Skills is a parsable slice of Skill.
+type Skills []*Skill
@@ -16,8 +16,30 @@ type Tx struct {
 	Department *DepartmentClient
 	// Employee is the client for interacting with the Employee builders.
 	Employee *EmployeeClient
+	// EmployeeSkill is the client for interacting with the EmployeeSkill builders.
+	EmployeeSkill *EmployeeSkillClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// OutboxOffset is the client for interacting with the OutboxOffset builders.
+	OutboxOffset *OutboxOffsetClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectSkill is the client for interacting with the ProjectSkill builders.
+	ProjectSkill *ProjectSkillClient
+	// ProjectStatusChange is the client for interacting with the ProjectStatusChange builders.
+	ProjectStatusChange *ProjectStatusChangeClient
+	// Skill is the client for interacting with the Skill builders.
+	Skill *SkillClient
+	// Task is the client for interacting with the Task builders.
+	Task *TaskClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,7 +173,18 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.Employee = NewEmployeeClient(tx.config)
+	tx.EmployeeSkill = NewEmployeeSkillClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.OutboxOffset = NewOutboxOffsetClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.ProjectSkill = NewProjectSkillClient(tx.config)
+	tx.ProjectStatusChange = NewProjectStatusChangeClient(tx.config)
+	tx.Skill = NewSkillClient(tx.config)
+	tx.Task = NewTaskClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,747 @@
+// Package fixture creates realistic data through the EntGo client: builders
+// for every entity with randomized defaults, and named scenarios that build a
+// whole organization. All randomness (including IDs) comes from the tenant
+// and seed given to New, so a seed always produces the same data for a
+// tenant. Tests use it through package testutil; the seed command uses it to
+// populate dev databases.
+package fixture
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/milestone"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/projectstatuschange"
+	"gin-crud-api/internal/ent/task"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+)
+
+// Epoch is the day that generated dates are relative to. It is fixed rather
+// than time.Now so that a seed produces the same dates on every run.
+var Epoch = time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+// Factory creates entities with defaults drawn from a seeded random source.
+// Rows are created in the tenant of the context passed to Create.
+type Factory struct {
+	client *ent.Client
+	src    *rand.ChaCha8
+	rand   *rand.Rand
+
+	// Counts of the builders started, which make names, emails and URLs unique
+	departments, employees, projects, skills, webhooks int
+}
+
+// New creates a factory writing through client whose defaults are determined
+// by tenantID and seed. IDs are global, so the tenant is part of the key:
+// tenants seeded alike get the same data under different IDs. tenantID should
+// be the tenant of the contexts passed to Create.
+func New(client *ent.Client, tenantID string, seed uint64) *Factory {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:8], seed)
+	tenantSum := sha256.Sum256([]byte(tenantID))
+	copy(key[8:], tenantSum[:])
+	src := rand.NewChaCha8(key)
+	return &Factory{client: client, src: src, rand: rand.New(src)}
+}
+
+// id returns a random (version 4) UUID from the seeded source
+func (f *Factory) id() uuid.UUID {
+	id, err := uuid.NewRandomFromReader(f.src)
+	if err != nil {
+		// ChaCha8 never fails to read
+		panic(fmt.Sprintf("fixture: failed to generate ID: %v", err))
+	}
+	return id
+}
+
+// numbered returns names[n-1], adding n once the names are used up
+func numbered(names []string, n int) string {
+	name := names[(n-1)%len(names)]
+	if n > len(names) {
+		name = fmt.Sprintf("%s %d", name, n)
+	}
+	return name
+}
+
+// pick returns a random element of values
+func pick[T any](f *Factory, values []T) T {
+	return values[f.rand.IntN(len(values))]
+}
+
+// day returns Epoch moved by offset days
+func day(offset int) time.Time {
+	return Epoch.AddDate(0, 0, offset)
+}
+
+// between returns a random day from from to to (inclusive)
+func (f *Factory) between(from, to time.Time) time.Time {
+	days := int(to.Sub(from).Hours() / 24)
+	if days <= 0 {
+		return from
+	}
+	return from.AddDate(0, 0, f.rand.IntN(days+1))
+}
+
+var (
+	firstNames = []string{
+		"Ada", "Ben", "Chloe", "Dmitri", "Elena", "Farid", "Grace", "Hiro", "Ines", "Jonas",
+		"Keiko", "Liam", "Maya", "Nikhil", "Olga", "Pablo", "Quinn", "Rosa", "Sven", "Tara",
+		"Umar", "Vera", "Wei", "Ximena", "Yusuf", "Zoe",
+	}
+	lastNames = []string{
+		"Adams", "Becker", "Castillo", "Dubois", "Eriksen", "Fischer", "Garcia", "Haddad", "Ito", "Jensen",
+		"Kowalski", "Lindqvist", "Moreau", "Nakamura", "Okafor", "Petrov", "Rossi", "Silva", "Tanaka", "Novak",
+	}
+	departmentNames = []string{
+		"Engineering", "Sales", "Marketing", "Finance", "Operations", "Product", "Design",
+		"Support", "Legal", "Research", "Security", "People",
+	}
+	jobTitles = []string{
+		"Software Engineer", "Senior Software Engineer", "Engineering Manager", "Product Manager",
+		"Designer", "Data Analyst", "Account Executive", "Support Specialist", "Accountant", "Recruiter",
+	}
+	locations    = []string{"Berlin", "Lisbon", "London", "New York", "Singapore", "Tokyo", "Remote"}
+	projectNames = []string{
+		"Apollo", "Artemis", "Atlas", "Borealis", "Comet", "Gemini", "Helios", "Mercury", "Nova", "Orion",
+		"Phoenix", "Polaris", "Pulsar", "Quasar", "Titan", "Vega", "Voyager", "Zephyr",
+	}
+	skillNames = []string{
+		"Go", "SQL", "Kubernetes", "Terraform", "React", "TypeScript", "Python", "GraphQL",
+		"Data Modeling", "Security", "Negotiation", "Public Speaking",
+	}
+	skillCategories = map[string]string{
+		"Go": "Language", "SQL": "Language", "TypeScript": "Language", "Python": "Language",
+		"Kubernetes": "Cloud", "Terraform": "Cloud",
+		"React": "Frontend", "GraphQL": "Backend", "Data Modeling": "Backend", "Security": "Backend",
+		"Negotiation": "Soft skill", "Public Speaking": "Soft skill",
+	}
+	milestoneTitles = []string{"Kickoff", "Prototype", "Alpha", "Beta", "Release candidate", "Launch"}
+	taskTitles      = []string{
+		"Write requirements", "Design data model", "Build API", "Build UI", "Write tests",
+		"Review security", "Load test", "Write documentation", "Train support", "Plan rollout",
+	}
+)
+
+// DepartmentBuilder creates a department. By default it is top-level with a
+// name that is unique within the factory.
+type DepartmentBuilder struct {
+	f        *Factory
+	id       uuid.UUID
+	name     string
+	parentID *uuid.UUID
+}
+
+// Department starts building a department
+func (f *Factory) Department() *DepartmentBuilder {
+	f.departments++
+	return &DepartmentBuilder{f: f, id: f.id(), name: numbered(departmentNames, f.departments)}
+}
+
+// Name sets the department name
+func (b *DepartmentBuilder) Name(name string) *DepartmentBuilder {
+	b.name = name
+	return b
+}
+
+// Parent places the department under parent
+func (b *DepartmentBuilder) Parent(parent *ent.Department) *DepartmentBuilder {
+	b.parentID = &parent.ID
+	return b
+}
+
+// Create saves the department
+func (b *DepartmentBuilder) Create(ctx context.Context) (*ent.Department, error) {
+	return b.f.client.Department.Create().
+		SetID(b.id).
+		SetName(b.name).
+		SetNillableParentID(b.parentID).
+		Save(ctx)
+}
+
+// EmployeeBuilder creates an employee. By default it is an ACTIVE full-time
+// employee (contractor or intern now and then) with a unique email, a job
+// title, phone, location and a hire date in the five years before Epoch.
+type EmployeeBuilder struct {
+	f               *Factory
+	id              uuid.UUID
+	name            string
+	email           string
+	departmentID    uuid.UUID
+	jobTitle        string
+	employmentType  employee.EmploymentType
+	status          employee.Status
+	hireDate        time.Time
+	terminationDate *time.Time
+	phone           string
+	location        string
+}
+
+// Employee starts building an employee of dept
+func (f *Factory) Employee(dept *ent.Department) *EmployeeBuilder {
+	f.employees++
+	first, last := pick(f, firstNames), pick(f, lastNames)
+	employmentType := employee.EmploymentTypeFULL_TIME
+	switch n := f.rand.IntN(20); {
+	case n == 0:
+		employmentType = employee.EmploymentTypeINTERN
+	case n < 4:
+		employmentType = employee.EmploymentTypeCONTRACTOR
+	}
+	return &EmployeeBuilder{
+		f:              f,
+		id:             f.id(),
+		name:           first + " " + last,
+		email:          fmt.Sprintf("%s.%s.%d@example.com", strings.ToLower(first), strings.ToLower(last), f.employees),
+		departmentID:   dept.ID,
+		jobTitle:       pick(f, jobTitles),
+		employmentType: employmentType,
+		status:         employee.StatusACTIVE,
+		hireDate:       f.between(day(-5*365), day(-1)),
+		phone:          fmt.Sprintf("+1 555 %04d", f.rand.IntN(10000)),
+		location:       pick(f, locations),
+	}
+}
+
+// Name sets the employee name
+func (b *EmployeeBuilder) Name(name string) *EmployeeBuilder {
+	b.name = name
+	return b
+}
+
+// Email sets the employee email
+func (b *EmployeeBuilder) Email(email string) *EmployeeBuilder {
+	b.email = email
+	return b
+}
+
+// JobTitle sets the job title
+func (b *EmployeeBuilder) JobTitle(title string) *EmployeeBuilder {
+	b.jobTitle = title
+	return b
+}
+
+// EmploymentType sets the employment type
+func (b *EmployeeBuilder) EmploymentType(t employee.EmploymentType) *EmployeeBuilder {
+	b.employmentType = t
+	return b
+}
+
+// OnLeave marks the employee as on leave
+func (b *EmployeeBuilder) OnLeave() *EmployeeBuilder {
+	b.status = employee.StatusON_LEAVE
+	return b
+}
+
+// Terminated marks the employee as terminated on date
+func (b *EmployeeBuilder) Terminated(date time.Time) *EmployeeBuilder {
+	b.status = employee.StatusTERMINATED
+	b.terminationDate = &date
+	return b
+}
+
+// HireDate sets the hire date
+func (b *EmployeeBuilder) HireDate(date time.Time) *EmployeeBuilder {
+	b.hireDate = date
+	return b
+}
+
+// Create saves the employee
+func (b *EmployeeBuilder) Create(ctx context.Context) (*ent.Employee, error) {
+	return b.f.client.Employee.Create().
+		SetID(b.id).
+		SetName(b.name).
+		SetEmail(b.email).
+		SetDepartmentID(b.departmentID).
+		SetJobTitle(b.jobTitle).
+		SetEmploymentType(b.employmentType).
+		SetStatus(b.status).
+		SetHireDate(b.hireDate).
+		SetNillableTerminationDate(b.terminationDate).
+		SetPhone(b.phone).
+		SetLocation(b.location).
+		Save(ctx)
+}
+
+// ProjectBuilder creates a project. By default it is ACTIVE with a random
+// priority, a budget of 10,000 to 500,000, and dates around Epoch, without
+// a lead or team members.
+type ProjectBuilder struct {
+	f           *Factory
+	id          uuid.UUID
+	name        string
+	description string
+	status      project.Status
+	priority    project.Priority
+	startDate   time.Time
+	endDate     time.Time
+	budget      float64
+	leadID      *uuid.UUID
+	memberIDs   []uuid.UUID
+}
+
+// Project starts building a project
+func (f *Factory) Project() *ProjectBuilder {
+	f.projects++
+	name := numbered(projectNames, f.projects)
+	start := f.between(day(-180), day(90))
+	return &ProjectBuilder{
+		f:           f,
+		id:          f.id(),
+		name:        name,
+		description: fmt.Sprintf("Project %s", name),
+		status:      project.StatusACTIVE,
+		priority:    pick(f, []project.Priority{project.PriorityLOW, project.PriorityMEDIUM, project.PriorityHIGH}),
+		startDate:   start,
+		endDate:     f.between(start.AddDate(0, 1, 0), start.AddDate(1, 0, 0)),
+		budget:      float64(10+f.rand.IntN(491)) * 1000,
+	}
+}
+
+// Name sets the project name
+func (b *ProjectBuilder) Name(name string) *ProjectBuilder {
+	b.name = name
+	return b
+}
+
+// Status sets the project status
+func (b *ProjectBuilder) Status(status project.Status) *ProjectBuilder {
+	b.status = status
+	return b
+}
+
+// Priority sets the project priority
+func (b *ProjectBuilder) Priority(priority project.Priority) *ProjectBuilder {
+	b.priority = priority
+	return b
+}
+
+// Dates sets the start and end dates
+func (b *ProjectBuilder) Dates(start, end time.Time) *ProjectBuilder {
+	b.startDate, b.endDate = start, end
+	return b
+}
+
+// Budget sets the budget
+func (b *ProjectBuilder) Budget(budget float64) *ProjectBuilder {
+	b.budget = budget
+	return b
+}
+
+// Lead makes emp the project lead and a team member
+func (b *ProjectBuilder) Lead(emp *ent.Employee) *ProjectBuilder {
+	b.leadID = &emp.ID
+	return b.Members(emp)
+}
+
+// Members adds employees to the team
+func (b *ProjectBuilder) Members(employees ...*ent.Employee) *ProjectBuilder {
+	for _, emp := range employees {
+		b.memberIDs = append(b.memberIDs, emp.ID)
+	}
+	return b
+}
+
+// Create saves the project with its team
+func (b *ProjectBuilder) Create(ctx context.Context) (*ent.Project, error) {
+	return b.f.client.Project.Create().
+		SetID(b.id).
+		SetName(b.name).
+		SetDescription(b.description).
+		SetStatus(b.status).
+		SetPriority(b.priority).
+		SetStartDate(b.startDate).
+		SetEndDate(b.endDate).
+		SetBudget(b.budget).
+		SetNillableLeadID(b.leadID).
+		AddTeamMemberIDs(uniqueIDs(b.memberIDs)...).
+		Save(ctx)
+}
+
+// AddTeamMembers adds employees to the team of an existing project
+func (f *Factory) AddTeamMembers(ctx context.Context, proj *ent.Project, employees ...*ent.Employee) error {
+	ids := make([]uuid.UUID, len(employees))
+	for i, emp := range employees {
+		ids[i] = emp.ID
+	}
+	return f.client.Project.UpdateOneID(proj.ID).AddTeamMemberIDs(ids...).Exec(ctx)
+}
+
+// uniqueIDs drops repeated IDs, keeping the first occurrence
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// MilestoneBuilder creates a milestone. By default it is OPEN and due
+// between the project's start and end dates.
+type MilestoneBuilder struct {
+	f         *Factory
+	id        uuid.UUID
+	projectID uuid.UUID
+	title     string
+	dueDate   time.Time
+	status    milestone.Status
+}
+
+// Milestone starts building a milestone of proj
+func (f *Factory) Milestone(proj *ent.Project) *MilestoneBuilder {
+	return &MilestoneBuilder{
+		f:         f,
+		id:        f.id(),
+		projectID: proj.ID,
+		title:     pick(f, milestoneTitles),
+		dueDate:   f.between(proj.StartDate, proj.EndDate),
+		status:    milestone.StatusOPEN,
+	}
+}
+
+// Title sets the milestone title
+func (b *MilestoneBuilder) Title(title string) *MilestoneBuilder {
+	b.title = title
+	return b
+}
+
+// DueDate sets the due date
+func (b *MilestoneBuilder) DueDate(date time.Time) *MilestoneBuilder {
+	b.dueDate = date
+	return b
+}
+
+// Completed marks the milestone as completed
+func (b *MilestoneBuilder) Completed() *MilestoneBuilder {
+	b.status = milestone.StatusCOMPLETED
+	return b
+}
+
+// Create saves the milestone
+func (b *MilestoneBuilder) Create(ctx context.Context) (*ent.Milestone, error) {
+	return b.f.client.Milestone.Create().
+		SetID(b.id).
+		SetProjectID(b.projectID).
+		SetTitle(b.title).
+		SetDueDate(b.dueDate).
+		SetStatus(b.status).
+		Save(ctx)
+}
+
+// TaskBuilder creates a task. By default it is TODO, unassigned and not
+// scheduled for a milestone, with an estimate of 1 to 40 hours and a due date
+// between the project's start and end dates.
+type TaskBuilder struct {
+	f             *Factory
+	id            uuid.UUID
+	projectID     uuid.UUID
+	milestoneID   *uuid.UUID
+	assigneeID    *uuid.UUID
+	title         string
+	dueDate       time.Time
+	status        task.Status
+	estimateHours float64
+}
+
+// Task starts building a task of proj
+func (f *Factory) Task(proj *ent.Project) *TaskBuilder {
+	return &TaskBuilder{
+		f:             f,
+		id:            f.id(),
+		projectID:     proj.ID,
+		title:         pick(f, taskTitles),
+		dueDate:       f.between(proj.StartDate, proj.EndDate),
+		status:        task.StatusTODO,
+		estimateHours: float64(1 + f.rand.IntN(40)),
+	}
+}
+
+// Title sets the task title
+func (b *TaskBuilder) Title(title string) *TaskBuilder {
+	b.title = title
+	return b
+}
+
+// Milestone schedules the task for m
+func (b *TaskBuilder) Milestone(m *ent.Milestone) *TaskBuilder {
+	b.milestoneID = &m.ID
+	return b
+}
+
+// Assignee assigns the task to emp
+func (b *TaskBuilder) Assignee(emp *ent.Employee) *TaskBuilder {
+	b.assigneeID = &emp.ID
+	return b
+}
+
+// Status sets the task status
+func (b *TaskBuilder) Status(status task.Status) *TaskBuilder {
+	b.status = status
+	return b
+}
+
+// DueDate sets the due date
+func (b *TaskBuilder) DueDate(date time.Time) *TaskBuilder {
+	b.dueDate = date
+	return b
+}
+
+// EstimateHours sets the estimate
+func (b *TaskBuilder) EstimateHours(hours float64) *TaskBuilder {
+	b.estimateHours = hours
+	return b
+}
+
+// Create saves the task
+func (b *TaskBuilder) Create(ctx context.Context) (*ent.Task, error) {
+	return b.f.client.Task.Create().
+		SetID(b.id).
+		SetProjectID(b.projectID).
+		SetNillableMilestoneID(b.milestoneID).
+		SetNillableAssigneeID(b.assigneeID).
+		SetTitle(b.title).
+		SetDueDate(b.dueDate).
+		SetStatus(b.status).
+		SetEstimateHours(b.estimateHours).
+		Save(ctx)
+}
+
+// SkillBuilder creates a skill. By default it has a name that is unique
+// within the factory and the category of that name.
+type SkillBuilder struct {
+	f        *Factory
+	id       uuid.UUID
+	name     string
+	category string
+}
+
+// Skill starts building a skill
+func (f *Factory) Skill() *SkillBuilder {
+	f.skills++
+	category := skillCategories[skillNames[(f.skills-1)%len(skillNames)]]
+	return &SkillBuilder{f: f, id: f.id(), name: numbered(skillNames, f.skills), category: category}
+}
+
+// Name sets the skill name
+func (b *SkillBuilder) Name(name string) *SkillBuilder {
+	b.name = name
+	return b
+}
+
+// Category sets the skill category
+func (b *SkillBuilder) Category(category string) *SkillBuilder {
+	b.category = category
+	return b
+}
+
+// Create saves the skill
+func (b *SkillBuilder) Create(ctx context.Context) (*ent.Skill, error) {
+	return b.f.client.Skill.Create().
+		SetID(b.id).
+		SetName(b.name).
+		SetCategory(b.category).
+		Save(ctx)
+}
+
+// EmployeeSkillBuilder gives an employee a skill. By default the level is
+// random from 1 to 5.
+type EmployeeSkillBuilder struct {
+	f          *Factory
+	employeeID uuid.UUID
+	skillID    uuid.UUID
+	level      int
+}
+
+// EmployeeSkill starts giving emp the skill s
+func (f *Factory) EmployeeSkill(emp *ent.Employee, s *ent.Skill) *EmployeeSkillBuilder {
+	return &EmployeeSkillBuilder{f: f, employeeID: emp.ID, skillID: s.ID, level: 1 + f.rand.IntN(5)}
+}
+
+// Level sets the proficiency level
+func (b *EmployeeSkillBuilder) Level(level int) *EmployeeSkillBuilder {
+	b.level = level
+	return b
+}
+
+// Create saves the employee's skill
+func (b *EmployeeSkillBuilder) Create(ctx context.Context) (*ent.EmployeeSkill, error) {
+	return b.f.client.EmployeeSkill.Create().
+		SetEmployeeID(b.employeeID).
+		SetSkillID(b.skillID).
+		SetLevel(b.level).
+		Save(ctx)
+}
+
+// ProjectSkillBuilder makes a skill required by a project. By default the
+// minimum level is random from 1 to 3.
+type ProjectSkillBuilder struct {
+	f         *Factory
+	projectID uuid.UUID
+	skillID   uuid.UUID
+	minLevel  int
+}
+
+// ProjectSkill starts requiring the skill s for proj
+func (f *Factory) ProjectSkill(proj *ent.Project, s *ent.Skill) *ProjectSkillBuilder {
+	return &ProjectSkillBuilder{f: f, projectID: proj.ID, skillID: s.ID, minLevel: 1 + f.rand.IntN(3)}
+}
+
+// MinLevel sets the minimum proficiency level
+func (b *ProjectSkillBuilder) MinLevel(level int) *ProjectSkillBuilder {
+	b.minLevel = level
+	return b
+}
+
+// Create saves the project's required skill
+func (b *ProjectSkillBuilder) Create(ctx context.Context) (*ent.ProjectSkill, error) {
+	return b.f.client.ProjectSkill.Create().
+		SetProjectID(b.projectID).
+		SetSkillID(b.skillID).
+		SetMinLevel(b.minLevel).
+		Save(ctx)
+}
+
+// WebhookSubscriptionBuilder creates a webhook subscription. By default it is
+// active, receives every event type and posts to a URL that is unique within
+// the factory, signed with a random secret.
+type WebhookSubscriptionBuilder struct {
+	f          *Factory
+	id         uuid.UUID
+	url        string
+	secret     string
+	eventTypes []string
+	active     bool
+}
+
+// WebhookSubscription starts building a webhook subscription
+func (f *Factory) WebhookSubscription() *WebhookSubscriptionBuilder {
+	f.webhooks++
+	return (&WebhookSubscriptionBuilder{
+		f:      f,
+		id:     f.id(),
+		url:    fmt.Sprintf("https://hooks.example.com/%d", f.webhooks),
+		secret: fmt.Sprintf("%016x%016x", f.rand.Uint64(), f.rand.Uint64()),
+		active: true,
+	}).EventTypes(model.AllWebhookEventType...)
+}
+
+// URL sets the receiver endpoint
+func (b *WebhookSubscriptionBuilder) URL(url string) *WebhookSubscriptionBuilder {
+	b.url = url
+	return b
+}
+
+// Secret sets the signing secret
+func (b *WebhookSubscriptionBuilder) Secret(secret string) *WebhookSubscriptionBuilder {
+	b.secret = secret
+	return b
+}
+
+// EventTypes sets the event types delivered to the subscription
+func (b *WebhookSubscriptionBuilder) EventTypes(types ...model.WebhookEventType) *WebhookSubscriptionBuilder {
+	b.eventTypes = make([]string, len(types))
+	for i, t := range types {
+		b.eventTypes[i] = string(t)
+	}
+	return b
+}
+
+// Inactive marks the subscription as inactive
+func (b *WebhookSubscriptionBuilder) Inactive() *WebhookSubscriptionBuilder {
+	b.active = false
+	return b
+}
+
+// Create saves the subscription
+func (b *WebhookSubscriptionBuilder) Create(ctx context.Context) (*ent.WebhookSubscription, error) {
+	return b.f.client.WebhookSubscription.Create().
+		SetID(b.id).
+		SetURL(b.url).
+		SetSecret(b.secret).
+		SetEventTypes(b.eventTypes).
+		SetActive(b.active).
+		Save(ctx)
+}
+
+// ProjectStatusChangeBuilder records a status change in a project's history.
+// By default it is the move from PLANNED to the project's status, made on the
+// project's start date by nobody in particular. It only writes history: the
+// project's status is left as it is.
+type ProjectStatusChangeBuilder struct {
+	f         *Factory
+	id        uuid.UUID
+	projectID uuid.UUID
+	from      projectstatuschange.FromStatus
+	to        projectstatuschange.ToStatus
+	reason    *string
+	changedBy *string
+	changedAt time.Time
+}
+
+// ProjectStatusChange starts recording a status change of proj
+func (f *Factory) ProjectStatusChange(proj *ent.Project) *ProjectStatusChangeBuilder {
+	return &ProjectStatusChangeBuilder{
+		f:         f,
+		id:        f.id(),
+		projectID: proj.ID,
+		from:      projectstatuschange.FromStatusPLANNED,
+		to:        projectstatuschange.ToStatus(proj.Status),
+		changedAt: proj.StartDate,
+	}
+}
+
+// From sets the status before the change
+func (b *ProjectStatusChangeBuilder) From(status project.Status) *ProjectStatusChangeBuilder {
+	b.from = projectstatuschange.FromStatus(status)
+	return b
+}
+
+// To sets the status after the change
+func (b *ProjectStatusChangeBuilder) To(status project.Status) *ProjectStatusChangeBuilder {
+	b.to = projectstatuschange.ToStatus(status)
+	return b
+}
+
+// Reason sets the reason given for the change
+func (b *ProjectStatusChangeBuilder) Reason(reason string) *ProjectStatusChangeBuilder {
+	b.reason = &reason
+	return b
+}
+
+// ChangedBy sets the principal that made the change
+func (b *ProjectStatusChangeBuilder) ChangedBy(principalID string) *ProjectStatusChangeBuilder {
+	b.changedBy = &principalID
+	return b
+}
+
+// ChangedAt sets when the change was made
+func (b *ProjectStatusChangeBuilder) ChangedAt(at time.Time) *ProjectStatusChangeBuilder {
+	b.changedAt = at
+	return b
+}
+
+// Create saves the status change
+func (b *ProjectStatusChangeBuilder) Create(ctx context.Context) (*ent.ProjectStatusChange, error) {
+	return b.f.client.ProjectStatusChange.Create().
+		SetID(b.id).
+		SetProjectID(b.projectID).
+		SetFromStatus(b.from).
+		SetToStatus(b.to).
+		SetNillableReason(b.reason).
+		SetNillableChangedBy(b.changedBy).
+		SetChangedAt(b.changedAt).
+		Save(ctx)
+}
@@ -0,0 +1,170 @@
+package fixture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/ent/task"
+)
+
+// Scenario is a named organization of a given size. A third of the
+// departments are top-level and the rest sit below them. About 90% of the
+// employees are ACTIVE, the others on leave or terminated. Projects get a
+// lead, a team of two to eight employees that are not terminated, up to
+// three milestones and three to eight tasks assigned to the team.
+type Scenario struct {
+	Name        string
+	Description string
+	Departments int
+	Employees   int
+	Projects    int
+}
+
+// scenarios are the named scenarios, from small to large
+var scenarios = []Scenario{
+	{Name: "small", Description: "2 departments, 8 employees and 3 projects", Departments: 2, Employees: 8, Projects: 3},
+	{Name: "org", Description: "3 departments, 50 employees and 10 projects", Departments: 3, Employees: 50, Projects: 10},
+	{Name: "large", Description: "12 departments, 500 employees and 60 projects", Departments: 12, Employees: 500, Projects: 60},
+}
+
+// Scenarios returns the named scenarios
+func Scenarios() []Scenario {
+	return append([]Scenario(nil), scenarios...)
+}
+
+// LookupScenario returns the scenario called name
+func LookupScenario(name string) (Scenario, error) {
+	names := make([]string, len(scenarios))
+	for i, s := range scenarios {
+		if s.Name == name {
+			return s, nil
+		}
+		names[i] = s.Name
+	}
+	return Scenario{}, fmt.Errorf("unknown scenario %q (want %s)", name, strings.Join(names, ", "))
+}
+
+// Dataset is the data created by a scenario, in creation order
+type Dataset struct {
+	Departments []*ent.Department
+	Employees   []*ent.Employee
+	Projects    []*ent.Project
+	Milestones  []*ent.Milestone
+	Tasks       []*ent.Task
+}
+
+// Load creates the scenario's data with f
+func (s Scenario) Load(ctx context.Context, f *Factory) (*Dataset, error) {
+	data := &Dataset{}
+
+	topLevel := max(1, (s.Departments+2)/3)
+	for i := 0; i < s.Departments; i++ {
+		b := f.Department()
+		if i >= topLevel {
+			b.Parent(pick(f, data.Departments))
+		}
+		dept, err := b.Create(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create department: %w", err)
+		}
+		data.Departments = append(data.Departments, dept)
+	}
+
+	// Only employees that are not terminated (the staff) join projects
+	var staff []*ent.Employee
+	for i := 0; i < s.Employees && len(data.Departments) > 0; i++ {
+		b := f.Employee(pick(f, data.Departments))
+		switch n := f.rand.IntN(20); {
+		case n == 0:
+			b.OnLeave()
+		case n == 1:
+			b.Terminated(f.between(b.hireDate, day(-1)))
+		}
+		emp, err := b.Create(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create employee: %w", err)
+		}
+		data.Employees = append(data.Employees, emp)
+		if emp.Status != employee.StatusTERMINATED {
+			staff = append(staff, emp)
+		}
+	}
+
+	statuses := []project.Status{
+		project.StatusACTIVE, project.StatusACTIVE, project.StatusACTIVE, project.StatusACTIVE, project.StatusACTIVE,
+		project.StatusPLANNED, project.StatusPLANNED, project.StatusON_HOLD, project.StatusCOMPLETED, project.StatusCANCELLED,
+	}
+	for i := 0; i < s.Projects; i++ {
+		b := f.Project().Status(pick(f, statuses))
+		team := f.team(staff)
+		if len(team) > 0 {
+			b.Lead(team[0]).Members(team[1:]...)
+		}
+		proj, err := b.Create(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create project: %w", err)
+		}
+		data.Projects = append(data.Projects, proj)
+		if err := f.plan(ctx, data, proj, team); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// team returns two to eight distinct employees of staff (fewer if staff is smaller)
+func (f *Factory) team(staff []*ent.Employee) []*ent.Employee {
+	size := min(len(staff), 2+f.rand.IntN(7))
+	team := make([]*ent.Employee, 0, size)
+	for _, i := range f.rand.Perm(len(staff))[:size] {
+		team = append(team, staff[i])
+	}
+	return team
+}
+
+// plan creates the milestones and tasks of proj. Tasks of completed projects
+// are DONE; those of planned projects are TODO.
+func (f *Factory) plan(ctx context.Context, data *Dataset, proj *ent.Project, team []*ent.Employee) error {
+	var milestones []*ent.Milestone
+	for i := f.rand.IntN(4); i > 0; i-- {
+		b := f.Milestone(proj)
+		if proj.Status == project.StatusCOMPLETED {
+			b.Completed()
+		}
+		m, err := b.Create(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create milestone: %w", err)
+		}
+		milestones = append(milestones, m)
+		data.Milestones = append(data.Milestones, m)
+	}
+
+	statuses := []task.Status{task.StatusTODO, task.StatusIN_PROGRESS, task.StatusDONE}
+	for i := 3 + f.rand.IntN(6); i > 0; i-- {
+		b := f.Task(proj)
+		switch proj.Status {
+		case project.StatusCOMPLETED:
+			b.Status(task.StatusDONE)
+		case project.StatusPLANNED:
+			// Nothing has started yet
+		default:
+			b.Status(pick(f, statuses))
+		}
+		if len(team) > 0 {
+			b.Assignee(pick(f, team))
+		}
+		if len(milestones) > 0 && f.rand.IntN(2) == 0 {
+			b.Milestone(pick(f, milestones))
+		}
+		t, err := b.Create(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+		data.Tasks = append(data.Tasks, t)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package fixture_test
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/ent/employee"
+	"gin-crud-api/internal/ent/project"
+	"gin-crud-api/internal/fixture"
+	"gin-crud-api/internal/tenant"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loadOrg loads the org scenario with seed into a new database
+func loadOrg(t *testing.T, seed uint64) *fixture.Dataset {
+	client := testutil.NewTestEntClientWithDriver(t, testutil.NewTestEntDriver(t))
+	return loadOrgInto(t, client, tenant.Default, seed)
+}
+
+// loadOrgInto loads the org scenario with seed into the tenant of client's database
+func loadOrgInto(t *testing.T, client *ent.Client, tenantID string, seed uint64) *fixture.Dataset {
+	scenario, err := fixture.LookupScenario("org")
+	require.NoError(t, err)
+	ctx := tenant.NewContext(auth.WithSystem(context.Background()), tenantID)
+	data, err := scenario.Load(ctx, fixture.New(client, tenantID, seed))
+	require.NoError(t, err)
+	return data
+}
+
+// employeeKeys returns the ID and email of every employee
+func employeeKeys(data *fixture.Dataset) []string {
+	keys := make([]string, 0, 2*len(data.Employees))
+	for _, emp := range data.Employees {
+		keys = append(keys, emp.ID.String(), emp.Email)
+	}
+	return keys
+}
+
+func TestScenario_Org(t *testing.T) {
+	// Setup & Test
+	data := loadOrg(t, 42)
+
+	// Assert: the sizes of the scenario
+	require.Len(t, data.Departments, 3)
+	require.Len(t, data.Employees, 50)
+	require.Len(t, data.Projects, 10)
+	assert.Nil(t, data.Departments[0].ParentID)
+	assert.NotNil(t, data.Departments[2].ParentID)
+
+	terminated := map[string]bool{}
+	for _, emp := range data.Employees {
+		if emp.Status == employee.StatusTERMINATED {
+			terminated[emp.ID.String()] = true
+		}
+	}
+	for _, proj := range data.Projects {
+		require.NotNil(t, proj.LeadID, proj.Name)
+		assert.False(t, terminated[proj.LeadID.String()], "terminated lead of %s", proj.Name)
+		assert.True(t, proj.StartDate.Before(proj.EndDate), proj.Name)
+		assert.Positive(t, proj.Budget)
+	}
+	assert.NotEmpty(t, data.Tasks)
+}
+
+func TestScenario_Deterministic(t *testing.T) {
+	// Setup & Test: load the scenario three times, each subtest has its own database
+	var first, again, other *fixture.Dataset
+	t.Run("first", func(t *testing.T) { first = loadOrg(t, 7) })
+	t.Run("again", func(t *testing.T) { again = loadOrg(t, 7) })
+	t.Run("other", func(t *testing.T) { other = loadOrg(t, 8) })
+
+	// Assert: the same seed creates the same rows, another seed other rows
+	assert.Equal(t, employeeKeys(first), employeeKeys(again))
+	require.Len(t, again.Tasks, len(first.Tasks))
+	for i := range first.Tasks {
+		assert.Equal(t, first.Tasks[i].ID, again.Tasks[i].ID)
+		assert.Equal(t, first.Tasks[i].AssigneeID, again.Tasks[i].AssigneeID)
+		assert.Equal(t, first.Tasks[i].DueDate, again.Tasks[i].DueDate)
+	}
+	assert.NotEqual(t, employeeKeys(first), employeeKeys(other))
+}
+
+func TestLookupScenario_Unknown(t *testing.T) {
+	_, err := fixture.LookupScenario("huge")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "small, org, large")
+}
+
+func TestBuilders(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+	f := testutil.NewFactory(t, client)
+	ctx := auth.WithSystem(context.Background())
+
+	// Test: defaults and overrides
+	eng := testutil.Create(t, f.Department())
+	platform := testutil.Create(t, f.Department().Name("Platform").Parent(eng))
+	ann := testutil.Create(t, f.Employee(platform).Name("Ann").Email("ann@example.com"))
+	bob := testutil.Create(t, f.Employee(eng))
+	proj := testutil.Create(t, f.Project().Lead(ann).Members(ann, bob).Status(project.StatusPLANNED))
+	cid := testutil.Create(t, f.Employee(eng).OnLeave())
+	require.NoError(t, f.AddTeamMembers(ctx, proj, cid))
+	milestone := testutil.Create(t, f.Milestone(proj))
+	task := testutil.Create(t, f.Task(proj).Milestone(milestone).Assignee(bob))
+
+	// Assert
+	assert.Equal(t, "Engineering", eng.Name)
+	assert.Equal(t, eng.ID, *platform.ParentID)
+	assert.Equal(t, "Ann", ann.Name)
+	assert.NotEqual(t, ann.Email, bob.Email)
+	assert.Equal(t, employee.StatusACTIVE, bob.Status)
+	assert.Equal(t, employee.StatusON_LEAVE, cid.Status)
+	assert.Equal(t, project.StatusPLANNED, proj.Status)
+	assert.Equal(t, ann.ID, *proj.LeadID)
+
+	team := client.Project.GetX(ctx, proj.ID).QueryTeamMembers().IDsX(ctx)
+	assert.ElementsMatch(t, []uuid.UUID{ann.ID, bob.ID, cid.ID}, team)
+	assert.False(t, milestone.DueDate.Before(proj.StartDate))
+	assert.Equal(t, milestone.ID, *task.MilestoneID)
+	assert.Equal(t, bob.ID, *task.AssigneeID)
+	assert.Equal(t, 1, client.Task.Query().CountX(ctx))
+}
+
+func TestScenario_TenantsDoNotCollide(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClientWithDriver(t, testutil.NewTestEntDriver(t))
+
+	// Test: two tenants of one database seeded alike
+	acme := loadOrgInto(t, client, "acme", 7)
+	globex := loadOrgInto(t, client, "globex", 7)
+
+	// Assert: the same organization under other IDs
+	require.Len(t, globex.Employees, len(acme.Employees))
+	for i := range acme.Employees {
+		assert.NotEqual(t, acme.Employees[i].ID, globex.Employees[i].ID)
+	}
+	assert.NotEqual(t, acme.Departments[0].ID, globex.Departments[0].ID)
+}
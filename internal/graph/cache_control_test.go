@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupCachingServer wires /query the way cmd/graphql does with auth
+// disabled: HTTP caching around the server with the cache control extension,
+// and one department
+func setupCachingServer(t *testing.T) http.Handler {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	deptRepo := database.NewEntDepartmentRepo(client)
+	require.NoError(t, deptRepo.Save(auth.WithSystem(context.Background()), &model.Department{ID: uuid.NewString(), Name: "Engineering"}))
+	resolver := NewResolver(deptRepo, database.NewEntEmployeeRepo(client), database.NewEntProjectRepo(client))
+
+	srv := NewServer(NewExecutableSchema(Config{Resolvers: resolver}), false)
+	srv.Use(middleware.NewCacheControl())
+	return auth.Disabled()(middleware.HTTPCacheMiddleware("Authorization")(srv))
+}
+
+// getQuery sends query as a GET request with optional headers
+func getQuery(t *testing.T, h http.Handler, query string, headers map[string]string) (*httptest.ResponseRecorder, graphqlResponse) {
+	req := httptest.NewRequest(http.MethodGet, "/query?query="+url.QueryEscape(query), nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp graphqlResponse
+	if rec.Code != http.StatusNotModified {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec, resp
+}
+
+// cacheMaxAge returns extensions.cacheControl.maxAge
+func cacheMaxAge(t *testing.T, resp graphqlResponse) float64 {
+	policy, ok := resp.Extensions["cacheControl"].(map[string]any)
+	require.True(t, ok, "missing cacheControl extension")
+	return policy["maxAge"].(float64)
+}
+
+// TestCacheControl_PolicyFromHints tests that a query is cached for the
+// smallest hint of its fields, and not at all with unhinted objects
+func TestCacheControl_PolicyFromHints(t *testing.T) {
+	// Setup
+	h := setupCachingServer(t)
+
+	// Test
+	deptRec, deptResp := getQuery(t, h, `{ departments { id name } }`, nil)
+	nestedRec, nestedResp := getQuery(t, h, `{ departments { name employees { email } } }`, nil)
+	projRec, projResp := getQuery(t, h, `{ projects { id } }`, nil)
+
+	// Assert
+	require.Empty(t, deptResp.Errors)
+	assert.Equal(t, "private, max-age=60", deptRec.Header().Get("Cache-Control"))
+	assert.Equal(t, 60.0, cacheMaxAge(t, deptResp))
+	assert.NotEmpty(t, deptRec.Header().Get("ETag"))
+	assert.Equal(t, "Authorization", deptRec.Header().Get("Vary"))
+
+	require.Empty(t, nestedResp.Errors)
+	assert.Equal(t, "private, max-age=30", nestedRec.Header().Get("Cache-Control"))
+	assert.Equal(t, 30.0, cacheMaxAge(t, nestedResp))
+
+	require.Empty(t, projResp.Errors)
+	assert.Equal(t, "private, no-cache", projRec.Header().Get("Cache-Control"))
+	assert.Equal(t, 0.0, cacheMaxAge(t, projResp))
+}
+
+// TestCacheControl_NotModified tests ETag revalidation
+func TestCacheControl_NotModified(t *testing.T) {
+	// Setup
+	h := setupCachingServer(t)
+	first, _ := getQuery(t, h, `{ departments { name } }`, nil)
+	etag := first.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// Test
+	same, _ := getQuery(t, h, `{ departments { name } }`, map[string]string{"If-None-Match": etag})
+	weak, _ := getQuery(t, h, `{ departments { name } }`, map[string]string{"If-None-Match": `"other", W/` + etag})
+	changed, resp := getQuery(t, h, `{ departments { id name } }`, map[string]string{"If-None-Match": etag})
+
+	// Assert
+	assert.Equal(t, http.StatusNotModified, same.Code)
+	assert.Empty(t, same.Body.String())
+	assert.Equal(t, etag, same.Header().Get("ETag"))
+	assert.Equal(t, "private, max-age=60", same.Header().Get("Cache-Control"))
+	assert.Equal(t, http.StatusNotModified, weak.Code)
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+	assert.Len(t, resp.Data["departments"], 1)
+}
+
+// TestCacheControl_MutationsAreNotCacheable tests that POSTed mutations get
+// a zero policy and no caching headers
+func TestCacheControl_MutationsAreNotCacheable(t *testing.T) {
+	// Setup
+	h := setupCachingServer(t)
+
+	// Test
+	_, resp := postQuery(t, h, `mutation { createDepartment(input: { name: "Sales" }) { id } }`)
+
+	// Assert
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, 0.0, cacheMaxAge(t, resp))
+}
+
+// TestCacheControl_WebsocketUpgradePassesThrough tests that subscriptions can
+// still connect: upgrades must reach the websocket transport unbuffered
+func TestCacheControl_WebsocketUpgradePassesThrough(t *testing.T) {
+	// Setup
+	ts := httptest.NewServer(setupCachingServer(t))
+	defer ts.Close()
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+
+	// Test
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/query", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.WriteJSON(map[string]string{"type": "connection_init"}))
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var ack map[string]any
+	require.NoError(t, conn.ReadJSON(&ack))
+
+	// Assert
+	assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("ETag"))
+	assert.Equal(t, "connection_ack", ack["type"])
+}
@@ -6,11 +6,28 @@ package graph
 
 import (
 	"context"
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/health"
+	"time"
 )
 
 // Health is the resolver for the health field.
-func (r *queryResolver) Health(ctx context.Context) (string, error) {
-	return "ok", nil
+func (r *queryResolver) Health(ctx context.Context) (*model.HealthStatus, error) {
+	report := health.Report{
+		Status:    health.StatusOK,
+		Timestamp: time.Now().UTC(),
+	}
+	if r.HealthChecker != nil {
+		report = r.HealthChecker.Run(ctx)
+	}
+
+	// Messages hold raw dependency errors; the query is open to anonymous callers
+	if !auth.FromContext(ctx).HasRole(auth.RoleAdmin) {
+		report = report.Redacted()
+	}
+
+	return toGraphQLHealthStatus(report), nil
 }
 
 // Mutation returns MutationResolver implementation.
@@ -3,8 +3,12 @@ package graph
 import (
 	"context"
 	"testing"
+	"time"
 
+	"gin-crud-api/internal/auth"
 	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/health"
 	"gin-crud-api/internal/middleware"
 	"gin-crud-api/internal/testutil"
 
@@ -31,7 +35,63 @@ func TestHealth(t *testing.T) {
 	// Call health check
 	result, err := resolver.Query().Health(ctx)
 
-	// Assert success
+	// Assert success: without a checker the service reports OK and no checks
 	require.NoError(t, err)
-	assert.Equal(t, "ok", result)
+	assert.Equal(t, model.HealthStateOk, result.Status)
+	assert.Empty(t, result.Checks)
+	assert.NotEmpty(t, result.Timestamp)
+}
+
+func TestHealth_WithChecks(t *testing.T) {
+	client := testutil.NewTestEntClient(t)
+	defer client.Close()
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	resolver.HealthChecker = health.NewChecker(time.Second, health.NewMigrationCheck(client))
+
+	result, err := resolver.Query().Health(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, model.HealthStateOk, result.Status)
+	require.Len(t, result.Checks, 1)
+	assert.Equal(t, "migrations", result.Checks[0].Name)
+	assert.Equal(t, model.HealthStateOk, result.Checks[0].Status)
+	assert.Nil(t, result.Checks[0].Message)
+}
+
+// failingCheck reports DOWN with a raw dependency error
+type failingCheck struct{}
+
+func (failingCheck) Name() string { return "database" }
+
+func (failingCheck) Check(ctx context.Context) health.CheckResult {
+	return health.CheckResult{Status: health.StatusDown, Message: "dial tcp 10.0.0.5:5432: connection refused"}
+}
+
+// TestHealth_MessagesForAdminsOnly tests that check messages are hidden from non-admins
+func TestHealth_MessagesForAdminsOnly(t *testing.T) {
+	// Setup
+	resolver := &Resolver{HealthChecker: health.NewChecker(time.Second, failingCheck{})}
+	admin := auth.WithPrincipal(context.Background(), &auth.Principal{ID: "ops", Roles: []string{auth.RoleAdmin}})
+	manager := auth.WithPrincipal(context.Background(), &auth.Principal{ID: "m", Roles: []string{auth.RoleManager}})
+
+	for name, ctx := range map[string]context.Context{"anonymous": context.Background(), "manager": manager} {
+		// Test
+		result, err := resolver.Query().Health(ctx)
+
+		// Assert: the status is shown, the error is not
+		require.NoError(t, err, name)
+		assert.Equal(t, model.HealthStateDown, result.Status, name)
+		require.Len(t, result.Checks, 1, name)
+		assert.Nil(t, result.Checks[0].Message, name)
+	}
+
+	result, err := resolver.Query().Health(admin)
+	require.NoError(t, err)
+	require.NotNil(t, result.Checks[0].Message)
+	assert.Contains(t, *result.Checks[0].Message, "connection refused")
 }
@@ -0,0 +1,73 @@
+package graph
+
+import "gin-crud-api/internal/graph/model"
+
+// ============================================================================
+// Query Complexity
+// ============================================================================
+
+// NewComplexityRoot builds per-field cost functions for the complexity limit.
+// Scalar fields keep gqlgen's default cost of 1. List fields multiply the cost
+// of their selection by listCost, the assumed number of items a list returns,
+// so nested lists such as departments { employees { projects } } grow quickly.
+func NewComplexityRoot(listCost int) ComplexityRoot {
+	if listCost < 1 {
+		listCost = 1
+	}
+
+	list := func(childComplexity int) int {
+		return 1 + childComplexity*listCost
+	}
+
+	var c ComplexityRoot
+
+	// Nested list fields
+	c.Department.Employees = list
+	c.Employee.Projects = list
+	c.Project.TeamMembers = list
+	c.Project.Milestones = list
+	c.Project.Tasks = list
+	c.Project.StatusHistory = list
+	c.Milestone.Tasks = list
+	c.Employee.Skills = list
+	c.Project.RequiredSkills = list
+	c.TeamMemberSuggestion.MatchedSkills = list
+	c.TeamMemberSuggestion.MissingSkills = list
+	c.Workload.Employees = list
+	c.Workload.Departments = list
+	c.EmployeeWorkload.Projects = list
+
+	// Root list queries
+	c.Query.Departments = list
+	c.Query.Employees = list
+	c.Query.Projects = list
+	c.Query.Skills = list
+	c.Query.WebhookSubscriptions = list
+	c.Query.EmployeesByDepartment = func(childComplexity int, departmentID string) int {
+		return list(childComplexity)
+	}
+	c.Query.ProjectsByEmployee = func(childComplexity int, employeeID string) int {
+		return list(childComplexity)
+	}
+	c.Query.ProjectsByStatus = func(childComplexity int, status model.ProjectStatus) int {
+		return list(childComplexity)
+	}
+
+	// Bounded by first instead of listCost
+	c.Query.SuggestTeamMembers = func(childComplexity int, projectID string, first *int) int {
+		n := 10
+		if first != nil && *first > 0 {
+			n = *first
+		}
+		return 1 + childComplexity*n
+	}
+	c.WebhookSubscription.Deliveries = func(childComplexity int, status *model.WebhookDeliveryStatus, first *int) int {
+		n := 20
+		if first != nil && *first > 0 {
+			n = *first
+		}
+		return 1 + childComplexity*n
+	}
+
+	return c
+}
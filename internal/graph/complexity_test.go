@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type graphqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+	Extensions map[string]any `json:"extensions"`
+}
+
+func setupLimitedServer(t *testing.T, maxDepth, maxComplexity, listCost int) *handler.Server {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  resolver,
+		Complexity: NewComplexityRoot(listCost),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(middleware.FixedDepthLimit(maxDepth))
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	srv.AroundOperations(middleware.LoggingMiddleware())
+	srv.AroundResponses(middleware.RejectionLoggingMiddleware())
+
+	return srv
+}
+
+func postQuery(t *testing.T, srv http.Handler, query string) (int, graphqlResponse) {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	auth.Disabled()(srv).ServeHTTP(rec, req)
+
+	var resp graphqlResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return rec.Code, resp
+}
+
+// TestQueryLimits_AllowsShallowQuery tests that ordinary queries are executed
+func TestQueryLimits_AllowsShallowQuery(t *testing.T) {
+	srv := setupLimitedServer(t, 5, 1000, 10)
+
+	status, resp := postQuery(t, srv, `{ departments { id name employees { id } } }`)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, resp.Errors)
+	assert.Contains(t, resp.Data, "departments")
+}
+
+// TestQueryLimits_RejectsDeepQuery tests that nesting beyond max depth is rejected
+func TestQueryLimits_RejectsDeepQuery(t *testing.T) {
+	srv := setupLimitedServer(t, 4, 1000000, 1)
+
+	query := `{ departments { employees { projects { teamMembers { projects { id } } } } } }`
+	status, resp := postQuery(t, srv, query)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, middleware.ErrCodeDepthLimit, resp.Errors[0].Extensions["code"])
+	assert.Contains(t, resp.Errors[0].Message, "depth 6")
+	assert.Contains(t, resp.Errors[0].Message, "limit of 4")
+}
+
+// TestQueryLimits_DepthIgnoresFragments tests that fragments do not add depth of their own
+func TestQueryLimits_DepthIgnoresFragments(t *testing.T) {
+	srv := setupLimitedServer(t, 4, 1000000, 1)
+
+	query := `
+		query { departments { ...deptFields } }
+		fragment deptFields on Department { employees { ... on Employee { projects { id } } } }
+	`
+	status, resp := postQuery(t, srv, query)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestQueryLimits_RejectsComplexQuery tests that nested list fields are priced by list cost
+func TestQueryLimits_RejectsComplexQuery(t *testing.T) {
+	srv := setupLimitedServer(t, 100, 1000, 10)
+
+	query := `{ departments { employees { projects { teamMembers { id } } } } }`
+	status, resp := postQuery(t, srv, query)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, status)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, middleware.ErrCodeComplexityLimit, resp.Errors[0].Extensions["code"])
+	assert.Contains(t, resp.Errors[0].Message, "exceeds the limit of 1000")
+}
+
+// TestQueryLimits_AllowsIntrospection tests that the Playground schema query is not rejected
+func TestQueryLimits_AllowsIntrospection(t *testing.T) {
+	srv := setupLimitedServer(t, 3, 1000, 10)
+
+	query := `{ __schema { types { name fields { name type { name ofType { name ofType { name } } } } } } }`
+	status, resp := postQuery(t, srv, query)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestNewComplexityRoot tests the per-field cost functions
+func TestNewComplexityRoot(t *testing.T) {
+	c := NewComplexityRoot(10)
+
+	// List fields multiply the cost of their selection
+	assert.Equal(t, 21, c.Query.Departments(2))
+	assert.Equal(t, 21, c.Department.Employees(2))
+	assert.Equal(t, 21, c.Query.EmployeesByDepartment(2, "dept"))
+
+	// Scalar fields fall back to gqlgen's default cost
+	assert.Nil(t, c.Department.Name)
+
+	// List cost below one is treated as one
+	assert.Equal(t, 3, NewComplexityRoot(0).Employee.Projects(2))
+}
+
+// TestQueryLimits_RejectionLogUsesRequestID tests that the rejection is logged
+// with the ID returned to the client in X-Request-ID
+func TestQueryLimits_RejectionLogUsesRequestID(t *testing.T) {
+	// Setup: capture the log output
+	prev := logger.Logger
+	t.Cleanup(func() { logger.Logger = prev })
+	var buf bytes.Buffer
+	logger.InitWithWriter("info", false, &buf)
+	srv := middleware.RequestIDMiddleware()(setupLimitedServer(t, 4, 1000000, 1))
+
+	// Test
+	body := `{"query":"{ departments { employees { projects { teamMembers { projects { id } } } } } }"}`
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+
+	// Assert
+	requestID := rec.Header().Get(middleware.RequestIDHeader)
+	require.NotEmpty(t, requestID)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Contains(t, buf.String(), `"request_id":"`+requestID+`"`)
+}
+
+// TestLogging_MutationDocumentsAreNotLogged tests that inline PII literals of
+// mutations stay out of the log, which only gets the document hash
+func TestLogging_MutationDocumentsAreNotLogged(t *testing.T) {
+	// Setup: capture the log output
+	prev := logger.Logger
+	t.Cleanup(func() { logger.Logger = prev })
+	var buf bytes.Buffer
+	logger.InitWithWriter("info", false, &buf)
+	srv := setupLimitedServer(t, 10, 1000000, 1)
+
+	// Test
+	postQuery(t, srv, `mutation { createEmployee(input: {name: "Ann", email: "ann@example.com", departmentID: "`+uuid.NewString()+`", phone: "+1 555 0100"}) { id } }`)
+	postQuery(t, srv, `{ departments { id } }`)
+
+	// Assert
+	assert.NotContains(t, buf.String(), "555 0100")
+	assert.Contains(t, buf.String(), `"document_hash":"`)
+	assert.Contains(t, buf.String(), `"query":"{ departments { id } }"`)
+}
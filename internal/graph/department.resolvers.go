@@ -16,33 +16,62 @@ import (
 	"github.com/google/uuid"
 )
 
+// Parent is the resolver for the parent field.
+func (r *departmentResolver) Parent(ctx context.Context, obj *model.Department) (*model.Department, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+
+	parent, err := r.DeptRepo.FindByID(ctx, *obj.ParentID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find parent department: %w", err)
+	}
+	return parent, nil
+}
+
 // CreateDepartment is the resolver for the createDepartment field.
 func (r *mutationResolver) CreateDepartment(ctx context.Context, input model.CreateDepartmentInput) (*model.Department, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "createDepartment").
 		Str("name", input.Name).
 		Msg("Creating department")
 
-	// Validate input
-	if input.Name == "" {
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("department", "input", input); err != nil {
 		log.Error().
+			Err(err).
 			Str("operation", "createDepartment").
-			Msg("Validation failed: department name is required")
-		return nil, errors.New("department name is required")
+			Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	// Parent must exist
+	if input.ParentID != nil && *input.ParentID != "" {
+		if err := r.checkDepartmentParent(ctx, "", *input.ParentID); err != nil {
+			log.Error().
+				Err(err).
+				Str("operation", "createDepartment").
+				Msg("Invalid parent department")
+			return nil, err
+		}
 	}
 
 	// Create GraphQL model
 	dept := &model.Department{
-		ID:   uuid.New().String(),
-		Name: input.Name,
+		ID:       uuid.New().String(),
+		Name:     input.Name,
+		ParentID: clearableID(nil, input.ParentID),
 	}
 
 	// Save to repository
-	if err := r.DeptRepo.Save(dept); err != nil {
+	if err := r.DeptRepo.Save(ctx, dept); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "createDepartment").
@@ -64,7 +93,7 @@ func (r *mutationResolver) CreateDepartment(ctx context.Context, input model.Cre
 func (r *mutationResolver) UpdateDepartment(ctx context.Context, id string, input model.UpdateDepartmentInput) (*model.Department, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "updateDepartment").
@@ -72,17 +101,18 @@ func (r *mutationResolver) UpdateDepartment(ctx context.Context, id string, inpu
 		Str("new_name", input.Name).
 		Msg("Updating department")
 
-	// Validate input
-	if input.Name == "" {
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("department", "input", input); err != nil {
 		log.Error().
+			Err(err).
 			Str("operation", "updateDepartment").
 			Str("department_id", id).
-			Msg("Validation failed: department name is required")
-		return nil, errors.New("department name is required")
+			Msg("Validation failed")
+		return nil, validationError(err)
 	}
 
 	// Check if department exists
-	existing, err := r.DeptRepo.FindByID(id)
+	existing, err := r.DeptRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -99,11 +129,24 @@ func (r *mutationResolver) UpdateDepartment(ctx context.Context, id string, inpu
 		return nil, fmt.Errorf("failed to find department: %w", err)
 	}
 
+	// Parent must exist and not be below the department; an empty ID makes it top-level
+	if input.ParentID != nil && *input.ParentID != "" {
+		if err := r.checkDepartmentParent(ctx, id, *input.ParentID); err != nil {
+			log.Error().
+				Err(err).
+				Str("operation", "updateDepartment").
+				Str("department_id", id).
+				Msg("Invalid parent department")
+			return nil, err
+		}
+	}
+
 	// Update GraphQL model
 	existing.Name = input.Name
+	existing.ParentID = clearableID(existing.ParentID, input.ParentID)
 
 	// Save to repository
-	if err := r.DeptRepo.Update(existing); err != nil {
+	if err := r.DeptRepo.Update(ctx, existing); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "updateDepartment").
@@ -122,10 +165,10 @@ func (r *mutationResolver) UpdateDepartment(ctx context.Context, id string, inpu
 }
 
 // DeleteDepartment is the resolver for the deleteDepartment field.
-func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (bool, error) {
+func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string, clientMutationID *string) (bool, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "deleteDepartment").
@@ -133,7 +176,7 @@ func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (boo
 		Msg("Deleting department with cascade")
 
 	// Check if department exists
-	_, err := r.DeptRepo.FindByID(id)
+	_, err := r.DeptRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -151,7 +194,7 @@ func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (boo
 	}
 
 	// Cascade delete: delete all employees in this department
-	employees, err := r.EmpRepo.FindByDepartmentID(id)
+	employees, err := r.EmpRepo.FindByDepartmentID(ctx, id)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -168,7 +211,7 @@ func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (boo
 		Msg("Cascade deleting employees")
 
 	for _, emp := range employees {
-		if err := r.EmpRepo.Delete(emp.ID); err != nil {
+		if err := r.EmpRepo.Delete(ctx, emp.ID); err != nil {
 			log.Error().
 				Err(err).
 				Str("operation", "deleteDepartment").
@@ -180,7 +223,7 @@ func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (boo
 	}
 
 	// Delete the department
-	if err := r.DeptRepo.Delete(id); err != nil {
+	if err := r.DeptRepo.Delete(ctx, id); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "deleteDepartment").
@@ -202,14 +245,14 @@ func (r *mutationResolver) DeleteDepartment(ctx context.Context, id string) (boo
 func (r *queryResolver) Department(ctx context.Context, id string) (*model.Department, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "department").
 		Str("department_id", id).
 		Msg("Fetching department")
 
-	dept, err := r.DeptRepo.FindByID(id)
+	dept, err := r.DeptRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Debug().
@@ -242,13 +285,13 @@ func (r *queryResolver) Department(ctx context.Context, id string) (*model.Depar
 func (r *queryResolver) Departments(ctx context.Context) ([]*model.Department, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "departments").
 		Msg("Fetching all departments")
 
-	depts, err := r.DeptRepo.FindAll()
+	depts, err := r.DeptRepo.FindAll(ctx)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -272,3 +315,8 @@ func (r *queryResolver) Departments(ctx context.Context) ([]*model.Department, e
 
 	return result, nil
 }
+
+// Department returns DepartmentResolver implementation.
+func (r *Resolver) Department() DepartmentResolver { return &departmentResolver{r} }
+
+type departmentResolver struct{ *Resolver }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"gin-crud-api/internal/auth"
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/middleware"
@@ -28,7 +29,7 @@ func setupDepartmentResolverTest(t *testing.T) (*Resolver, context.Context) {
 	resolver := NewResolver(deptRepo, empRepo, projRepo)
 
 	// Create context with request ID (for logging)
-	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
 
 	return resolver, ctx
 }
@@ -137,7 +138,7 @@ func TestDeleteDepartment_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete department
-	success, err := resolver.Mutation().DeleteDepartment(ctx, created.ID)
+	success, err := resolver.Mutation().DeleteDepartment(ctx, created.ID, nil)
 
 	// Assert success
 	require.NoError(t, err)
@@ -156,7 +157,7 @@ func TestDeleteDepartment_NotFound(t *testing.T) {
 	nonExistentID := uuid.New().String()
 
 	// Attempt to delete non-existent department
-	success, err := resolver.Mutation().DeleteDepartment(ctx, nonExistentID)
+	success, err := resolver.Mutation().DeleteDepartment(ctx, nonExistentID, nil)
 
 	// Assert not found error
 	require.Error(t, err)
@@ -183,7 +184,7 @@ func TestDeleteDepartment_WithEmployees(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete department (should cascade delete employees)
-	success, err := resolver.Mutation().DeleteDepartment(ctx, dept.ID)
+	success, err := resolver.Mutation().DeleteDepartment(ctx, dept.ID, nil)
 
 	// Assert success
 	require.NoError(t, err)
@@ -326,3 +327,40 @@ func TestEmployeesByDepartment_Empty(t *testing.T) {
 	require.NotNil(t, employees)
 	assert.Empty(t, employees)
 }
+
+// TestUpdateDepartment_ParentCycle tests that a department cannot be moved below itself
+func TestUpdateDepartment_ParentCycle(t *testing.T) {
+	resolver, ctx := setupDepartmentResolverTest(t)
+
+	engineering, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Engineering"})
+	require.NoError(t, err)
+	platform, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Platform", ParentID: &engineering.ID})
+	require.NoError(t, err)
+
+	// Move Engineering below its own child
+	_, err = resolver.Mutation().UpdateDepartment(ctx, engineering.ID, model.UpdateDepartmentInput{Name: "Engineering", ParentID: &platform.ID})
+
+	// Assert rejected, and the parent resolves
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "below itself")
+	parent, err := resolver.Department().Parent(ctx, platform)
+	require.NoError(t, err)
+	assert.Equal(t, engineering.ID, parent.ID)
+
+	// An empty parent ID makes the department top-level
+	empty := ""
+	updated, err := resolver.Mutation().UpdateDepartment(ctx, platform.ID, model.UpdateDepartmentInput{Name: "Platform", ParentID: &empty})
+	require.NoError(t, err)
+	assert.Nil(t, updated.ParentID)
+}
+
+// TestCreateDepartment_ParentNotFound tests validation of the parent department
+func TestCreateDepartment_ParentNotFound(t *testing.T) {
+	resolver, ctx := setupDepartmentResolverTest(t)
+	missing := uuid.NewString()
+
+	_, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Platform", ParentID: &missing})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
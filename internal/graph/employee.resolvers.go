@@ -19,14 +19,14 @@ import (
 // Projects is the resolver for the projects field.
 func (r *employeeResolver) Projects(ctx context.Context, obj *model.Employee) ([]*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Debug().
 		Str("operation", "employee.projects").
 		Str("employee_id", obj.ID).
 		Msg("Fetching projects for employee")
 
-	projects, err := r.ProjRepo.FindByEmployeeID(obj.ID)
+	projects, err := r.ProjRepo.FindByEmployeeID(ctx, obj.ID)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -47,44 +47,25 @@ func (r *employeeResolver) Projects(ctx context.Context, obj *model.Employee) ([
 func (r *mutationResolver) CreateEmployee(ctx context.Context, input model.CreateEmployeeInput) (*model.Employee, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "createEmployee").
 		Str("name", input.Name).
-		Str("email", input.Email).
 		Str("department_id", input.DepartmentID).
 		Msg("Creating employee")
 
-	// Validate input
-	if input.Name == "" {
-		log.Error().
-			Str("operation", "createEmployee").
-			Msg("Validation failed: employee name is required")
-		return nil, errors.New("employee name is required")
-	}
-	if input.Email == "" {
-		log.Error().
-			Str("operation", "createEmployee").
-			Msg("Validation failed: employee email is required")
-		return nil, errors.New("employee email is required")
-	}
-	if !isValidEmail(input.Email) {
-		log.Error().
-			Str("operation", "createEmployee").
-			Str("email", input.Email).
-			Msg("Validation failed: invalid email format")
-		return nil, errors.New("invalid email format")
-	}
-	if input.DepartmentID == "" {
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("employee", "input", input); err != nil {
 		log.Error().
+			Err(err).
 			Str("operation", "createEmployee").
-			Msg("Validation failed: department ID is required")
-		return nil, errors.New("department ID is required")
+			Msg("Validation failed")
+		return nil, validationError(err)
 	}
 
 	// Verify department exists
-	_, err := r.DeptRepo.FindByID(input.DepartmentID)
+	_, err := r.DeptRepo.FindByID(ctx, input.DepartmentID)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -101,16 +82,35 @@ func (r *mutationResolver) CreateEmployee(ctx context.Context, input model.Creat
 		return nil, fmt.Errorf("failed to verify department: %w", err)
 	}
 
+	// Validate and normalize the hire date
+	hireDate, err := normalizeDate(input.HireDate)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid hire date format")
+		return nil, fmt.Errorf("invalid hire date format (use YYYY-MM-DD): %w", err)
+	}
+
+	// Set default values for optional fields
+	employmentType := model.EmploymentTypeFullTime
+	if input.EmploymentType != nil {
+		employmentType = *input.EmploymentType
+	}
+
 	// Create GraphQL model
 	emp := &model.Employee{
-		ID:           uuid.New().String(),
-		Name:         input.Name,
-		Email:        input.Email,
-		DepartmentID: input.DepartmentID,
+		ID:             uuid.New().String(),
+		Name:           input.Name,
+		Email:          input.Email,
+		DepartmentID:   input.DepartmentID,
+		JobTitle:       input.JobTitle,
+		EmploymentType: employmentType,
+		Status:         model.EmploymentStatusActive,
+		HireDate:       hireDate,
+		Phone:          input.Phone,
+		Location:       input.Location,
 	}
 
 	// Save to repository
-	if err := r.EmpRepo.Save(emp); err != nil {
+	if err := r.EmpRepo.Save(ctx, emp); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "createEmployee").
@@ -123,65 +123,37 @@ func (r *mutationResolver) CreateEmployee(ctx context.Context, input model.Creat
 		Str("operation", "createEmployee").
 		Str("employee_id", emp.ID).
 		Str("name", emp.Name).
-		Str("email", emp.Email).
 		Str("department_id", emp.DepartmentID).
 		Msg("Employee created successfully")
 
-	return &model.Employee{
-		ID:           emp.ID,
-		Name:         emp.Name,
-		Email:        emp.Email,
-		DepartmentID: emp.DepartmentID,
-	}, nil
+	return emp, nil
 }
 
 // UpdateEmployee is the resolver for the updateEmployee field.
 func (r *mutationResolver) UpdateEmployee(ctx context.Context, id string, input model.UpdateEmployeeInput) (*model.Employee, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "updateEmployee").
 		Str("employee_id", id).
 		Str("new_name", input.Name).
-		Str("new_email", input.Email).
 		Str("new_department_id", input.DepartmentID).
 		Msg("Updating employee")
 
-	// Validate input
-	if input.Name == "" {
-		log.Error().
-			Str("operation", "updateEmployee").
-			Str("employee_id", id).
-			Msg("Validation failed: employee name is required")
-		return nil, errors.New("employee name is required")
-	}
-	if input.Email == "" {
-		log.Error().
-			Str("operation", "updateEmployee").
-			Str("employee_id", id).
-			Msg("Validation failed: employee email is required")
-		return nil, errors.New("employee email is required")
-	}
-	if !isValidEmail(input.Email) {
-		log.Error().
-			Str("operation", "updateEmployee").
-			Str("employee_id", id).
-			Str("email", input.Email).
-			Msg("Validation failed: invalid email format")
-		return nil, errors.New("invalid email format")
-	}
-	if input.DepartmentID == "" {
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("employee", "input", input); err != nil {
 		log.Error().
+			Err(err).
 			Str("operation", "updateEmployee").
 			Str("employee_id", id).
-			Msg("Validation failed: department ID is required")
-		return nil, errors.New("department ID is required")
+			Msg("Validation failed")
+		return nil, validationError(err)
 	}
 
 	// Check if employee exists
-	existing, err := r.EmpRepo.FindByID(id)
+	existing, err := r.EmpRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -199,7 +171,7 @@ func (r *mutationResolver) UpdateEmployee(ctx context.Context, id string, input
 	}
 
 	// Verify department exists
-	_, err = r.DeptRepo.FindByID(input.DepartmentID)
+	_, err = r.DeptRepo.FindByID(ctx, input.DepartmentID)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -218,13 +190,48 @@ func (r *mutationResolver) UpdateEmployee(ctx context.Context, id string, input
 		return nil, fmt.Errorf("failed to verify department: %w", err)
 	}
 
+	// Terminations go through terminateEmployee and rehireEmployee
+	if input.Status != nil {
+		if *input.Status == model.EmploymentStatusTerminated {
+			return nil, errors.New("use terminateEmployee to terminate an employee")
+		}
+		if existing.Status == model.EmploymentStatusTerminated {
+			return nil, errors.New("cannot change the status of a terminated employee, use rehireEmployee")
+		}
+	}
+
+	hireDate, err := normalizeDate(input.HireDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hire date format (use YYYY-MM-DD): %w", err)
+	}
+
 	// Update GraphQL model
 	existing.Name = input.Name
 	existing.Email = input.Email
 	existing.DepartmentID = input.DepartmentID
 
+	// Update optional fields only if provided
+	if input.JobTitle != nil {
+		existing.JobTitle = input.JobTitle
+	}
+	if input.EmploymentType != nil {
+		existing.EmploymentType = *input.EmploymentType
+	}
+	if input.Status != nil {
+		existing.Status = *input.Status
+	}
+	if hireDate != nil {
+		existing.HireDate = hireDate
+	}
+	if input.Phone != nil {
+		existing.Phone = input.Phone
+	}
+	if input.Location != nil {
+		existing.Location = input.Location
+	}
+
 	// Save to repository
-	if err := r.EmpRepo.Update(existing); err != nil {
+	if err := r.EmpRepo.Update(ctx, existing); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "updateEmployee").
@@ -237,23 +244,17 @@ func (r *mutationResolver) UpdateEmployee(ctx context.Context, id string, input
 		Str("operation", "updateEmployee").
 		Str("employee_id", existing.ID).
 		Str("name", existing.Name).
-		Str("email", existing.Email).
 		Str("department_id", existing.DepartmentID).
 		Msg("Employee updated successfully")
 
-	return &model.Employee{
-		ID:           existing.ID,
-		Name:         existing.Name,
-		Email:        existing.Email,
-		DepartmentID: existing.DepartmentID,
-	}, nil
+	return existing, nil
 }
 
 // DeleteEmployee is the resolver for the deleteEmployee field.
-func (r *mutationResolver) DeleteEmployee(ctx context.Context, id string) (bool, error) {
+func (r *mutationResolver) DeleteEmployee(ctx context.Context, id string, clientMutationID *string) (bool, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "deleteEmployee").
@@ -261,7 +262,7 @@ func (r *mutationResolver) DeleteEmployee(ctx context.Context, id string) (bool,
 		Msg("Deleting employee")
 
 	// Check if employee exists
-	_, err := r.EmpRepo.FindByID(id)
+	_, err := r.EmpRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Warn().
@@ -279,7 +280,7 @@ func (r *mutationResolver) DeleteEmployee(ctx context.Context, id string) (bool,
 	}
 
 	// Delete the employee
-	if err := r.EmpRepo.Delete(id); err != nil {
+	if err := r.EmpRepo.Delete(ctx, id); err != nil {
 		log.Error().
 			Err(err).
 			Str("operation", "deleteEmployee").
@@ -296,18 +297,121 @@ func (r *mutationResolver) DeleteEmployee(ctx context.Context, id string) (bool,
 	return true, nil
 }
 
+// TerminateEmployee is the resolver for the terminateEmployee field.
+func (r *mutationResolver) TerminateEmployee(ctx context.Context, id string, terminationDate *string) (*model.Employee, error) {
+	// Get logger with request ID
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "terminateEmployee").
+		Str("employee_id", id).
+		Msg("Terminating employee")
+
+	date, err := dateOrToday(terminationDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid termination date format (use YYYY-MM-DD): %w", err)
+	}
+
+	// Check if employee exists
+	existing, err := r.EmpRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			log.Warn().
+				Str("operation", "terminateEmployee").
+				Str("employee_id", id).
+				Msg("Employee not found")
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, fmt.Errorf("failed to find employee: %w", err)
+	}
+
+	if existing.Status == model.EmploymentStatusTerminated {
+		return nil, errors.New("employee is already terminated")
+	}
+	if existing.HireDate != nil && date.Format("2006-01-02") < *existing.HireDate {
+		return nil, errors.New("termination date must not be before the hire date")
+	}
+
+	if err := r.EmpRepo.Terminate(ctx, id, date); err != nil {
+		log.Error().
+			Err(err).
+			Str("operation", "terminateEmployee").
+			Str("employee_id", id).
+			Msg("Failed to terminate employee")
+		return nil, fmt.Errorf("failed to terminate employee: %w", err)
+	}
+
+	log.Info().
+		Str("operation", "terminateEmployee").
+		Str("employee_id", id).
+		Msg("Employee terminated successfully")
+
+	return r.EmpRepo.FindByID(ctx, id)
+}
+
+// RehireEmployee is the resolver for the rehireEmployee field.
+func (r *mutationResolver) RehireEmployee(ctx context.Context, id string, hireDate *string) (*model.Employee, error) {
+	// Get logger with request ID
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "rehireEmployee").
+		Str("employee_id", id).
+		Msg("Rehiring employee")
+
+	date, err := dateOrToday(hireDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hire date format (use YYYY-MM-DD): %w", err)
+	}
+
+	// Check if employee exists
+	existing, err := r.EmpRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			log.Warn().
+				Str("operation", "rehireEmployee").
+				Str("employee_id", id).
+				Msg("Employee not found")
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, fmt.Errorf("failed to find employee: %w", err)
+	}
+
+	if existing.Status != model.EmploymentStatusTerminated {
+		return nil, errors.New("only terminated employees can be rehired")
+	}
+
+	if err := r.EmpRepo.Rehire(ctx, id, date); err != nil {
+		log.Error().
+			Err(err).
+			Str("operation", "rehireEmployee").
+			Str("employee_id", id).
+			Msg("Failed to rehire employee")
+		return nil, fmt.Errorf("failed to rehire employee: %w", err)
+	}
+
+	log.Info().
+		Str("operation", "rehireEmployee").
+		Str("employee_id", id).
+		Msg("Employee rehired successfully")
+
+	return r.EmpRepo.FindByID(ctx, id)
+}
+
 // Employee is the resolver for the employee field.
 func (r *queryResolver) Employee(ctx context.Context, id string) (*model.Employee, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "employee").
 		Str("employee_id", id).
 		Msg("Fetching employee")
 
-	emp, err := r.EmpRepo.FindByID(id)
+	emp, err := r.EmpRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Debug().
@@ -330,25 +434,20 @@ func (r *queryResolver) Employee(ctx context.Context, id string) (*model.Employe
 		Str("name", emp.Name).
 		Msg("Employee found")
 
-	return &model.Employee{
-		ID:           emp.ID,
-		Name:         emp.Name,
-		Email:        emp.Email,
-		DepartmentID: emp.DepartmentID,
-	}, nil
+	return emp, nil
 }
 
 // Employees is the resolver for the employees field.
 func (r *queryResolver) Employees(ctx context.Context) ([]*model.Employee, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "employees").
 		Msg("Fetching all employees")
 
-	emps, err := r.EmpRepo.FindAll()
+	emps, err := r.EmpRepo.FindAll(ctx)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -357,14 +456,9 @@ func (r *queryResolver) Employees(ctx context.Context) ([]*model.Employee, error
 		return nil, fmt.Errorf("failed to fetch employees: %w", err)
 	}
 
-	result := make([]*model.Employee, len(emps))
-	for i, emp := range emps {
-		result[i] = &model.Employee{
-			ID:           emp.ID,
-			Name:         emp.Name,
-			Email:        emp.Email,
-			DepartmentID: emp.DepartmentID,
-		}
+	result := emps
+	if result == nil {
+		result = []*model.Employee{}
 	}
 
 	log.Debug().
@@ -379,14 +473,14 @@ func (r *queryResolver) Employees(ctx context.Context) ([]*model.Employee, error
 func (r *queryResolver) EmployeesByDepartment(ctx context.Context, departmentID string) ([]*model.Employee, error) {
 	// Get logger with request ID
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "employeesByDepartment").
 		Str("department_id", departmentID).
 		Msg("Fetching employees by department")
 
-	emps, err := r.EmpRepo.FindByDepartmentID(departmentID)
+	emps, err := r.EmpRepo.FindByDepartmentID(ctx, departmentID)
 	if err != nil {
 		log.Error().
 			Err(err).
@@ -396,14 +490,9 @@ func (r *queryResolver) EmployeesByDepartment(ctx context.Context, departmentID
 		return nil, fmt.Errorf("failed to fetch employees by department: %w", err)
 	}
 
-	result := make([]*model.Employee, len(emps))
-	for i, emp := range emps {
-		result[i] = &model.Employee{
-			ID:           emp.ID,
-			Name:         emp.Name,
-			Email:        emp.Email,
-			DepartmentID: emp.DepartmentID,
-		}
+	result := emps
+	if result == nil {
+		result = []*model.Employee{}
 	}
 
 	log.Debug().
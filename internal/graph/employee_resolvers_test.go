@@ -2,16 +2,21 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/middleware"
 	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/validation"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func setupEmployeeResolverTest(t *testing.T) (*Resolver, context.Context, *model.Department) {
@@ -28,7 +33,7 @@ func setupEmployeeResolverTest(t *testing.T) (*Resolver, context.Context, *model
 	resolver := NewResolver(deptRepo, empRepo, projRepo)
 
 	// Create context with request ID (for logging)
-	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
 
 	// Create a test department for employees
 	deptInput := model.CreateDepartmentInput{Name: "Engineering"}
@@ -311,7 +316,7 @@ func TestDeleteEmployee_Success(t *testing.T) {
 	require.NoError(t, err)
 
 	// Delete employee
-	success, err := resolver.Mutation().DeleteEmployee(ctx, created.ID)
+	success, err := resolver.Mutation().DeleteEmployee(ctx, created.ID, nil)
 
 	// Assert success
 	require.NoError(t, err)
@@ -330,7 +335,7 @@ func TestDeleteEmployee_NotFound(t *testing.T) {
 	nonExistentID := uuid.New().String()
 
 	// Attempt to delete non-existent employee
-	success, err := resolver.Mutation().DeleteEmployee(ctx, nonExistentID)
+	success, err := resolver.Mutation().DeleteEmployee(ctx, nonExistentID, nil)
 
 	// Assert not found error
 	require.Error(t, err)
@@ -425,7 +430,7 @@ func TestEmployeesQuery_Empty(t *testing.T) {
 	empRepo := database.NewEntEmployeeRepo(client)
 	projRepo := database.NewEntProjectRepo(client)
 	resolver := NewResolver(deptRepo, empRepo, projRepo)
-	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, "test-request-id")
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
 
 	// Query all employees (empty database)
 	employees, err := resolver.Query().Employees(ctx)
@@ -435,3 +440,233 @@ func TestEmployeesQuery_Empty(t *testing.T) {
 	require.NotNil(t, employees)
 	assert.Empty(t, employees)
 }
+
+// TestCreateEmployee_ProfileFields tests profile fields and their defaults
+func TestCreateEmployee_ProfileFields(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	jobTitle, hireDate, location := "Engineer", "2024-02-01", "Berlin"
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name:         "John Doe",
+		Email:        "john@example.com",
+		DepartmentID: dept.ID,
+		JobTitle:     &jobTitle,
+		HireDate:     &hireDate,
+		Location:     &location,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentTypeFullTime, emp.EmploymentType)
+	assert.Equal(t, model.EmploymentStatusActive, emp.Status)
+
+	// Assert the fields round-trip through the repository
+	found, err := resolver.Query().Employee(ctx, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &jobTitle, found.JobTitle)
+	assert.Equal(t, &hireDate, found.HireDate)
+	assert.Equal(t, &location, found.Location)
+	assert.Nil(t, found.Phone)
+}
+
+// TestCreateEmployee_InvalidProfileFields tests hire date and phone checks
+func TestCreateEmployee_InvalidProfileFields(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	hireDate := "01/02/2024"
+	_, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID, HireDate: &hireDate,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid hire date format")
+
+	// Phone rule from the shared validation config
+	v, err := validation.New(config.ValidationConfig{Rules: map[string]map[string]config.ValidationRule{
+		"employee": {"phone": {Charset: "0-9+() .-"}},
+	}})
+	require.NoError(t, err)
+	resolver.Validator = v
+
+	phone := "call me"
+	_, err = resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID, Phone: &phone,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "employee phone contains characters that are not allowed")
+}
+
+// TestUpdateEmployee_Status tests status changes allowed through updateEmployee
+func TestUpdateEmployee_Status(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	created, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+
+	update := model.UpdateEmployeeInput{Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID}
+
+	// ON_LEAVE is allowed
+	onLeave := model.EmploymentStatusOnLeave
+	update.Status = &onLeave
+	updated, err := resolver.Mutation().UpdateEmployee(ctx, created.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusOnLeave, updated.Status)
+
+	// TERMINATED must go through terminateEmployee
+	terminated := model.EmploymentStatusTerminated
+	update.Status = &terminated
+	_, err = resolver.Mutation().UpdateEmployee(ctx, created.ID, update)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use terminateEmployee")
+
+	// Omitted fields are unchanged
+	update.Status = nil
+	updated, err = resolver.Mutation().UpdateEmployee(ctx, created.ID, update)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusOnLeave, updated.Status)
+
+	// A terminated employee's status cannot be changed here
+	_, err = resolver.Mutation().TerminateEmployee(ctx, created.ID, nil)
+	require.NoError(t, err)
+	active := model.EmploymentStatusActive
+	update.Status = &active
+	_, err = resolver.Mutation().UpdateEmployee(ctx, created.ID, update)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use rehireEmployee")
+}
+
+// TestTerminateEmployee_Success tests termination removes the employee from active projects
+func TestTerminateEmployee_Success(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	hireDate := "2020-01-01"
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID, HireDate: &hireDate,
+	})
+	require.NoError(t, err)
+
+	var projectIDs []string
+	for range 2 {
+		project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+			Name: "Project", StartDate: "2024-01-01", EndDate: "2024-12-31",
+			Budget: 1000, TeamMemberIDs: []string{emp.ID},
+		})
+		require.NoError(t, err)
+		projectIDs = append(projectIDs, project.ID)
+	}
+	for _, status := range []model.ProjectStatus{model.ProjectStatusActive, model.ProjectStatusCompleted} {
+		_, err := resolver.Mutation().TransitionProject(ctx, projectIDs[1], status, nil)
+		require.NoError(t, err)
+	}
+
+	terminationDate := "2025-06-30"
+	terminated, err := resolver.Mutation().TerminateEmployee(ctx, emp.ID, &terminationDate)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusTerminated, terminated.Status)
+	assert.Equal(t, &terminationDate, terminated.TerminationDate)
+
+	// Only the completed project keeps the employee
+	projects, err := resolver.Employee().Projects(ctx, terminated)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, model.ProjectStatusCompleted, projects[0].Status)
+
+	// Terminating twice is rejected
+	_, err = resolver.Mutation().TerminateEmployee(ctx, emp.ID, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already terminated")
+}
+
+// TestTerminateEmployee_CannotJoinProject tests terminated employees are kept
+// off project teams by every team write
+func TestTerminateEmployee_CannotJoinProject(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+	_, err = resolver.Mutation().TerminateEmployee(ctx, emp.ID, nil)
+	require.NoError(t, err)
+
+	project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Apollo", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.NoError(t, err)
+
+	writes := map[string]func() error{
+		"addEmployeeToProject": func() error {
+			_, err := resolver.Mutation().AddEmployeeToProject(ctx, project.ID, emp.ID)
+			return err
+		},
+		"updateProject": func() error {
+			_, err := resolver.Mutation().UpdateProject(ctx, project.ID, model.UpdateProjectInput{TeamMemberIDs: []string{emp.ID}})
+			return err
+		},
+		"createProject": func() error {
+			_, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+				Name: "Artemis", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000, TeamMemberIDs: []string{emp.ID},
+			})
+			return err
+		},
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			err := write()
+			require.Error(t, err)
+			var gqlErr *gqlerror.Error
+			require.True(t, errors.As(err, &gqlErr))
+			assert.Equal(t, "VALIDATION_FAILED", gqlErr.Extensions["code"])
+			assert.Contains(t, err.Error(), "terminated employees cannot join a project team")
+		})
+	}
+}
+
+// TestTerminateEmployee_BeforeHireDate tests the termination date cannot precede the hire date
+func TestTerminateEmployee_BeforeHireDate(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	hireDate := "2024-05-01"
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID, HireDate: &hireDate,
+	})
+	require.NoError(t, err)
+
+	terminationDate := "2024-04-30"
+	_, err = resolver.Mutation().TerminateEmployee(ctx, emp.ID, &terminationDate)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not be before the hire date")
+}
+
+// TestTerminateEmployee_NotFound tests terminating a non-existent employee
+func TestTerminateEmployee_NotFound(t *testing.T) {
+	resolver, ctx, _ := setupEmployeeResolverTest(t)
+
+	_, err := resolver.Mutation().TerminateEmployee(ctx, uuid.New().String(), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "employee not found")
+}
+
+// TestRehireEmployee tests rehiring clears the termination date
+func TestRehireEmployee(t *testing.T) {
+	resolver, ctx, dept := setupEmployeeResolverTest(t)
+
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "John Doe", Email: "john@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+
+	// Only terminated employees can be rehired
+	_, err = resolver.Mutation().RehireEmployee(ctx, emp.ID, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only terminated employees")
+
+	_, err = resolver.Mutation().TerminateEmployee(ctx, emp.ID, nil)
+	require.NoError(t, err)
+
+	hireDate := "2026-02-01"
+	rehired, err := resolver.Mutation().RehireEmployee(ctx, emp.ID, &hireDate)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusActive, rehired.Status)
+	assert.Equal(t, &hireDate, rehired.HireDate)
+	assert.Nil(t, rehired.TerminationDate)
+}
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/health"
+)
+
+// ============================================================================
+// Health Conversion Helpers
+// ============================================================================
+
+// toGraphQLHealthStatus converts a health report into the GraphQL HealthStatus type
+func toGraphQLHealthStatus(report health.Report) *model.HealthStatus {
+	checks := make([]*model.HealthCheck, 0, len(report.Checks))
+	for _, res := range report.Checks {
+		check := &model.HealthCheck{
+			Name:       res.Name,
+			Status:     model.HealthState(res.Status),
+			DurationMs: res.DurationMs,
+		}
+		if res.Message != "" {
+			message := res.Message
+			check.Message = &message
+		}
+		checks = append(checks, check)
+	}
+
+	return &model.HealthStatus{
+		Status:    model.HealthState(report.Status),
+		Checks:    checks,
+		Timestamp: report.Timestamp.Format(time.RFC3339),
+	}
+}
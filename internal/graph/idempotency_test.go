@@ -0,0 +1,179 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var idempotencySecret = []byte("idempotency-secret")
+
+// setupIdempotentServer wires /query the way cmd/graphql does: client info,
+// bearer authentication and the idempotency extension
+func setupIdempotentServer(t *testing.T, ttl time.Duration) (http.Handler, *ent.Client) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(middleware.NewIdempotency(database.NewEntIdempotencyRepo(client), &config.IdempotencyConfig{TTL: ttl}))
+
+	h := auth.Middleware(auth.NewVerifier(idempotencySecret, ""), false)(srv)
+	return middleware.RequestInfoMiddleware(false)(h), client
+}
+
+// idempotentPost sends a query as principal sub with an optional Idempotency-Key header
+func idempotentPost(t *testing.T, h http.Handler, sub, key, query string) (*httptest.ResponseRecorder, graphqlResponse) {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	token, err := auth.Sign(auth.Claims{Subject: sub}, idempotencySecret)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp graphqlResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return rec, resp
+}
+
+func createdID(t *testing.T, resp graphqlResponse, field string) string {
+	require.Empty(t, resp.Errors)
+	obj, ok := resp.Data[field].(map[string]any)
+	require.True(t, ok, "missing %s in response", field)
+	return obj["id"].(string)
+}
+
+// TestIdempotency_HeaderReplaysCreate tests that a retried create returns the original project
+func TestIdempotency_HeaderReplaysCreate(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+	mutation := `mutation { createProject(input: {name: "Apollo", startDate: "2025-01-01", endDate: "2025-06-30", budget: 1000}) { id name } }`
+
+	rec, resp := idempotentPost(t, h, "alice", "retry-1", mutation)
+	first := createdID(t, resp, "createProject")
+	assert.Empty(t, rec.Header().Get(middleware.IdempotentReplayedHeader))
+
+	rec, resp = idempotentPost(t, h, "alice", "retry-1", mutation)
+	assert.Equal(t, first, createdID(t, resp, "createProject"))
+	assert.Equal(t, "true", rec.Header().Get(middleware.IdempotentReplayedHeader))
+
+	assert.Equal(t, 1, client.Project.Query().CountX(auth.WithSystem(t.Context())))
+}
+
+// TestIdempotency_ClientMutationID tests keys passed in the input and as a delete argument
+func TestIdempotency_ClientMutationID(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+	mutation := `mutation { createDepartment(input: {name: "Engineering", clientMutationId: "dept-1"}) { id } }`
+
+	_, resp := idempotentPost(t, h, "alice", "", mutation)
+	id := createdID(t, resp, "createDepartment")
+	_, resp = idempotentPost(t, h, "alice", "", mutation)
+	assert.Equal(t, id, createdID(t, resp, "createDepartment"))
+	assert.Equal(t, 1, client.Department.Query().CountX(auth.WithSystem(t.Context())))
+
+	// A retried delete reports the original success instead of "not found"
+	deletion := `mutation { deleteDepartment(id: "` + id + `", clientMutationId: "del-1") }`
+	_, resp = idempotentPost(t, h, "alice", "", deletion)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, true, resp.Data["deleteDepartment"])
+
+	_, resp = idempotentPost(t, h, "alice", "", deletion)
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, true, resp.Data["deleteDepartment"])
+}
+
+// TestIdempotency_KeyReusedForDifferentRequest tests that a key cannot be reused with other arguments
+func TestIdempotency_KeyReusedForDifferentRequest(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+
+	_, resp := idempotentPost(t, h, "alice", "key-1", `mutation { createDepartment(input: {name: "A"}) { id } }`)
+	createdID(t, resp, "createDepartment")
+
+	_, resp = idempotentPost(t, h, "alice", "key-1", `mutation { createDepartment(input: {name: "B"}) { id } }`)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, middleware.ErrCodeIdempotencyKeyReused, resp.Errors[0].Extensions["code"])
+	assert.Equal(t, 1, client.Department.Query().CountX(auth.WithSystem(t.Context())))
+}
+
+// TestIdempotency_ScopedToPrincipal tests that callers cannot replay each other's results
+func TestIdempotency_ScopedToPrincipal(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+	mutation := `mutation { createDepartment(input: {name: "Engineering"}) { id } }`
+
+	_, resp := idempotentPost(t, h, "alice", "shared-key", mutation)
+	alice := createdID(t, resp, "createDepartment")
+	_, resp = idempotentPost(t, h, "bob", "shared-key", mutation)
+	bob := createdID(t, resp, "createDepartment")
+
+	assert.NotEqual(t, alice, bob)
+	assert.Equal(t, 2, client.Department.Query().CountX(auth.WithSystem(t.Context())))
+}
+
+// TestIdempotency_FailedMutationCanBeRetried tests that errors do not consume the key
+func TestIdempotency_FailedMutationCanBeRetried(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+
+	_, resp := idempotentPost(t, h, "alice", "key-1", `mutation { createDepartment(input: {name: ""}) { id } }`)
+	require.NotEmpty(t, resp.Errors)
+	assert.Equal(t, 0, client.IdempotencyKey.Query().CountX(auth.WithSystem(t.Context())))
+}
+
+// TestIdempotency_ExpiredKeyExecutesAgain tests that results are only replayed within the TTL
+func TestIdempotency_ExpiredKeyExecutesAgain(t *testing.T) {
+	h, client := setupIdempotentServer(t, 50*time.Millisecond)
+	mutation := `mutation { createDepartment(input: {name: "Engineering"}) { id } }`
+
+	_, resp := idempotentPost(t, h, "alice", "key-1", mutation)
+	first := createdID(t, resp, "createDepartment")
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, resp = idempotentPost(t, h, "alice", "key-1", mutation)
+	assert.NotEqual(t, first, createdID(t, resp, "createDepartment"))
+	assert.Equal(t, 2, client.Department.Query().CountX(auth.WithSystem(t.Context())))
+}
+
+// TestIdempotency_UpdatesAndQueriesIgnoreKey tests that only create/delete mutations are keyed
+func TestIdempotency_UpdatesAndQueriesIgnoreKey(t *testing.T) {
+	h, client := setupIdempotentServer(t, time.Hour)
+
+	_, resp := idempotentPost(t, h, "alice", "key-1", `{ departments { id } }`)
+	require.Empty(t, resp.Errors)
+
+	_, resp = idempotentPost(t, h, "alice", "", `mutation { createDepartment(input: {name: "Engineering"}) { id } }`)
+	id := createdID(t, resp, "createDepartment")
+
+	update := `mutation { updateDepartment(id: "` + id + `", input: {name: "R&D"}) { id } }`
+	_, resp = idempotentPost(t, h, "alice", "key-1", update)
+	require.Empty(t, resp.Errors)
+
+	assert.Equal(t, 0, client.IdempotencyKey.Query().CountX(auth.WithSystem(t.Context())))
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/metrics"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/validation"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMetricsServer(t *testing.T) (*handler.Server, *metrics.Metrics) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+
+	m := metrics.New()
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(middleware.FixedDepthLimit(3))
+	srv.Use(middleware.NewMetricsMiddleware(m))
+	srv.AroundOperations(middleware.LoggingMiddleware())
+
+	return srv, m
+}
+
+// TestMetrics_RecordsOperations tests operation counters, latency and in-flight gauge
+func TestMetrics_RecordsOperations(t *testing.T) {
+	srv, m := setupMetricsServer(t)
+
+	postQuery(t, srv, `query ListDepartments { departments { id } }`)
+	postQuery(t, srv, `query Depts { list: departments { id } }`)
+	postQuery(t, srv, `mutation { createDepartment(input: {name: "Engineering"}) { id } }`)
+
+	assert.Equal(t, 2.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("departments", "query", "success")))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("createDepartment", "mutation", "success")))
+	assert.Equal(t, 0.0, promtest.ToFloat64(m.RequestsInFlight))
+	assert.Equal(t, 2, promtest.CollectAndCount(m.RequestDuration))
+}
+
+// TestMetrics_OperationLabelIgnoresClientNames tests that the operation label
+// is bounded by the schema, whatever the operation is called
+func TestMetrics_OperationLabelIgnoresClientNames(t *testing.T) {
+	srv, m := setupMetricsServer(t)
+
+	postQuery(t, srv, `query Random1 { departments { id } }`)
+	postQuery(t, srv, `query Random2 { ...root } fragment root on Query { departments { id } }`)
+	postQuery(t, srv, `query Random3 { departments { id } health { status } }`)
+
+	assert.Equal(t, 2.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("departments", "query", "success")))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("multiple", "query", "success")))
+	assert.Equal(t, 2, promtest.CollectAndCount(m.RequestsTotal))
+}
+
+// TestMetrics_CountsErrorsByCode tests error counters for resolver and rejected operations
+func TestMetrics_CountsErrorsByCode(t *testing.T) {
+	srv, m := setupMetricsServer(t)
+
+	// Resolver error without an extensions.code
+	postQuery(t, srv, `mutation { deleteDepartment(id: "00000000-0000-0000-0000-000000000000") }`)
+
+	// Input rejected by the validation rules
+	postQuery(t, srv, `mutation { createDepartment(input: {name: ""}) { id } }`)
+
+	// Operation rejected by the depth limit before execution
+	postQuery(t, srv, `{ departments { employees { projects { id } } } }`)
+
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.ErrorsTotal.WithLabelValues("NONE")))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.ErrorsTotal.WithLabelValues(validation.ErrCodeValidationFailed)))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.ErrorsTotal.WithLabelValues(middleware.ErrCodeDepthLimit)))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("deleteDepartment", "mutation", "error")))
+	assert.Equal(t, 1.0, promtest.ToFloat64(m.RequestsTotal.WithLabelValues("createDepartment", "mutation", "error")))
+}
+
+// TestMetrics_RecordsResolverLatency tests that only resolver-backed fields are timed
+func TestMetrics_RecordsResolverLatency(t *testing.T) {
+	srv, m := setupMetricsServer(t)
+
+	postQuery(t, srv, `{ departments { id name } health { status } }`)
+
+	// Query.departments and Query.health are resolvers; Department.id/name are plain fields
+	assert.Equal(t, 2, promtest.CollectAndCount(m.ResolverDuration))
+}
+
+// TestMetrics_Handler tests that the registry is served in Prometheus text format
+func TestMetrics_Handler(t *testing.T) {
+	_, m := setupMetricsServer(t)
+	m.RequestsTotal.WithLabelValues("departments", "query", "success").Inc()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `ginapi_graphql_requests_total{operation="departments",status="success",type="query"} 1`)
+	assert.Contains(t, string(body), "go_goroutines")
+}
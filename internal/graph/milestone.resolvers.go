@@ -0,0 +1,175 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.82
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+
+	"github.com/google/uuid"
+)
+
+// Tasks is the resolver for the tasks field.
+func (r *milestoneResolver) Tasks(ctx context.Context, obj *model.Milestone) ([]*model.Task, error) {
+	tasks, err := r.ProjRepo.FindTasksByMilestoneID(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks for milestone: %w", err)
+	}
+	return tasks, nil
+}
+
+// CreateMilestone is the resolver for the createMilestone field.
+func (r *mutationResolver) CreateMilestone(ctx context.Context, input model.CreateMilestoneInput) (*model.Milestone, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "createMilestone").
+		Str("project_id", input.ProjectID).
+		Str("title", input.Title).
+		Msg("Creating milestone")
+
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("milestone", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	dueDate, err := normalizeDate(input.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due date format (use YYYY-MM-DD): %w", err)
+	}
+
+	// Verify project exists
+	if _, err := r.ProjRepo.FindByID(ctx, input.ProjectID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", input.ProjectID)
+		}
+		return nil, fmt.Errorf("failed to validate project: %w", err)
+	}
+
+	status := model.MilestoneStatusOpen
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	milestone := &model.Milestone{
+		ID:        uuid.New().String(),
+		ProjectID: input.ProjectID,
+		Title:     input.Title,
+		DueDate:   dueDate,
+		Status:    status,
+	}
+
+	if err := r.ProjRepo.SaveMilestone(ctx, milestone); err != nil {
+		log.Error().Err(err).Msg("Failed to save milestone")
+		return nil, fmt.Errorf("failed to create milestone: %w", err)
+	}
+
+	log.Info().
+		Str("milestone_id", milestone.ID).
+		Str("project_id", milestone.ProjectID).
+		Msg("Milestone created successfully")
+
+	return milestone, nil
+}
+
+// UpdateMilestone is the resolver for the updateMilestone field.
+func (r *mutationResolver) UpdateMilestone(ctx context.Context, id string, input model.UpdateMilestoneInput) (*model.Milestone, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "updateMilestone").
+		Str("milestone_id", id).
+		Msg("Updating milestone")
+
+	// Validate provided fields against the configured rules
+	if err := r.Validator.ValidatePartial("milestone", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	existing, err := r.ProjRepo.FindMilestoneByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("milestone with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to find milestone: %w", err)
+	}
+
+	// Apply updates (only update provided fields)
+	if input.Title != nil {
+		existing.Title = *input.Title
+	}
+	if input.DueDate != nil {
+		dueDate, err := normalizeDate(input.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date format (use YYYY-MM-DD): %w", err)
+		}
+		existing.DueDate = dueDate
+	}
+	if input.Status != nil {
+		existing.Status = *input.Status
+	}
+
+	if err := r.ProjRepo.UpdateMilestone(ctx, existing); err != nil {
+		log.Error().Err(err).Msg("Failed to update milestone")
+		return nil, fmt.Errorf("failed to update milestone: %w", err)
+	}
+
+	log.Info().
+		Str("milestone_id", id).
+		Msg("Milestone updated successfully")
+
+	return existing, nil
+}
+
+// DeleteMilestone is the resolver for the deleteMilestone field.
+func (r *mutationResolver) DeleteMilestone(ctx context.Context, id string, clientMutationID *string) (bool, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "deleteMilestone").
+		Str("milestone_id", id).
+		Msg("Deleting milestone")
+
+	if err := r.ProjRepo.DeleteMilestone(ctx, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, fmt.Errorf("milestone with ID %s not found", id)
+		}
+		log.Error().Err(err).Msg("Failed to delete milestone")
+		return false, fmt.Errorf("failed to delete milestone: %w", err)
+	}
+
+	log.Info().
+		Str("milestone_id", id).
+		Msg("Milestone deleted successfully")
+
+	return true, nil
+}
+
+// Milestone is the resolver for the milestone field.
+func (r *queryResolver) Milestone(ctx context.Context, id string) (*model.Milestone, error) {
+	milestone, err := r.ProjRepo.FindMilestoneByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil // GraphQL convention: return nil for not found
+		}
+		return nil, fmt.Errorf("failed to find milestone: %w", err)
+	}
+	return milestone, nil
+}
+
+// Milestone returns MilestoneResolver implementation.
+func (r *Resolver) Milestone() MilestoneResolver { return &milestoneResolver{r} }
+
+type milestoneResolver struct{ *Resolver }
@@ -13,6 +13,11 @@ import (
 type CreateDepartmentInput struct {
 	// Department name (required)
 	Name string `json:"name"`
+	// ID of the parent department (optional, top-level when omitted)
+	ParentID *string `json:"parentID,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
 }
 
 // Input for creating a new employee
@@ -23,6 +28,34 @@ type CreateEmployeeInput struct {
 	Email string `json:"email"`
 	// ID of the department (required, must reference an existing department)
 	DepartmentID string `json:"departmentID"`
+	// Job title (optional)
+	JobTitle *string `json:"jobTitle,omitempty"`
+	// Type of employment contract (defaults to FULL_TIME if not provided)
+	EmploymentType *EmploymentType `json:"employmentType,omitempty"`
+	// Hire date in YYYY-MM-DD format (optional)
+	HireDate *string `json:"hireDate,omitempty"`
+	// Phone number (optional)
+	Phone *string `json:"phone,omitempty"`
+	// Office or city (optional)
+	Location *string `json:"location,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+// Input for creating a new milestone
+type CreateMilestoneInput struct {
+	// ID of the project (required, must reference an existing project)
+	ProjectID string `json:"projectID"`
+	// Milestone title (required)
+	Title string `json:"title"`
+	// Due date in YYYY-MM-DD format (optional)
+	DueDate *string `json:"dueDate,omitempty"`
+	// Milestone status (defaults to OPEN if not provided)
+	Status *MilestoneStatus `json:"status,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
 }
 
 // Input for creating a new project
@@ -31,7 +64,7 @@ type CreateProjectInput struct {
 	Name string `json:"name"`
 	// Project description (optional)
 	Description *string `json:"description,omitempty"`
-	// Project status (defaults to ACTIVE if not provided)
+	// Project status: one of the workflow's initial statuses (defaults to PLANNED if not provided)
 	Status *ProjectStatus `json:"status,omitempty"`
 	// Project priority (defaults to MEDIUM if not provided)
 	Priority *ProjectPriority `json:"priority,omitempty"`
@@ -41,21 +74,96 @@ type CreateProjectInput struct {
 	EndDate string `json:"endDate"`
 	// Budget amount
 	Budget float64 `json:"budget"`
+	// ID of the employee leading the project (optional)
+	LeadID *string `json:"leadID,omitempty"`
 	// List of employee IDs to assign to this project
 	TeamMemberIDs []string `json:"teamMemberIDs,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+// Input for creating a new skill
+type CreateSkillInput struct {
+	// Skill name (required, unique)
+	Name string `json:"name"`
+	// Category (optional)
+	Category *string `json:"category,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+// Input for creating a new task
+type CreateTaskInput struct {
+	// ID of the project (required, must reference an existing project)
+	ProjectID string `json:"projectID"`
+	// ID of a milestone of the same project (optional)
+	MilestoneID *string `json:"milestoneID,omitempty"`
+	// Task title (required)
+	Title string `json:"title"`
+	// Due date in YYYY-MM-DD format (optional)
+	DueDate *string `json:"dueDate,omitempty"`
+	// Task status (defaults to TODO if not provided)
+	Status *TaskStatus `json:"status,omitempty"`
+	// ID of the assigned employee (optional, must be a project team member)
+	AssigneeID *string `json:"assigneeID,omitempty"`
+	// Estimated effort in hours (optional)
+	EstimateHours *float64 `json:"estimateHours,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+// Input for creating a webhook subscription
+type CreateWebhookSubscriptionInput struct {
+	// URL events are posted to (http or https). Loopback, private and link-local
+	// addresses are rejected unless webhooks.allow_private_targets is set.
+	URL string `json:"url"`
+	// Secret for the X-Webhook-Signature HMAC (at least 16 characters, never returned)
+	Secret string `json:"secret"`
+	// Event types to deliver (at least one)
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	// Whether new events are delivered (defaults to true)
+	Active *bool `json:"active,omitempty"`
+	// Idempotency key (optional). Retrying with the same key returns the original
+	// response instead of creating a duplicate; takes precedence over the Idempotency-Key header.
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
 }
 
 // Department represents an organizational department.
 // Each department can have multiple employees.
+// Departments form a tree: a manager sees the employees of their own department
+// and of every department below it.
 type Department struct {
 	// Unique identifier (UUID)
 	ID string `json:"id"`
 	// Department name
 	Name string `json:"name"`
+	// ID of the parent department (null for top-level departments)
+	ParentID *string `json:"parentID,omitempty"`
+	// The parent department
+	Parent *Department `json:"parent,omitempty"`
 	// List of employees in this department
 	Employees []*Employee `json:"employees,omitempty"`
 }
 
+// A department's load in the window
+type DepartmentWorkload struct {
+	// The department
+	Department *Department `json:"department"`
+	// Employees counted (not terminated)
+	EmployeeCount int `json:"employeeCount"`
+	// Employees with at least one flag
+	OverallocatedCount int `json:"overallocatedCount"`
+	// Sum of the employees' project counts
+	ProjectAssignments int `json:"projectAssignments"`
+	// Sum of the employees' open task hours
+	OpenTaskHours float64 `json:"openTaskHours"`
+	// Average load score of the employees
+	AverageLoad float64 `json:"averageLoad"`
+}
+
 // Employee represents a person working in a department.
 // Each employee must belong to exactly one department.
 // Employees can work on multiple projects.
@@ -64,14 +172,95 @@ type Employee struct {
 	ID string `json:"id"`
 	// Employee full name
 	Name string `json:"name"`
-	// Employee email address (must be unique)
+	// Employee email address (must be unique); masked without the pii:read permission
 	Email string `json:"email"`
 	// ID of the department this employee belongs to
 	DepartmentID string `json:"departmentID"`
+	// Job title
+	JobTitle *string `json:"jobTitle,omitempty"`
+	// Type of employment contract
+	EmploymentType EmploymentType `json:"employmentType"`
+	// Employment status
+	Status EmploymentStatus `json:"status"`
+	// Date the employee was (last) hired in YYYY-MM-DD format
+	HireDate *string `json:"hireDate,omitempty"`
+	// Date the employment ended in YYYY-MM-DD format (only while terminated)
+	TerminationDate *string `json:"terminationDate,omitempty"`
+	// Phone number; masked without the pii:read permission
+	Phone *string `json:"phone,omitempty"`
+	// Office or city the employee works from
+	Location *string `json:"location,omitempty"`
 	// The department this employee belongs to
 	Department *Department `json:"department,omitempty"`
 	// List of projects this employee is working on
 	Projects []*Project `json:"projects,omitempty"`
+	// Skills of the employee
+	Skills []*EmployeeSkill `json:"skills"`
+}
+
+// A skill an employee has
+type EmployeeSkill struct {
+	// The skill
+	Skill *Skill `json:"skill"`
+	// Proficiency from 1 (beginner) to 5 (expert)
+	Level int `json:"level"`
+}
+
+// An employee's load in the window
+type EmployeeWorkload struct {
+	// The employee
+	Employee *Employee `json:"employee"`
+	// ACTIVE projects of the employee overlapping the window
+	Projects []*Project `json:"projects"`
+	// Number of projects
+	ProjectCount int `json:"projectCount"`
+	// Estimated hours of assigned tasks not DONE and due in the window
+	OpenTaskHours float64 `json:"openTaskHours"`
+	// Higher of projectCount / max_projects and openTaskHours / capacity hours.
+	// Above 1 means overallocated.
+	LoadScore float64 `json:"loadScore"`
+	// Whether any flag is raised
+	Overallocated bool `json:"overallocated"`
+	// Reasons the employee is overallocated
+	Flags []WorkloadFlag `json:"flags"`
+}
+
+// Result of a single dependency check
+type HealthCheck struct {
+	// Check name (database, connection_pool, migrations)
+	Name string `json:"name"`
+	// Outcome of the check
+	Status HealthState `json:"status"`
+	// Reason for a non-OK status; only shown to admins
+	Message *string `json:"message,omitempty"`
+	// Time spent running the check in milliseconds
+	DurationMs float64 `json:"durationMs"`
+}
+
+// Structured health report; status is the worst status of all checks
+type HealthStatus struct {
+	// Overall health state
+	Status HealthState `json:"status"`
+	// Individual dependency checks
+	Checks []*HealthCheck `json:"checks"`
+	// Time the report was produced (RFC3339)
+	Timestamp string `json:"timestamp"`
+}
+
+// A checkpoint in a project's timeline
+type Milestone struct {
+	// Unique identifier (UUID)
+	ID string `json:"id"`
+	// ID of the project this milestone belongs to
+	ProjectID string `json:"projectID"`
+	// Milestone title
+	Title string `json:"title"`
+	// Due date in YYYY-MM-DD format
+	DueDate *string `json:"dueDate,omitempty"`
+	// Milestone status
+	Status MilestoneStatus `json:"status"`
+	// Tasks scheduled for this milestone
+	Tasks []*Task `json:"tasks"`
 }
 
 // Mutation root type - All mutations extend this type
@@ -97,18 +286,109 @@ type Project struct {
 	EndDate string `json:"endDate"`
 	// Project budget amount
 	Budget float64 `json:"budget"`
+	// ID of the employee leading the project
+	LeadID *string `json:"leadID,omitempty"`
+	// The employee leading the project; project leads can only change the projects they lead
+	Lead *Employee `json:"lead,omitempty"`
 	// List of employees working on this project (team members)
 	TeamMembers []*Employee `json:"teamMembers,omitempty"`
+	// Milestones of this project, ordered by due date
+	Milestones []*Milestone `json:"milestones"`
+	// Tasks of this project
+	Tasks []*Task `json:"tasks"`
+	// Share of tasks that are DONE, from 0 to 1 (0 when the project has no tasks)
+	Progress float64 `json:"progress"`
+	// Statuses the project can move to with transitionProject
+	AllowedTransitions []ProjectStatus `json:"allowedTransitions"`
+	// Status transitions of the project, oldest first
+	StatusHistory []*ProjectStatusChange `json:"statusHistory"`
+	// Skills team members should have
+	RequiredSkills []*RequiredSkill `json:"requiredSkills"`
+}
+
+// A recorded transition of a project's status
+type ProjectStatusChange struct {
+	// Unique identifier (UUID)
+	ID string `json:"id"`
+	// ID of the project
+	ProjectID string `json:"projectID"`
+	// Status before the transition
+	From ProjectStatus `json:"from"`
+	// Status after the transition
+	To ProjectStatus `json:"to"`
+	// Reason given for the transition
+	Reason *string `json:"reason,omitempty"`
+	// ID of the principal that made the transition (null for anonymous callers)
+	ChangedBy *string `json:"changedBy,omitempty"`
+	// Time of the transition (RFC 3339)
+	ChangedAt string `json:"changedAt"`
 }
 
 // Query root type - All queries extend this type
 type Query struct {
 }
 
+// A skill a project requires
+type RequiredSkill struct {
+	// The skill
+	Skill *Skill `json:"skill"`
+	// Minimum proficiency from 1 (beginner) to 5 (expert)
+	MinLevel int `json:"minLevel"`
+}
+
+// A skill in the shared catalog
+type Skill struct {
+	// Unique identifier (UUID)
+	ID string `json:"id"`
+	// Skill name (unique)
+	Name string `json:"name"`
+	// Category such as language, cloud or soft skill
+	Category *string `json:"category,omitempty"`
+}
+
+// A unit of work in a project
+type Task struct {
+	// Unique identifier (UUID)
+	ID string `json:"id"`
+	// ID of the project this task belongs to
+	ProjectID string `json:"projectID"`
+	// ID of the milestone this task is scheduled for
+	MilestoneID *string `json:"milestoneID,omitempty"`
+	// Task title
+	Title string `json:"title"`
+	// Due date in YYYY-MM-DD format
+	DueDate *string `json:"dueDate,omitempty"`
+	// Task status
+	Status TaskStatus `json:"status"`
+	// ID of the assigned employee
+	AssigneeID *string `json:"assigneeID,omitempty"`
+	// The assigned employee (a member of the project team)
+	Assignee *Employee `json:"assignee,omitempty"`
+	// Estimated effort in hours
+	EstimateHours *float64 `json:"estimateHours,omitempty"`
+}
+
+// A candidate for a project team
+type TeamMemberSuggestion struct {
+	// The suggested employee
+	Employee *Employee `json:"employee"`
+	// Skill match from 0 to 1. Each required skill counts equally, with partial
+	// credit for a level below the minimum.
+	Score float64 `json:"score"`
+	// Required skills the employee has at or above the minimum level
+	MatchedSkills []*EmployeeSkill `json:"matchedSkills"`
+	// Required skills the employee lacks or has below the minimum level
+	MissingSkills []*RequiredSkill `json:"missingSkills"`
+	// Number of projects the employee is on that are not COMPLETED or CANCELLED
+	CurrentProjects int `json:"currentProjects"`
+}
+
 // Input for updating an existing department
 type UpdateDepartmentInput struct {
 	// Department name (required)
 	Name string `json:"name"`
+	// ID of the parent department; omitted keeps it, an empty ID makes the department top-level
+	ParentID *string `json:"parentID,omitempty"`
 }
 
 // Input for updating an existing employee
@@ -119,6 +399,29 @@ type UpdateEmployeeInput struct {
 	Email string `json:"email"`
 	// ID of the department (required, must reference an existing department)
 	DepartmentID string `json:"departmentID"`
+	// Job title (unchanged if not provided)
+	JobTitle *string `json:"jobTitle,omitempty"`
+	// Type of employment contract (unchanged if not provided)
+	EmploymentType *EmploymentType `json:"employmentType,omitempty"`
+	// Employment status (unchanged if not provided). Only ACTIVE and ON_LEAVE can
+	// be set here; use terminateEmployee and rehireEmployee for terminations.
+	Status *EmploymentStatus `json:"status,omitempty"`
+	// Hire date in YYYY-MM-DD format (unchanged if not provided)
+	HireDate *string `json:"hireDate,omitempty"`
+	// Phone number (unchanged if not provided)
+	Phone *string `json:"phone,omitempty"`
+	// Office or city (unchanged if not provided)
+	Location *string `json:"location,omitempty"`
+}
+
+// Input for updating an existing milestone
+type UpdateMilestoneInput struct {
+	// Milestone title
+	Title *string `json:"title,omitempty"`
+	// Due date in YYYY-MM-DD format
+	DueDate *string `json:"dueDate,omitempty"`
+	// Milestone status
+	Status *MilestoneStatus `json:"status,omitempty"`
 }
 
 // Input for updating an existing project
@@ -127,7 +430,7 @@ type UpdateProjectInput struct {
 	Name *string `json:"name,omitempty"`
 	// Project description
 	Description *string `json:"description,omitempty"`
-	// Project status
+	// Project status - only the current status is accepted, use transitionProject to change it
 	Status *ProjectStatus `json:"status,omitempty"`
 	// Project priority
 	Priority *ProjectPriority `json:"priority,omitempty"`
@@ -137,10 +440,344 @@ type UpdateProjectInput struct {
 	EndDate *string `json:"endDate,omitempty"`
 	// Budget amount
 	Budget *float64 `json:"budget,omitempty"`
+	// ID of the employee leading the project; an empty ID removes the lead
+	LeadID *string `json:"leadID,omitempty"`
 	// List of employee IDs to assign to this project (replaces existing team)
 	TeamMemberIDs []string `json:"teamMemberIDs,omitempty"`
 }
 
+// Input for updating an existing skill
+type UpdateSkillInput struct {
+	// Skill name (unique)
+	Name *string `json:"name,omitempty"`
+	// Category (an empty string clears it)
+	Category *string `json:"category,omitempty"`
+}
+
+// Input for updating an existing task.
+// Omitted fields are unchanged; an empty milestoneID or assigneeID clears it.
+type UpdateTaskInput struct {
+	// ID of a milestone of the same project ("" to unschedule)
+	MilestoneID *string `json:"milestoneID,omitempty"`
+	// Task title
+	Title *string `json:"title,omitempty"`
+	// Due date in YYYY-MM-DD format
+	DueDate *string `json:"dueDate,omitempty"`
+	// Task status
+	Status *TaskStatus `json:"status,omitempty"`
+	// ID of the assigned employee, must be a project team member ("" to unassign)
+	AssigneeID *string `json:"assigneeID,omitempty"`
+	// Estimated effort in hours
+	EstimateHours *float64 `json:"estimateHours,omitempty"`
+}
+
+// Input for updating a webhook subscription
+type UpdateWebhookSubscriptionInput struct {
+	// URL events are posted to (http or https)
+	URL *string `json:"url,omitempty"`
+	// New signing secret (at least 16 characters)
+	Secret *string `json:"secret,omitempty"`
+	// Event types to deliver (at least one)
+	EventTypes []WebhookEventType `json:"eventTypes,omitempty"`
+	// Whether new events are delivered
+	Active *bool `json:"active,omitempty"`
+}
+
+// One event sent to one subscription
+type WebhookDelivery struct {
+	// Unique identifier (UUID), sent as X-Webhook-Delivery
+	ID string `json:"id"`
+	// ID of the subscription
+	SubscriptionID string `json:"subscriptionID"`
+	// ID of the outbox event, the id field of the request body
+	EventID string `json:"eventID"`
+	// Type of the event
+	EventType WebhookEventType `json:"eventType"`
+	// Delivery state
+	Status WebhookDeliveryStatus `json:"status"`
+	// Number of attempts made
+	Attempts int `json:"attempts"`
+	// HTTP status of the last attempt
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+	// Time of the next attempt in RFC 3339 format (PENDING only)
+	NextAttemptAt *string `json:"nextAttemptAt,omitempty"`
+	// Time of the successful attempt in RFC 3339 format
+	DeliveredAt *string `json:"deliveredAt,omitempty"`
+	// Time the delivery was queued in RFC 3339 format
+	CreatedAt string `json:"createdAt"`
+}
+
+// A receiver of entity change events
+type WebhookSubscription struct {
+	// Unique identifier (UUID)
+	ID string `json:"id"`
+	// URL events are posted to
+	URL string `json:"url"`
+	// Event types delivered to this subscription
+	EventTypes []WebhookEventType `json:"eventTypes"`
+	// Whether new events are delivered
+	Active bool `json:"active"`
+	// Creation time in RFC 3339 format
+	CreatedAt string `json:"createdAt"`
+	// Delivery log, newest first
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+// Workload report for a date window
+type Workload struct {
+	// First day of the window in YYYY-MM-DD format
+	From string `json:"from"`
+	// Last day of the window in YYYY-MM-DD format
+	To string `json:"to"`
+	// Employees that are not terminated, highest load first
+	Employees []*EmployeeWorkload `json:"employees"`
+	// Rollup per department, ordered by name
+	Departments []*DepartmentWorkload `json:"departments"`
+}
+
+// Employment status of an employee
+type EmploymentStatus string
+
+const (
+	// Currently working
+	EmploymentStatusActive EmploymentStatus = "ACTIVE"
+	// Temporarily away (e.g. parental leave)
+	EmploymentStatusOnLeave EmploymentStatus = "ON_LEAVE"
+	// Employment ended - set by terminateEmployee, cleared by rehireEmployee
+	EmploymentStatusTerminated EmploymentStatus = "TERMINATED"
+)
+
+var AllEmploymentStatus = []EmploymentStatus{
+	EmploymentStatusActive,
+	EmploymentStatusOnLeave,
+	EmploymentStatusTerminated,
+}
+
+func (e EmploymentStatus) IsValid() bool {
+	switch e {
+	case EmploymentStatusActive, EmploymentStatusOnLeave, EmploymentStatusTerminated:
+		return true
+	}
+	return false
+}
+
+func (e EmploymentStatus) String() string {
+	return string(e)
+}
+
+func (e *EmploymentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmploymentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmploymentStatus", str)
+	}
+	return nil
+}
+
+func (e EmploymentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EmploymentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EmploymentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Type of employment contract
+type EmploymentType string
+
+const (
+	// Permanent full-time employee
+	EmploymentTypeFullTime EmploymentType = "FULL_TIME"
+	// External contractor
+	EmploymentTypeContractor EmploymentType = "CONTRACTOR"
+	// Intern
+	EmploymentTypeIntern EmploymentType = "INTERN"
+)
+
+var AllEmploymentType = []EmploymentType{
+	EmploymentTypeFullTime,
+	EmploymentTypeContractor,
+	EmploymentTypeIntern,
+}
+
+func (e EmploymentType) IsValid() bool {
+	switch e {
+	case EmploymentTypeFullTime, EmploymentTypeContractor, EmploymentTypeIntern:
+		return true
+	}
+	return false
+}
+
+func (e EmploymentType) String() string {
+	return string(e)
+}
+
+func (e *EmploymentType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmploymentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmploymentType", str)
+	}
+	return nil
+}
+
+func (e EmploymentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EmploymentType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EmploymentType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Health state of the service or of a single dependency
+type HealthState string
+
+const (
+	// Healthy
+	HealthStateOk HealthState = "OK"
+	// Serving traffic, but a dependency needs attention (e.g. saturated connection pool)
+	HealthStateDegraded HealthState = "DEGRADED"
+	// Not able to serve traffic (e.g. database unreachable or migrations pending)
+	HealthStateDown HealthState = "DOWN"
+)
+
+var AllHealthState = []HealthState{
+	HealthStateOk,
+	HealthStateDegraded,
+	HealthStateDown,
+}
+
+func (e HealthState) IsValid() bool {
+	switch e {
+	case HealthStateOk, HealthStateDegraded, HealthStateDown:
+		return true
+	}
+	return false
+}
+
+func (e HealthState) String() string {
+	return string(e)
+}
+
+func (e *HealthState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HealthState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HealthState", str)
+	}
+	return nil
+}
+
+func (e HealthState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *HealthState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e HealthState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Milestone status
+type MilestoneStatus string
+
+const (
+	// Milestone not reached yet
+	MilestoneStatusOpen MilestoneStatus = "OPEN"
+	// Milestone reached
+	MilestoneStatusCompleted MilestoneStatus = "COMPLETED"
+)
+
+var AllMilestoneStatus = []MilestoneStatus{
+	MilestoneStatusOpen,
+	MilestoneStatusCompleted,
+}
+
+func (e MilestoneStatus) IsValid() bool {
+	switch e {
+	case MilestoneStatusOpen, MilestoneStatusCompleted:
+		return true
+	}
+	return false
+}
+
+func (e MilestoneStatus) String() string {
+	return string(e)
+}
+
+func (e *MilestoneStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MilestoneStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MilestoneStatus", str)
+	}
+	return nil
+}
+
+func (e MilestoneStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MilestoneStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MilestoneStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Project priority level for resource allocation and planning
 type ProjectPriority string
 
@@ -202,27 +839,35 @@ func (e ProjectPriority) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// Project status indicates the current state of the project
+// Project status indicates the current state of the project.
+// After creation it only changes through transitionProject, following the
+// configured workflow (by default COMPLETED and CANCELLED are final).
 type ProjectStatus string
 
 const (
+	// Project is planned but work has not started
+	ProjectStatusPlanned ProjectStatus = "PLANNED"
 	// Project is currently active and in progress
 	ProjectStatusActive ProjectStatus = "ACTIVE"
 	// Project is completed
 	ProjectStatusCompleted ProjectStatus = "COMPLETED"
 	// Project is temporarily on hold
 	ProjectStatusOnHold ProjectStatus = "ON_HOLD"
+	// Project was stopped before completion
+	ProjectStatusCancelled ProjectStatus = "CANCELLED"
 )
 
 var AllProjectStatus = []ProjectStatus{
+	ProjectStatusPlanned,
 	ProjectStatusActive,
 	ProjectStatusCompleted,
 	ProjectStatusOnHold,
+	ProjectStatusCancelled,
 }
 
 func (e ProjectStatus) IsValid() bool {
 	switch e {
-	case ProjectStatusActive, ProjectStatusCompleted, ProjectStatusOnHold:
+	case ProjectStatusPlanned, ProjectStatusActive, ProjectStatusCompleted, ProjectStatusOnHold, ProjectStatusCancelled:
 		return true
 	}
 	return false
@@ -262,3 +907,244 @@ func (e ProjectStatus) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+// Task status
+type TaskStatus string
+
+const (
+	// Not started
+	TaskStatusTodo TaskStatus = "TODO"
+	// Being worked on
+	TaskStatusInProgress TaskStatus = "IN_PROGRESS"
+	// Finished - counts towards Project.progress
+	TaskStatusDone TaskStatus = "DONE"
+)
+
+var AllTaskStatus = []TaskStatus{
+	TaskStatusTodo,
+	TaskStatusInProgress,
+	TaskStatusDone,
+}
+
+func (e TaskStatus) IsValid() bool {
+	switch e {
+	case TaskStatusTodo, TaskStatusInProgress, TaskStatusDone:
+		return true
+	}
+	return false
+}
+
+func (e TaskStatus) String() string {
+	return string(e)
+}
+
+func (e *TaskStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskStatus", str)
+	}
+	return nil
+}
+
+func (e TaskStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// State of a delivery
+type WebhookDeliveryStatus string
+
+const (
+	// Waiting for its first attempt or a retry
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// Accepted by the receiver with a 2xx response
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "DELIVERED"
+	// Dead-lettered after the last retry failed
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "DEAD"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusDelivered,
+	WebhookDeliveryStatusDead,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusDead:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Events a webhook can subscribe to
+type WebhookEventType string
+
+const (
+	// An employee was created
+	WebhookEventTypeEmployeeCreated WebhookEventType = "EMPLOYEE_CREATED"
+	// An employee moved to another department
+	WebhookEventTypeEmployeeMoved WebhookEventType = "EMPLOYEE_MOVED"
+	// An employee was deleted (also when their department is deleted)
+	WebhookEventTypeEmployeeDeleted WebhookEventType = "EMPLOYEE_DELETED"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeEmployeeCreated,
+	WebhookEventTypeEmployeeMoved,
+	WebhookEventTypeEmployeeDeleted,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeEmployeeCreated, WebhookEventTypeEmployeeMoved, WebhookEventTypeEmployeeDeleted:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WebhookEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WebhookEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Reason an employee is overallocated
+type WorkloadFlag string
+
+const (
+	// On more ACTIVE projects than workload.max_projects
+	WorkloadFlagTooManyProjects WorkloadFlag = "TOO_MANY_PROJECTS"
+	// Open task hours exceed workload.weekly_hours over the window
+	WorkloadFlagTooManyTaskHours WorkloadFlag = "TOO_MANY_TASK_HOURS"
+)
+
+var AllWorkloadFlag = []WorkloadFlag{
+	WorkloadFlagTooManyProjects,
+	WorkloadFlagTooManyTaskHours,
+}
+
+func (e WorkloadFlag) IsValid() bool {
+	switch e {
+	case WorkloadFlagTooManyProjects, WorkloadFlagTooManyTaskHours:
+		return true
+	}
+	return false
+}
+
+func (e WorkloadFlag) String() string {
+	return string(e)
+}
+
+func (e *WorkloadFlag) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WorkloadFlag(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WorkloadFlag", str)
+	}
+	return nil
+}
+
+func (e WorkloadFlag) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WorkloadFlag) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WorkloadFlag) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
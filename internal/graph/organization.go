@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/validation"
+)
+
+// ============================================================================
+// Department Tree, Project Lead and Team Helper Functions
+// ============================================================================
+
+// checkEmployeeExists verifies that an employee referenced by ID exists
+func (r *Resolver) checkEmployeeExists(ctx context.Context, id string) error {
+	if _, err := r.EmpRepo.FindByID(ctx, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return fmt.Errorf("employee with ID %s not found", id)
+		}
+		return fmt.Errorf("failed to validate employee: %w", err)
+	}
+	return nil
+}
+
+// checkDepartmentParent verifies that parentID exists and, when moving an
+// existing department (deptID non-empty), is not the department itself or
+// one of its descendants
+func (r *Resolver) checkDepartmentParent(ctx context.Context, deptID, parentID string) error {
+	seen := map[string]bool{}
+	for id := parentID; !seen[id]; {
+		seen[id] = true
+		if id == deptID {
+			return fmt.Errorf("department %s cannot be placed below itself", deptID)
+		}
+		dept, err := r.DeptRepo.FindByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				if id == parentID {
+					return fmt.Errorf("department with ID %s not found", parentID)
+				}
+				return nil // Above the visible part of the tree
+			}
+			return fmt.Errorf("failed to validate parent department: %w", err)
+		}
+		if dept.ParentID == nil {
+			return nil
+		}
+		id = *dept.ParentID
+	}
+	return nil
+}
+
+// teamWriteError maps repository errors of project team writes to
+// client-facing errors; field names the input holding the team
+func teamWriteError(action, field string, err error) error {
+	if errors.Is(err, database.ErrEmployeeTerminated) {
+		return validationError(validation.Errors{{
+			Field:   field,
+			Rule:    "active",
+			Message: database.ErrEmployeeTerminated.Error(),
+		}})
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
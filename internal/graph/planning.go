@@ -0,0 +1,54 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-crud-api/internal/database"
+)
+
+// ============================================================================
+// Milestone and Task Helper Functions
+// ============================================================================
+
+// checkTaskRefs verifies that a task's milestone belongs to the same project.
+// A nil ID is skipped. That the assignee is on the project team is checked by
+// the repository, in the transaction writing the task.
+func (r *Resolver) checkTaskRefs(ctx context.Context, projectID string, milestoneID *string) error {
+	if milestoneID != nil {
+		milestone, err := r.ProjRepo.FindMilestoneByID(ctx, *milestoneID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return fmt.Errorf("milestone with ID %s not found", *milestoneID)
+			}
+			return fmt.Errorf("failed to validate milestone: %w", err)
+		}
+		if milestone.ProjectID != projectID {
+			return fmt.Errorf("milestone %s belongs to another project", *milestoneID)
+		}
+	}
+
+	return nil
+}
+
+// taskWriteError maps repository errors of task writes to client-facing errors
+func taskWriteError(action string, assigneeID *string, err error) error {
+	if errors.Is(err, database.ErrNotTeamMember) && assigneeID != nil {
+		return fmt.Errorf("employee %s is not a member of the project team", *assigneeID)
+	}
+	return fmt.Errorf("failed to %s task: %w", action, err)
+}
+
+// clearableID maps an update input ID to its new value: nil keeps current,
+// an empty string clears it
+func clearableID(current, input *string) *string {
+	switch {
+	case input == nil:
+		return current
+	case *input == "":
+		return nil
+	default:
+		return input
+	}
+}
@@ -8,10 +8,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"gin-crud-api/internal/auth"
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/graph/model"
 	"gin-crud-api/internal/logger"
 	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/validation"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,22 +23,17 @@ import (
 // CreateProject is the resolver for the createProject field.
 func (r *mutationResolver) CreateProject(ctx context.Context, input model.CreateProjectInput) (*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "createProject").
 		Str("name", input.Name).
 		Msg("Creating project")
 
-	// Validate required fields
-	if input.Name == "" {
-		log.Error().Msg("Validation failed: project name is required")
-		return nil, errors.New("project name is required")
-	}
-
-	if input.Budget <= 0 {
-		log.Error().Msg("Validation failed: budget must be positive")
-		return nil, errors.New("budget must be positive")
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("project", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
 	}
 
 	// Validate and parse dates
@@ -57,11 +55,16 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 		return nil, errors.New("end date must be after start date")
 	}
 
-	// Set default values for optional fields
-	status := model.ProjectStatusActive
+	// Set default values for optional fields; the workflow decides which
+	// statuses a project may start in
+	status := r.Workflow.InitialStatus()
 	if input.Status != nil {
 		status = *input.Status
 	}
+	if !r.Workflow.CanStartIn(status) {
+		log.Error().Str("status", string(status)).Msg("Invalid initial project status")
+		return nil, invalidInitialStatusError(status, r.Workflow.InitialStatuses())
+	}
 
 	priority := model.ProjectPriorityMedium
 	if input.Priority != nil {
@@ -72,7 +75,7 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 	var teamMembers []*model.Employee
 	if len(input.TeamMemberIDs) > 0 {
 		for _, empID := range input.TeamMemberIDs {
-			emp, err := r.EmpRepo.FindByID(empID)
+			emp, err := r.EmpRepo.FindByID(ctx, empID)
 			if err != nil {
 				if errors.Is(err, database.ErrNotFound) {
 					log.Error().Str("employee_id", empID).Msg("Employee not found")
@@ -85,6 +88,14 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 		}
 	}
 
+	// Validate the lead exists
+	if input.LeadID != nil {
+		if err := r.checkEmployeeExists(ctx, *input.LeadID); err != nil {
+			log.Error().Err(err).Msg("Failed to validate project lead")
+			return nil, err
+		}
+	}
+
 	// Create project model
 	project := &model.Project{
 		ID:          uuid.New().String(),
@@ -94,6 +105,7 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 		StartDate:   input.StartDate,
 		EndDate:     input.EndDate,
 		Budget:      input.Budget,
+		LeadID:      input.LeadID,
 		TeamMembers: teamMembers,
 	}
 
@@ -102,9 +114,9 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 	}
 
 	// Save to repository
-	if err := r.ProjRepo.Save(project); err != nil {
+	if err := r.ProjRepo.Save(ctx, project); err != nil {
 		log.Error().Err(err).Msg("Failed to save project")
-		return nil, fmt.Errorf("failed to create project: %w", err)
+		return nil, teamWriteError("create project", "teamMemberIDs", err)
 	}
 
 	log.Info().
@@ -118,7 +130,7 @@ func (r *mutationResolver) CreateProject(ctx context.Context, input model.Create
 // UpdateProject is the resolver for the updateProject field.
 func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input model.UpdateProjectInput) (*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "updateProject").
@@ -126,7 +138,7 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 		Msg("Updating project")
 
 	// Check if project exists
-	existing, err := r.ProjRepo.FindByID(id)
+	existing, err := r.ProjRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Error().Str("project_id", id).Msg("Project not found")
@@ -136,11 +148,14 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 		return nil, fmt.Errorf("failed to find project: %w", err)
 	}
 
+	// Validate provided fields against the configured rules
+	if err := r.Validator.ValidatePartial("project", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
 	// Apply updates (only update provided fields)
 	if input.Name != nil {
-		if *input.Name == "" {
-			return nil, errors.New("project name cannot be empty")
-		}
 		existing.Name = *input.Name
 	}
 
@@ -148,8 +163,9 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 		existing.Description = input.Description
 	}
 
-	if input.Status != nil {
-		existing.Status = *input.Status
+	// Status changes must go through the workflow
+	if input.Status != nil && *input.Status != existing.Status {
+		return nil, fmt.Errorf("status cannot be changed with updateProject, use transitionProject")
 	}
 
 	if input.Priority != nil {
@@ -180,17 +196,22 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 	}
 
 	if input.Budget != nil {
-		if *input.Budget <= 0 {
-			return nil, errors.New("budget must be positive")
-		}
 		existing.Budget = *input.Budget
 	}
 
+	// An empty lead ID removes the lead
+	if input.LeadID != nil && *input.LeadID != "" {
+		if err := r.checkEmployeeExists(ctx, *input.LeadID); err != nil {
+			return nil, err
+		}
+	}
+	existing.LeadID = clearableID(existing.LeadID, input.LeadID)
+
 	// Update team members if provided
 	if input.TeamMemberIDs != nil {
 		var teamMembers []*model.Employee
 		for _, empID := range input.TeamMemberIDs {
-			emp, err := r.EmpRepo.FindByID(empID)
+			emp, err := r.EmpRepo.FindByID(ctx, empID)
 			if err != nil {
 				if errors.Is(err, database.ErrNotFound) {
 					return nil, fmt.Errorf("employee with ID %s not found", empID)
@@ -203,9 +224,9 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 	}
 
 	// Save updates
-	if err := r.ProjRepo.Update(existing); err != nil {
+	if err := r.ProjRepo.Update(ctx, existing); err != nil {
 		log.Error().Err(err).Msg("Failed to update project")
-		return nil, fmt.Errorf("failed to update project: %w", err)
+		return nil, teamWriteError("update project", "teamMemberIDs", err)
 	}
 
 	log.Info().
@@ -216,9 +237,9 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input m
 }
 
 // DeleteProject is the resolver for the deleteProject field.
-func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (bool, error) {
+func (r *mutationResolver) DeleteProject(ctx context.Context, id string, clientMutationID *string) (bool, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "deleteProject").
@@ -226,7 +247,7 @@ func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (bool,
 		Msg("Deleting project")
 
 	// Delete project
-	if err := r.ProjRepo.Delete(id); err != nil {
+	if err := r.ProjRepo.Delete(ctx, id); err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Error().Str("project_id", id).Msg("Project not found")
 			return false, fmt.Errorf("project with ID %s not found", id)
@@ -245,7 +266,7 @@ func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (bool,
 // AddEmployeeToProject is the resolver for the addEmployeeToProject field.
 func (r *mutationResolver) AddEmployeeToProject(ctx context.Context, projectID string, employeeID string) (*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "addEmployeeToProject").
@@ -254,7 +275,7 @@ func (r *mutationResolver) AddEmployeeToProject(ctx context.Context, projectID s
 		Msg("Adding employee to project")
 
 	// Verify employee exists
-	_, err := r.EmpRepo.FindByID(employeeID)
+	_, err := r.EmpRepo.FindByID(ctx, employeeID)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, fmt.Errorf("employee with ID %s not found", employeeID)
@@ -263,16 +284,16 @@ func (r *mutationResolver) AddEmployeeToProject(ctx context.Context, projectID s
 	}
 
 	// Add team member
-	if err := r.ProjRepo.AddTeamMember(projectID, employeeID); err != nil {
+	if err := r.ProjRepo.AddTeamMember(ctx, projectID, employeeID); err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, fmt.Errorf("project with ID %s not found", projectID)
 		}
 		log.Error().Err(err).Msg("Failed to add employee to project")
-		return nil, fmt.Errorf("failed to add employee to project: %w", err)
+		return nil, teamWriteError("add employee to project", "employeeID", err)
 	}
 
 	// Return updated project
-	project, err := r.ProjRepo.FindByID(projectID)
+	project, err := r.ProjRepo.FindByID(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve updated project: %w", err)
 	}
@@ -288,7 +309,7 @@ func (r *mutationResolver) AddEmployeeToProject(ctx context.Context, projectID s
 // RemoveEmployeeFromProject is the resolver for the removeEmployeeFromProject field.
 func (r *mutationResolver) RemoveEmployeeFromProject(ctx context.Context, projectID string, employeeID string) (*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "removeEmployeeFromProject").
@@ -297,7 +318,7 @@ func (r *mutationResolver) RemoveEmployeeFromProject(ctx context.Context, projec
 		Msg("Removing employee from project")
 
 	// Remove team member
-	if err := r.ProjRepo.RemoveTeamMember(projectID, employeeID); err != nil {
+	if err := r.ProjRepo.RemoveTeamMember(ctx, projectID, employeeID); err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, fmt.Errorf("project with ID %s not found", projectID)
 		}
@@ -306,7 +327,7 @@ func (r *mutationResolver) RemoveEmployeeFromProject(ctx context.Context, projec
 	}
 
 	// Return updated project
-	project, err := r.ProjRepo.FindByID(projectID)
+	project, err := r.ProjRepo.FindByID(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve updated project: %w", err)
 	}
@@ -319,17 +340,138 @@ func (r *mutationResolver) RemoveEmployeeFromProject(ctx context.Context, projec
 	return project, nil
 }
 
+// TransitionProject is the resolver for the transitionProject field.
+func (r *mutationResolver) TransitionProject(ctx context.Context, id string, to model.ProjectStatus, reason *string) (*model.Project, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "transitionProject").
+		Str("project_id", id).
+		Str("to", string(to)).
+		Msg("Transitioning project status")
+
+	existing, err := r.ProjRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+
+	// Enforce the configured workflow
+	if !r.Workflow.CanTransition(existing.Status, to) {
+		log.Warn().
+			Str("project_id", id).
+			Str("from", string(existing.Status)).
+			Str("to", string(to)).
+			Msg("Status transition not allowed")
+		return nil, invalidTransitionError(existing.Status, to, r.Workflow.Allowed(existing.Status))
+	}
+	if r.Workflow.ReasonRequired(to) && (reason == nil || strings.TrimSpace(*reason) == "") {
+		return nil, validationError(validation.Errors{{
+			Field:   "reason",
+			Rule:    "required",
+			Message: fmt.Sprintf("a reason is required to move a project to %s", to),
+		}})
+	}
+
+	change := &model.ProjectStatusChange{
+		ProjectID: id,
+		From:      existing.Status,
+		To:        to,
+		Reason:    reason,
+	}
+	if p := auth.FromContext(ctx); p != nil {
+		change.ChangedBy = &p.ID
+	}
+
+	if err := r.ProjRepo.TransitionStatus(ctx, change); err != nil {
+		if errors.Is(err, database.ErrStatusConflict) {
+			return nil, fmt.Errorf("project status was changed concurrently, reload and retry")
+		}
+		log.Error().Err(err).Msg("Failed to transition project status")
+		return nil, fmt.Errorf("failed to transition project: %w", err)
+	}
+
+	log.Info().
+		Str("project_id", id).
+		Str("from", string(change.From)).
+		Str("to", string(change.To)).
+		Msg("Project status transitioned successfully")
+
+	existing.Status = to
+	return existing, nil
+}
+
+// Lead is the resolver for the lead field.
+func (r *projectResolver) Lead(ctx context.Context, obj *model.Project) (*model.Employee, error) {
+	if obj.LeadID == nil {
+		return nil, nil
+	}
+
+	emp, err := r.EmpRepo.FindByID(ctx, *obj.LeadID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find project lead: %w", err)
+	}
+	return emp, nil
+}
+
+// Milestones is the resolver for the milestones field.
+func (r *projectResolver) Milestones(ctx context.Context, obj *model.Project) ([]*model.Milestone, error) {
+	milestones, err := r.ProjRepo.FindMilestonesByProjectID(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch milestones for project: %w", err)
+	}
+	return milestones, nil
+}
+
+// Tasks is the resolver for the tasks field.
+func (r *projectResolver) Tasks(ctx context.Context, obj *model.Project) ([]*model.Task, error) {
+	tasks, err := r.ProjRepo.FindTasksByProjectID(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks for project: %w", err)
+	}
+	return tasks, nil
+}
+
+// Progress is the resolver for the progress field.
+func (r *projectResolver) Progress(ctx context.Context, obj *model.Project) (float64, error) {
+	progress, err := r.ProjRepo.Progress(ctx, obj.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute project progress: %w", err)
+	}
+	return progress, nil
+}
+
+// AllowedTransitions is the resolver for the allowedTransitions field.
+func (r *projectResolver) AllowedTransitions(ctx context.Context, obj *model.Project) ([]model.ProjectStatus, error) {
+	return r.Workflow.Allowed(obj.Status), nil
+}
+
+// StatusHistory is the resolver for the statusHistory field.
+func (r *projectResolver) StatusHistory(ctx context.Context, obj *model.Project) ([]*model.ProjectStatusChange, error) {
+	changes, err := r.ProjRepo.FindStatusChanges(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status history for project: %w", err)
+	}
+	return changes, nil
+}
+
 // Project is the resolver for the project field.
 func (r *queryResolver) Project(ctx context.Context, id string) (*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "project").
 		Str("project_id", id).
 		Msg("Fetching project")
 
-	project, err := r.ProjRepo.FindByID(id)
+	project, err := r.ProjRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			log.Debug().Str("project_id", id).Msg("Project not found")
@@ -349,13 +491,13 @@ func (r *queryResolver) Project(ctx context.Context, id string) (*model.Project,
 // Projects is the resolver for the projects field.
 func (r *queryResolver) Projects(ctx context.Context) ([]*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "projects").
 		Msg("Fetching all projects")
 
-	projects, err := r.ProjRepo.FindAll()
+	projects, err := r.ProjRepo.FindAll(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to fetch projects")
 		return nil, fmt.Errorf("failed to fetch projects: %w", err)
@@ -371,14 +513,14 @@ func (r *queryResolver) Projects(ctx context.Context) ([]*model.Project, error)
 // ProjectsByStatus is the resolver for the projectsByStatus field.
 func (r *queryResolver) ProjectsByStatus(ctx context.Context, status model.ProjectStatus) ([]*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "projectsByStatus").
 		Str("status", string(status)).
 		Msg("Fetching projects by status")
 
-	projects, err := r.ProjRepo.FindByStatus(status)
+	projects, err := r.ProjRepo.FindByStatus(ctx, status)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to fetch projects by status")
 		return nil, fmt.Errorf("failed to fetch projects by status: %w", err)
@@ -395,7 +537,7 @@ func (r *queryResolver) ProjectsByStatus(ctx context.Context, status model.Proje
 // ProjectsByEmployee is the resolver for the projectsByEmployee field.
 func (r *queryResolver) ProjectsByEmployee(ctx context.Context, employeeID string) ([]*model.Project, error) {
 	requestID := middleware.GetRequestID(ctx)
-	log := logger.WithRequestID(requestID)
+	log := logger.WithRequestID(ctx, requestID)
 
 	log.Info().
 		Str("operation", "projectsByEmployee").
@@ -403,7 +545,7 @@ func (r *queryResolver) ProjectsByEmployee(ctx context.Context, employeeID strin
 		Msg("Fetching projects by employee")
 
 	// Verify employee exists
-	_, err := r.EmpRepo.FindByID(employeeID)
+	_, err := r.EmpRepo.FindByID(ctx, employeeID)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			return nil, fmt.Errorf("employee with ID %s not found", employeeID)
@@ -411,7 +553,7 @@ func (r *queryResolver) ProjectsByEmployee(ctx context.Context, employeeID strin
 		return nil, fmt.Errorf("failed to validate employee: %w", err)
 	}
 
-	projects, err := r.ProjRepo.FindByEmployeeID(employeeID)
+	projects, err := r.ProjRepo.FindByEmployeeID(ctx, employeeID)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to fetch projects by employee")
 		return nil, fmt.Errorf("failed to fetch projects by employee: %w", err)
@@ -424,3 +566,8 @@ func (r *queryResolver) ProjectsByEmployee(ctx context.Context, employeeID strin
 
 	return projects, nil
 }
+
+// Project returns ProjectResolver implementation.
+func (r *Resolver) Project() ProjectResolver { return &projectResolver{r} }
+
+type projectResolver struct{ *Resolver }
@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/workflow"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func setupProjectResolverTest(t *testing.T) (*Resolver, context.Context, *model.Project) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	w, err := workflow.New(config.WorkflowConfig{ReasonRequired: []string{"CANCELLED"}})
+	require.NoError(t, err)
+	resolver.Workflow = w
+
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{ID: "user-1"})
+
+	planned := model.ProjectStatusPlanned
+	project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Apollo", Status: &planned, StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.NoError(t, err)
+
+	return resolver, ctx, project
+}
+
+// TestTransitionProject_RecordsHistory tests allowed transitions and their history
+func TestTransitionProject_RecordsHistory(t *testing.T) {
+	resolver, ctx, project := setupProjectResolverTest(t)
+
+	allowed, err := resolver.Project().AllowedTransitions(ctx, project)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ProjectStatus{model.ProjectStatusActive, model.ProjectStatusCancelled}, allowed)
+
+	reason := "Kick-off done"
+	updated, err := resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusActive, &reason)
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusActive, updated.Status)
+
+	_, err = resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusCompleted, nil)
+	require.NoError(t, err)
+
+	history, err := resolver.Project().StatusHistory(ctx, project)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, model.ProjectStatusPlanned, history[0].From)
+	assert.Equal(t, model.ProjectStatusActive, history[0].To)
+	assert.Equal(t, &reason, history[0].Reason)
+	assert.Equal(t, "user-1", *history[0].ChangedBy)
+	assert.Equal(t, model.ProjectStatusCompleted, history[1].To)
+	assert.Nil(t, history[1].Reason)
+}
+
+// TestCreateProject_InitialStatus tests that projects start in the workflow's initial statuses
+func TestCreateProject_InitialStatus(t *testing.T) {
+	resolver, ctx, _ := setupProjectResolverTest(t)
+
+	// Without a status the project is PLANNED
+	project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Gemini", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusPlanned, project.Status)
+
+	// Final statuses are only reached through transitions
+	completed := model.ProjectStatusCompleted
+	_, err = resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Mercury", Status: &completed, StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.Error(t, err)
+
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, workflow.ErrCodeInvalidTransition, gqlErr.Extensions["code"])
+	assert.Equal(t, []model.ProjectStatus{model.ProjectStatusPlanned, model.ProjectStatusActive}, gqlErr.Extensions["allowed"])
+}
+
+// TestTransitionProject_NotAllowed tests completed projects cannot be reopened
+func TestTransitionProject_NotAllowed(t *testing.T) {
+	resolver, ctx, project := setupProjectResolverTest(t)
+
+	_, err := resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusCompleted, nil)
+	require.Error(t, err)
+
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, workflow.ErrCodeInvalidTransition, gqlErr.Extensions["code"])
+	assert.Equal(t, []model.ProjectStatus{model.ProjectStatusActive, model.ProjectStatusCancelled}, gqlErr.Extensions["allowed"])
+
+	// Final statuses allow nothing
+	_, err = resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusActive, nil)
+	require.NoError(t, err)
+	_, err = resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusCompleted, nil)
+	require.NoError(t, err)
+	_, err = resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusActive, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot move from COMPLETED to ACTIVE")
+}
+
+// TestTransitionProject_ReasonRequired tests configured statuses need a reason
+func TestTransitionProject_ReasonRequired(t *testing.T) {
+	resolver, ctx, project := setupProjectResolverTest(t)
+
+	blank := "  "
+	_, err := resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusCancelled, &blank)
+	require.Error(t, err)
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, "VALIDATION_FAILED", gqlErr.Extensions["code"])
+
+	reason := "Customer withdrew"
+	cancelled, err := resolver.Mutation().TransitionProject(ctx, project.ID, model.ProjectStatusCancelled, &reason)
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusCancelled, cancelled.Status)
+}
+
+// TestTransitionProject_NotFound tests transitions of unknown projects
+func TestTransitionProject_NotFound(t *testing.T) {
+	resolver, ctx, _ := setupProjectResolverTest(t)
+
+	_, err := resolver.Mutation().TransitionProject(ctx, "00000000-0000-0000-0000-000000000000", model.ProjectStatusActive, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+// TestUpdateProject_StatusChangeRejected tests updateProject no longer changes the status
+func TestUpdateProject_StatusChangeRejected(t *testing.T) {
+	resolver, ctx, project := setupProjectResolverTest(t)
+
+	completed := model.ProjectStatusCompleted
+	_, err := resolver.Mutation().UpdateProject(ctx, project.ID, model.UpdateProjectInput{Status: &completed})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use transitionProject")
+
+	// The current status is accepted so existing clients keep working
+	planned := model.ProjectStatusPlanned
+	name := "Artemis"
+	updated, err := resolver.Mutation().UpdateProject(ctx, project.ID, model.UpdateProjectInput{Name: &name, Status: &planned})
+	require.NoError(t, err)
+	assert.Equal(t, "Artemis", updated.Name)
+	assert.Equal(t, model.ProjectStatusPlanned, updated.Status)
+}
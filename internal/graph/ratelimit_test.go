@@ -0,0 +1,193 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/ratelimit"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rateLimitSecret = []byte("rate-limit-secret")
+
+// setupRateLimitedServer wires /query the way cmd/graphql does: client info,
+// bearer authentication and the rate limit extension
+func setupRateLimitedServer(t *testing.T, cfg config.RateLimitConfig) http.Handler {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(middleware.NewRateLimit(ratelimit.NewMemoryStore(), &cfg))
+	srv.AroundResponses(middleware.RejectionLoggingMiddleware())
+
+	h := auth.Middleware(auth.NewVerifier(rateLimitSecret, ""), false)(srv)
+	return middleware.RequestInfoMiddleware(cfg.TrustProxyHeaders)(h)
+}
+
+// rateLimitedPost sends a query from ip, optionally as principal sub
+func rateLimitedPost(t *testing.T, h http.Handler, ip, sub, query string) (*httptest.ResponseRecorder, graphqlResponse) {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = ip + ":41000"
+	if sub != "" {
+		token, err := auth.Sign(auth.Claims{Subject: sub}, rateLimitSecret)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp graphqlResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return rec, resp
+}
+
+// TestRateLimit_SeparateQueryAndMutationBudgets tests that exhausting mutations leaves queries working
+func TestRateLimit_SeparateQueryAndMutationBudgets(t *testing.T) {
+	h := setupRateLimitedServer(t, config.RateLimitConfig{
+		Query:    config.RateLimitRule{RequestsPerSecond: 0.01, Burst: 5},
+		Mutation: config.RateLimitRule{RequestsPerSecond: 0.01, Burst: 1},
+	})
+
+	rec, resp := rateLimitedPost(t, h, "10.0.0.1", "alice", `mutation { createDepartment(input: {name: "A"}) { id } }`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, resp.Errors)
+
+	rec, resp = rateLimitedPost(t, h, "10.0.0.1", "alice", `mutation { createDepartment(input: {name: "B"}) { id } }`)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "100", rec.Header().Get("Retry-After"))
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, middleware.ErrCodeRateLimited, resp.Errors[0].Extensions["code"])
+	assert.Equal(t, float64(100), resp.Errors[0].Extensions["retryAfter"])
+	assert.Equal(t, "mutation", resp.Errors[0].Extensions["budget"])
+	assert.Nil(t, resp.Data)
+
+	rec, resp = rateLimitedPost(t, h, "10.0.0.1", "alice", `{ departments { id } }`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestRateLimit_KeyedByPrincipalOrIP tests that budgets follow the principal, else the IP
+func TestRateLimit_KeyedByPrincipalOrIP(t *testing.T) {
+	h := setupRateLimitedServer(t, config.RateLimitConfig{
+		Query: config.RateLimitRule{RequestsPerSecond: 0.01, Burst: 1},
+	})
+	query := `{ departments { id } }`
+
+	// Anonymous clients are limited per IP
+	rec, _ := rateLimitedPost(t, h, "10.0.0.1", "", query)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec, _ = rateLimitedPost(t, h, "10.0.0.1", "", query)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	rec, _ = rateLimitedPost(t, h, "10.0.0.2", "", query)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// An authenticated principal has its own budget regardless of IP
+	rec, _ = rateLimitedPost(t, h, "10.0.0.1", "alice", query)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec, _ = rateLimitedPost(t, h, "10.0.0.3", "alice", query)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+// TestRateLimit_OperationOverride tests per root field budgets on top of the mutation budget
+func TestRateLimit_OperationOverride(t *testing.T) {
+	h := setupRateLimitedServer(t, config.RateLimitConfig{
+		Mutation: config.RateLimitRule{RequestsPerSecond: 10, Burst: 100},
+		Operations: map[string]config.RateLimitRule{
+			// viper lower-cases keys; matching is case-insensitive
+			"createdepartment": {RequestsPerSecond: 0.5, Burst: 1},
+		},
+	})
+
+	rec, _ := rateLimitedPost(t, h, "10.0.0.1", "", `mutation { createDepartment(input: {name: "A"}) { id } }`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Aliases and fragments do not dodge the override
+	rec, resp := rateLimitedPost(t, h, "10.0.0.1", "",
+		`mutation { ... on Mutation { again: createDepartment(input: {name: "B"}) { id } } }`)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "field:createDepartment", resp.Errors[0].Extensions["budget"])
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+	// Other mutations only use the shared mutation budget
+	rec, resp = rateLimitedPost(t, h, "10.0.0.1", "", `mutation { deleteDepartment(id: "00000000-0000-0000-0000-000000000000") }`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	for _, err := range resp.Errors {
+		assert.NotEqual(t, middleware.ErrCodeRateLimited, err.Extensions["code"])
+	}
+}
+
+// TestRateLimit_RejectionRefundsOtherBudgets tests that an operation rejected by
+// its override does not use up the shared mutation budget
+func TestRateLimit_RejectionRefundsOtherBudgets(t *testing.T) {
+	h := setupRateLimitedServer(t, config.RateLimitConfig{
+		Mutation: config.RateLimitRule{RequestsPerSecond: 0.01, Burst: 2},
+		Operations: map[string]config.RateLimitRule{
+			"createdepartment": {RequestsPerSecond: 0.01, Burst: 1},
+		},
+	})
+	create := `mutation { createDepartment(input: {name: "A"}) { id } }`
+	remove := `mutation { deleteDepartment(id: "00000000-0000-0000-0000-000000000000") }`
+
+	rec, _ := rateLimitedPost(t, h, "10.0.0.1", "", create)
+	require.Equal(t, http.StatusOK, rec.Code)
+	for i := 0; i < 3; i++ {
+		rec, resp := rateLimitedPost(t, h, "10.0.0.1", "", create)
+		require.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "field:createDepartment", resp.Errors[0].Extensions["budget"])
+	}
+
+	// One of the two mutation tokens is left
+	rec, _ = rateLimitedPost(t, h, "10.0.0.1", "", remove)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec, resp := rateLimitedPost(t, h, "10.0.0.1", "", remove)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "mutation", resp.Errors[0].Extensions["budget"])
+}
+
+// TestRateLimit_TrustedProxyHeaders tests client IP extraction behind a proxy
+func TestRateLimit_TrustedProxyHeaders(t *testing.T) {
+	h := setupRateLimitedServer(t, config.RateLimitConfig{
+		TrustProxyHeaders: true,
+		Query:             config.RateLimitRule{RequestsPerSecond: 0.01, Burst: 1},
+	})
+
+	send := func(forwardedFor string) int {
+		body, _ := json.Marshal(map[string]string{"query": `{ departments { id } }`})
+		req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Same proxy address, different original clients
+	assert.Equal(t, http.StatusOK, send("203.0.113.1, 10.0.0.1"))
+	assert.Equal(t, http.StatusOK, send("203.0.113.2, 10.0.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, send("203.0.113.1"))
+}
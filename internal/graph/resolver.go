@@ -1,7 +1,11 @@
 package graph
 
 import (
+	"gin-crud-api/internal/config"
 	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/health"
+	"gin-crud-api/internal/validation"
+	"gin-crud-api/internal/workflow"
 )
 
 // This file will not be regenerated automatically.
@@ -12,6 +16,27 @@ type Resolver struct {
 	DeptRepo database.DepartmentRepository
 	EmpRepo  database.EmployeeRepository
 	ProjRepo database.ProjectRepository
+
+	// SkillRepo backs the skill catalog and staffing suggestions
+	SkillRepo database.SkillRepository
+
+	// WebhookRepo stores webhook subscriptions and their deliveries
+	WebhookRepo database.WebhookRepository
+
+	// AllowPrivateWebhookTargets lets subscriptions target loopback, private and link-local addresses
+	AllowPrivateWebhookTargets bool
+
+	// HealthChecker backs the health query; nil reports OK without checks
+	HealthChecker *health.Checker
+
+	// Validator checks mutation inputs (default rules unless replaced)
+	Validator *validation.Validator
+
+	// Workflow decides which project status transitions are allowed
+	Workflow *workflow.ProjectWorkflow
+
+	// WorkloadLimits decide when the workload report flags an employee (zero values use defaults)
+	WorkloadLimits config.WorkloadConfig
 }
 
 // NewResolver creates a new resolver with injected dependencies
@@ -20,5 +45,8 @@ func NewResolver(deptRepo database.DepartmentRepository, empRepo database.Employ
 		DeptRepo: deptRepo,
 		EmpRepo:  empRepo,
 		ProjRepo: projRepo,
+
+		Validator: validation.Default(),
+		Workflow:  workflow.Default(),
 	}
 }
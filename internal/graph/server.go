@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gin-crud-api/internal/ent/privacy"
+	"gin-crud-api/internal/pii"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeForbidden is the extensions.code returned when an Ent privacy policy
+// denies the viewer access to a record
+const ErrCodeForbidden = "FORBIDDEN"
+
+// NewServer creates the GraphQL handler with the same transports and caches as
+// handler.NewDefaultServer, but only allows introspection when requested
+// (production hides the schema).
+func NewServer(es graphql.ExecutableSchema, introspection bool) *handler.Server {
+	srv := handler.New(es)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.SetErrorPresenter(presentError)
+	srv.AroundFields(maskPII)
+
+	if introspection {
+		srv.Use(extension.Introspection{})
+	}
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
+
+	return srv
+}
+
+// presentError tags errors caused by a privacy policy denial with FORBIDDEN
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if errors.Is(err, privacy.Deny) {
+		errcode.Set(gqlErr, ErrCodeForbidden)
+	}
+	return gqlErr
+}
+
+// maskPII masks the values of @pii fields for callers without the pii:read
+// permission
+func maskPII(ctx context.Context, next graphql.Resolver) (any, error) {
+	res, err := next(ctx)
+	if err != nil || pii.CanRead(ctx) {
+		return res, err
+	}
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Definition == nil || fc.Field.Definition.Directives.ForName("pii") == nil {
+		return res, err
+	}
+	switch v := res.(type) {
+	case string:
+		return pii.Mask(v), nil
+	case *string:
+		if v != nil {
+			masked := pii.Mask(*v)
+			return &masked, nil
+		}
+	}
+	return res, err
+}
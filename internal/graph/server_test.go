@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/ent/schema"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/pii"
+	"gin-crud-api/internal/testutil"
+
+	entschema "entgo.io/ent/schema"
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, introspection bool) http.Handler {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	return NewServer(NewExecutableSchema(Config{Resolvers: resolver}), introspection)
+}
+
+// TestNewServer_Introspection tests that introspection follows the configuration
+func TestNewServer_Introspection(t *testing.T) {
+	_, resp := postQuery(t, newTestServer(t, true), `{ __schema { queryType { name } } }`)
+	require.Empty(t, resp.Errors)
+	assert.Contains(t, resp.Data, "__schema")
+
+	_, resp = postQuery(t, newTestServer(t, false), `{ __schema { queryType { name } } }`)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "introspection disabled")
+}
+
+// TestNewServer_QueriesWithoutIntrospection tests that ordinary queries are unaffected
+func TestNewServer_QueriesWithoutIntrospection(t *testing.T) {
+	status, resp := postQuery(t, newTestServer(t, false), `{ departments { id } }`)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Empty(t, resp.Errors)
+}
+
+// TestNewServer_MasksPII tests that @pii fields are masked unless the caller
+// holds the pii:read permission or is the system (auth disabled)
+func TestNewServer_MasksPII(t *testing.T) {
+	// Setup
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+	deptRepo := database.NewEntDepartmentRepo(client)
+	empRepo := database.NewEntEmployeeRepo(client)
+	dept := &model.Department{ID: uuid.NewString(), Name: "Engineering"}
+	require.NoError(t, deptRepo.Save(auth.WithSystem(context.Background()), dept))
+	phone := "+1 555 0100"
+	require.NoError(t, empRepo.Save(auth.WithSystem(context.Background()), &model.Employee{
+		ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID, Phone: &phone,
+	}))
+	srv := NewServer(NewExecutableSchema(Config{Resolvers: NewResolver(deptRepo, empRepo, database.NewEntProjectRepo(client))}), false)
+	as := func(p *auth.Principal) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			srv.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), p)))
+		})
+	}
+	emailOf := func(resp graphqlResponse) any {
+		require.Empty(t, resp.Errors)
+		return resp.Data["employees"].([]any)[0].(map[string]any)["email"]
+	}
+
+	// Test
+	_, other := postQuery(t, as(&auth.Principal{ID: "ops", Roles: []string{auth.RoleAdmin}}), `{ employees { name email phone } }`)
+	_, reader := postQuery(t, as(&auth.Principal{ID: "hr", Permissions: []string{pii.PermissionRead}}), `{ employees { email } }`)
+	_, system := postQuery(t, srv, `{ employees { email phone } }`)
+
+	// Assert
+	assert.Equal(t, "a***@example.com", emailOf(other))
+	assert.Equal(t, "***", other.Data["employees"].([]any)[0].(map[string]any)["phone"])
+	assert.Equal(t, "Ann", other.Data["employees"].([]any)[0].(map[string]any)["name"])
+	assert.Equal(t, "ann@example.com", emailOf(reader))
+	assert.Equal(t, "ann@example.com", emailOf(system))
+	assert.Equal(t, phone, system.Data["employees"].([]any)[0].(map[string]any)["phone"])
+}
+
+// TestPIIClassification tests that every Ent field annotated as PII carries
+// @pii in the GraphQL schema and is scrubbed from logs
+func TestPIIClassification(t *testing.T) {
+	gqlSchema := NewExecutableSchema(Config{}).Schema()
+	classified := 0
+
+	for _, field := range (schema.Employee{}).Fields() {
+		desc := field.Descriptor()
+		if !slices.ContainsFunc(desc.Annotations, func(a entschema.Annotation) bool { return a.Name() == (pii.Annotation{}).Name() }) {
+			continue
+		}
+		classified++
+		gqlField := gqlSchema.Types["Employee"].Fields.ForName(desc.Name)
+		require.NotNil(t, gqlField, desc.Name)
+		assert.NotNil(t, gqlField.Directives.ForName("pii"), "Employee.%s lacks @pii", desc.Name)
+		assert.True(t, pii.IsKey(desc.Name), "%s is not a pii.Keys entry", desc.Name)
+	}
+	assert.NotZero(t, classified)
+}
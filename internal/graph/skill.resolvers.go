@@ -0,0 +1,368 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.82
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+
+	"github.com/google/uuid"
+)
+
+// Skills is the resolver for the skills field.
+func (r *employeeResolver) Skills(ctx context.Context, obj *model.Employee) ([]*model.EmployeeSkill, error) {
+	skills, err := r.SkillRepo.FindEmployeeSkills(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch skills for employee: %w", err)
+	}
+	return skills, nil
+}
+
+// CreateSkill is the resolver for the createSkill field.
+func (r *mutationResolver) CreateSkill(ctx context.Context, input model.CreateSkillInput) (*model.Skill, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "createSkill").
+		Str("name", input.Name).
+		Msg("Creating skill")
+
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("skill", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	s := &model.Skill{
+		ID:   uuid.New().String(),
+		Name: input.Name,
+	}
+	if input.Category != nil && *input.Category != "" {
+		s.Category = input.Category
+	}
+
+	if err := r.SkillRepo.Save(ctx, s); err != nil {
+		if errors.Is(err, database.ErrAlreadyExists) {
+			return nil, fmt.Errorf("skill %q already exists", input.Name)
+		}
+		log.Error().Err(err).Msg("Failed to save skill")
+		return nil, fmt.Errorf("failed to create skill: %w", err)
+	}
+
+	log.Info().
+		Str("skill_id", s.ID).
+		Msg("Skill created successfully")
+
+	return s, nil
+}
+
+// UpdateSkill is the resolver for the updateSkill field.
+func (r *mutationResolver) UpdateSkill(ctx context.Context, id string, input model.UpdateSkillInput) (*model.Skill, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "updateSkill").
+		Str("skill_id", id).
+		Msg("Updating skill")
+
+	// Validate provided fields against the configured rules
+	if err := r.Validator.ValidatePartial("skill", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	existing, err := r.SkillRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("skill with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to find skill: %w", err)
+	}
+
+	// Apply updates (only update provided fields, an empty category clears it)
+	if input.Name != nil {
+		existing.Name = *input.Name
+	}
+	existing.Category = clearableID(existing.Category, input.Category)
+
+	if err := r.SkillRepo.Update(ctx, existing); err != nil {
+		if errors.Is(err, database.ErrAlreadyExists) {
+			return nil, fmt.Errorf("skill %q already exists", existing.Name)
+		}
+		log.Error().Err(err).Msg("Failed to update skill")
+		return nil, fmt.Errorf("failed to update skill: %w", err)
+	}
+
+	log.Info().
+		Str("skill_id", id).
+		Msg("Skill updated successfully")
+
+	return existing, nil
+}
+
+// DeleteSkill is the resolver for the deleteSkill field.
+func (r *mutationResolver) DeleteSkill(ctx context.Context, id string, clientMutationID *string) (bool, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "deleteSkill").
+		Str("skill_id", id).
+		Msg("Deleting skill")
+
+	// Employee levels and project requirements are removed with the skill
+	if err := r.SkillRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, fmt.Errorf("skill with ID %s not found", id)
+		}
+		log.Error().Err(err).Msg("Failed to delete skill")
+		return false, fmt.Errorf("failed to delete skill: %w", err)
+	}
+
+	log.Info().
+		Str("skill_id", id).
+		Msg("Skill deleted successfully")
+
+	return true, nil
+}
+
+// SetEmployeeSkill is the resolver for the setEmployeeSkill field.
+func (r *mutationResolver) SetEmployeeSkill(ctx context.Context, employeeID string, skillID string, level int) (*model.Employee, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "setEmployeeSkill").
+		Str("employee_id", employeeID).
+		Str("skill_id", skillID).
+		Int("level", level).
+		Msg("Setting employee skill")
+
+	if err := checkSkillLevel("level", level); err != nil {
+		return nil, err
+	}
+
+	emp, err := r.EmpRepo.FindByID(ctx, employeeID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, fmt.Errorf("failed to find employee: %w", err)
+	}
+	if _, err := r.SkillRepo.FindByID(ctx, skillID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("skill with ID %s not found", skillID)
+		}
+		return nil, fmt.Errorf("failed to find skill: %w", err)
+	}
+
+	if err := r.SkillRepo.SetEmployeeSkill(ctx, employeeID, skillID, level); err != nil {
+		log.Error().Err(err).Msg("Failed to set employee skill")
+		return nil, fmt.Errorf("failed to set employee skill: %w", err)
+	}
+
+	log.Info().
+		Str("employee_id", employeeID).
+		Str("skill_id", skillID).
+		Msg("Employee skill set successfully")
+
+	return emp, nil
+}
+
+// RemoveEmployeeSkill is the resolver for the removeEmployeeSkill field.
+func (r *mutationResolver) RemoveEmployeeSkill(ctx context.Context, employeeID string, skillID string) (*model.Employee, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "removeEmployeeSkill").
+		Str("employee_id", employeeID).
+		Str("skill_id", skillID).
+		Msg("Removing employee skill")
+
+	emp, err := r.EmpRepo.FindByID(ctx, employeeID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, fmt.Errorf("failed to find employee: %w", err)
+	}
+
+	if err := r.SkillRepo.RemoveEmployeeSkill(ctx, employeeID, skillID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("employee does not have skill %s", skillID)
+		}
+		log.Error().Err(err).Msg("Failed to remove employee skill")
+		return nil, fmt.Errorf("failed to remove employee skill: %w", err)
+	}
+
+	log.Info().
+		Str("employee_id", employeeID).
+		Str("skill_id", skillID).
+		Msg("Employee skill removed successfully")
+
+	return emp, nil
+}
+
+// SetProjectRequiredSkill is the resolver for the setProjectRequiredSkill field.
+func (r *mutationResolver) SetProjectRequiredSkill(ctx context.Context, projectID string, skillID string, minLevel *int) (*model.Project, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	level := minSkillLevel
+	if minLevel != nil {
+		level = *minLevel
+	}
+
+	log.Info().
+		Str("operation", "setProjectRequiredSkill").
+		Str("project_id", projectID).
+		Str("skill_id", skillID).
+		Int("min_level", level).
+		Msg("Setting project required skill")
+
+	if err := checkSkillLevel("minLevel", level); err != nil {
+		return nil, err
+	}
+
+	proj, err := r.ProjRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", projectID)
+		}
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+	if _, err := r.SkillRepo.FindByID(ctx, skillID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("skill with ID %s not found", skillID)
+		}
+		return nil, fmt.Errorf("failed to find skill: %w", err)
+	}
+
+	if err := r.SkillRepo.SetProjectSkill(ctx, projectID, skillID, level); err != nil {
+		log.Error().Err(err).Msg("Failed to set project required skill")
+		return nil, fmt.Errorf("failed to set project required skill: %w", err)
+	}
+
+	log.Info().
+		Str("project_id", projectID).
+		Str("skill_id", skillID).
+		Msg("Project required skill set successfully")
+
+	return proj, nil
+}
+
+// RemoveProjectRequiredSkill is the resolver for the removeProjectRequiredSkill field.
+func (r *mutationResolver) RemoveProjectRequiredSkill(ctx context.Context, projectID string, skillID string) (*model.Project, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "removeProjectRequiredSkill").
+		Str("project_id", projectID).
+		Str("skill_id", skillID).
+		Msg("Removing project required skill")
+
+	proj, err := r.ProjRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", projectID)
+		}
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+
+	if err := r.SkillRepo.RemoveProjectSkill(ctx, projectID, skillID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project does not require skill %s", skillID)
+		}
+		log.Error().Err(err).Msg("Failed to remove project required skill")
+		return nil, fmt.Errorf("failed to remove project required skill: %w", err)
+	}
+
+	log.Info().
+		Str("project_id", projectID).
+		Str("skill_id", skillID).
+		Msg("Project required skill removed successfully")
+
+	return proj, nil
+}
+
+// RequiredSkills is the resolver for the requiredSkills field.
+func (r *projectResolver) RequiredSkills(ctx context.Context, obj *model.Project) ([]*model.RequiredSkill, error) {
+	skills, err := r.SkillRepo.FindProjectSkills(ctx, obj.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch required skills for project: %w", err)
+	}
+	return skills, nil
+}
+
+// Skill is the resolver for the skill field.
+func (r *queryResolver) Skill(ctx context.Context, id string) (*model.Skill, error) {
+	s, err := r.SkillRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil // GraphQL convention: return nil for not found
+		}
+		return nil, fmt.Errorf("failed to find skill: %w", err)
+	}
+	return s, nil
+}
+
+// Skills is the resolver for the skills field.
+func (r *queryResolver) Skills(ctx context.Context) ([]*model.Skill, error) {
+	skills, err := r.SkillRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch skills: %w", err)
+	}
+	return skills, nil
+}
+
+// SuggestTeamMembers is the resolver for the suggestTeamMembers field.
+func (r *queryResolver) SuggestTeamMembers(ctx context.Context, projectID string, first *int) ([]*model.TeamMemberSuggestion, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	limit := 10
+	if first != nil {
+		limit = *first
+	}
+
+	log.Info().
+		Str("operation", "suggestTeamMembers").
+		Str("project_id", projectID).
+		Int("first", limit).
+		Msg("Suggesting team members")
+
+	if limit < 1 || limit > maxSuggestions {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxSuggestions)
+	}
+
+	if _, err := r.ProjRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", projectID)
+		}
+		return nil, fmt.Errorf("failed to find project: %w", err)
+	}
+
+	suggestions, err := r.SkillRepo.SuggestTeamMembers(ctx, projectID, limit)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to suggest team members")
+		return nil, fmt.Errorf("failed to suggest team members: %w", err)
+	}
+
+	log.Info().
+		Str("project_id", projectID).
+		Int("count", len(suggestions)).
+		Msg("Team members suggested successfully")
+
+	return suggestions, nil
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/validation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func setupSkillResolverTest(t *testing.T) (*Resolver, context.Context, *model.Project, *model.Skill) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	resolver.SkillRepo = database.NewEntSkillRepo(client)
+
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
+
+	project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Apollo", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.NoError(t, err)
+
+	skill, err := resolver.Mutation().CreateSkill(ctx, model.CreateSkillInput{Name: "Go", Category: ptr("language")})
+	require.NoError(t, err)
+
+	return resolver, ctx, project, skill
+}
+
+func createSkillTestEmployee(t *testing.T, resolver *Resolver, ctx context.Context, name string) *model.Employee {
+	depts, err := resolver.Query().Departments(ctx)
+	require.NoError(t, err)
+	var deptID string
+	if len(depts) > 0 {
+		deptID = depts[0].ID
+	} else {
+		dept, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Engineering"})
+		require.NoError(t, err)
+		deptID = dept.ID
+	}
+
+	emp, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: name, Email: name + "@example.com", DepartmentID: deptID,
+	})
+	require.NoError(t, err)
+	return emp
+}
+
+// TestCreateSkill_Duplicate tests skill names are unique
+func TestCreateSkill_Duplicate(t *testing.T) {
+	resolver, ctx, _, _ := setupSkillResolverTest(t)
+
+	_, err := resolver.Mutation().CreateSkill(ctx, model.CreateSkillInput{Name: "Go"})
+	assert.EqualError(t, err, `skill "Go" already exists`)
+}
+
+// TestSetEmployeeSkill_LevelOutOfRange tests proficiency levels are 1-5
+func TestSetEmployeeSkill_LevelOutOfRange(t *testing.T) {
+	resolver, ctx, _, skill := setupSkillResolverTest(t)
+	emp := createSkillTestEmployee(t, resolver, ctx, "ann")
+
+	_, err := resolver.Mutation().SetEmployeeSkill(ctx, emp.ID, skill.ID, 6)
+	require.Error(t, err)
+
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, validation.ErrCodeValidationFailed, gqlErr.Extensions["code"])
+	assert.Equal(t, "level: level must be between 1 and 5", gqlErr.Message)
+}
+
+// TestSuggestTeamMembers tests candidates are ranked by skill match and exclude the team
+func TestSuggestTeamMembers(t *testing.T) {
+	resolver, ctx, project, skill := setupSkillResolverTest(t)
+
+	_, err := resolver.Mutation().SetProjectRequiredSkill(ctx, project.ID, skill.ID, ptr(3))
+	require.NoError(t, err)
+	required, err := resolver.Project().RequiredSkills(ctx, project)
+	require.NoError(t, err)
+	require.Len(t, required, 1)
+	assert.Equal(t, 3, required[0].MinLevel)
+
+	ann := createSkillTestEmployee(t, resolver, ctx, "ann")
+	bob := createSkillTestEmployee(t, resolver, ctx, "bob")
+	cid := createSkillTestEmployee(t, resolver, ctx, "cid")
+	_, err = resolver.Mutation().SetEmployeeSkill(ctx, ann.ID, skill.ID, 2)
+	require.NoError(t, err)
+	_, err = resolver.Mutation().SetEmployeeSkill(ctx, bob.ID, skill.ID, 4)
+	require.NoError(t, err)
+	_, err = resolver.Mutation().SetEmployeeSkill(ctx, cid.ID, skill.ID, 5)
+	require.NoError(t, err)
+	_, err = resolver.Mutation().AddEmployeeToProject(ctx, project.ID, cid.ID)
+	require.NoError(t, err)
+
+	suggestions, err := resolver.Query().SuggestTeamMembers(ctx, project.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, bob.ID, suggestions[0].Employee.ID)
+	assert.Equal(t, 1.0, suggestions[0].Score)
+	assert.Equal(t, ann.ID, suggestions[1].Employee.ID)
+	assert.InDelta(t, 2.0/3.0, suggestions[1].Score, 1e-9)
+
+	_, err = resolver.Query().SuggestTeamMembers(ctx, project.ID, ptr(0))
+	assert.EqualError(t, err, "first must be between 1 and 50")
+}
+
+// TestDeleteSkill_RemovesFromEmployees tests deleting a skill removes employee levels
+func TestDeleteSkill_RemovesFromEmployees(t *testing.T) {
+	resolver, ctx, _, skill := setupSkillResolverTest(t)
+	emp := createSkillTestEmployee(t, resolver, ctx, "ann")
+	_, err := resolver.Mutation().SetEmployeeSkill(ctx, emp.ID, skill.ID, 3)
+	require.NoError(t, err)
+
+	ok, err := resolver.Mutation().DeleteSkill(ctx, skill.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	skills, err := resolver.Employee().Skills(ctx, emp)
+	require.NoError(t, err)
+	assert.Empty(t, skills)
+}
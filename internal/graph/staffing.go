@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"fmt"
+
+	"gin-crud-api/internal/validation"
+)
+
+// ============================================================================
+// Skill and Staffing Helper Functions
+// ============================================================================
+
+// Proficiency levels range from 1 (beginner) to 5 (expert)
+const (
+	minSkillLevel = 1
+	maxSkillLevel = 5
+)
+
+// maxSuggestions caps the first argument of suggestTeamMembers
+const maxSuggestions = 50
+
+// checkSkillLevel returns a validation error when a proficiency level is out of range
+func checkSkillLevel(field string, level int) error {
+	if level >= minSkillLevel && level <= maxSkillLevel {
+		return nil
+	}
+	return validationError(validation.Errors{{
+		Field:   field,
+		Rule:    "range",
+		Message: fmt.Sprintf("%s must be between %d and %d", field, minSkillLevel, maxSkillLevel),
+	}})
+}
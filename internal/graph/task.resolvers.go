@@ -0,0 +1,208 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.82
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+
+	"github.com/google/uuid"
+)
+
+// CreateTask is the resolver for the createTask field.
+func (r *mutationResolver) CreateTask(ctx context.Context, input model.CreateTaskInput) (*model.Task, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "createTask").
+		Str("project_id", input.ProjectID).
+		Str("title", input.Title).
+		Msg("Creating task")
+
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("task", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	dueDate, err := normalizeDate(input.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due date format (use YYYY-MM-DD): %w", err)
+	}
+
+	// Verify project exists
+	if _, err := r.ProjRepo.FindByID(ctx, input.ProjectID); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("project with ID %s not found", input.ProjectID)
+		}
+		return nil, fmt.Errorf("failed to validate project: %w", err)
+	}
+
+	// Milestone must be in the project
+	if err := r.checkTaskRefs(ctx, input.ProjectID, input.MilestoneID); err != nil {
+		log.Error().Err(err).Msg("Invalid task references")
+		return nil, err
+	}
+
+	status := model.TaskStatusTodo
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	task := &model.Task{
+		ID:            uuid.New().String(),
+		ProjectID:     input.ProjectID,
+		MilestoneID:   input.MilestoneID,
+		Title:         input.Title,
+		DueDate:       dueDate,
+		Status:        status,
+		AssigneeID:    input.AssigneeID,
+		EstimateHours: input.EstimateHours,
+	}
+
+	if err := r.ProjRepo.SaveTask(ctx, task); err != nil {
+		log.Error().Err(err).Msg("Failed to save task")
+		return nil, taskWriteError("create", task.AssigneeID, err)
+	}
+
+	log.Info().
+		Str("task_id", task.ID).
+		Str("project_id", task.ProjectID).
+		Msg("Task created successfully")
+
+	return task, nil
+}
+
+// UpdateTask is the resolver for the updateTask field.
+func (r *mutationResolver) UpdateTask(ctx context.Context, id string, input model.UpdateTaskInput) (*model.Task, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "updateTask").
+		Str("task_id", id).
+		Msg("Updating task")
+
+	// Validate provided fields against the configured rules
+	if err := r.Validator.ValidatePartial("task", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+
+	existing, err := r.ProjRepo.FindTaskByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("task with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	// Only references that change are checked again
+	milestoneID := clearableID(existing.MilestoneID, input.MilestoneID)
+	assigneeID := clearableID(existing.AssigneeID, input.AssigneeID)
+	var newMilestoneID *string
+	if input.MilestoneID != nil {
+		newMilestoneID = milestoneID
+	}
+	if err := r.checkTaskRefs(ctx, existing.ProjectID, newMilestoneID); err != nil {
+		log.Error().Err(err).Msg("Invalid task references")
+		return nil, err
+	}
+
+	// Apply updates (only update provided fields)
+	existing.MilestoneID = milestoneID
+	existing.AssigneeID = assigneeID
+	if input.Title != nil {
+		existing.Title = *input.Title
+	}
+	if input.DueDate != nil {
+		dueDate, err := normalizeDate(input.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid due date format (use YYYY-MM-DD): %w", err)
+		}
+		existing.DueDate = dueDate
+	}
+	if input.Status != nil {
+		existing.Status = *input.Status
+	}
+	if input.EstimateHours != nil {
+		existing.EstimateHours = input.EstimateHours
+	}
+
+	if err := r.ProjRepo.UpdateTask(ctx, existing); err != nil {
+		log.Error().Err(err).Msg("Failed to update task")
+		return nil, taskWriteError("update", existing.AssigneeID, err)
+	}
+
+	log.Info().
+		Str("task_id", id).
+		Msg("Task updated successfully")
+
+	return existing, nil
+}
+
+// DeleteTask is the resolver for the deleteTask field.
+func (r *mutationResolver) DeleteTask(ctx context.Context, id string, clientMutationID *string) (bool, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "deleteTask").
+		Str("task_id", id).
+		Msg("Deleting task")
+
+	if err := r.ProjRepo.DeleteTask(ctx, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, fmt.Errorf("task with ID %s not found", id)
+		}
+		log.Error().Err(err).Msg("Failed to delete task")
+		return false, fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	log.Info().
+		Str("task_id", id).
+		Msg("Task deleted successfully")
+
+	return true, nil
+}
+
+// Task is the resolver for the task field.
+func (r *queryResolver) Task(ctx context.Context, id string) (*model.Task, error) {
+	task, err := r.ProjRepo.FindTaskByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil // GraphQL convention: return nil for not found
+		}
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+	return task, nil
+}
+
+// Assignee is the resolver for the assignee field.
+func (r *taskResolver) Assignee(ctx context.Context, obj *model.Task) (*model.Employee, error) {
+	if obj.AssigneeID == nil {
+		return nil, nil
+	}
+
+	emp, err := r.EmpRepo.FindByID(ctx, *obj.AssigneeID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find assignee: %w", err)
+	}
+	return emp, nil
+}
+
+// Task returns TaskResolver implementation.
+func (r *Resolver) Task() TaskResolver { return &taskResolver{r} }
+
+type taskResolver struct{ *Resolver }
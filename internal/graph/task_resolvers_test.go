@@ -0,0 +1,251 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTaskResolverTest creates a project with one team member and one employee outside the team
+func setupTaskResolverTest(t *testing.T) (*Resolver, context.Context, *model.Project, *model.Employee, *model.Employee) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
+
+	dept, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Engineering"})
+	require.NoError(t, err)
+	member, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "Team Member", Email: "member@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+	outsider, err := resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "Outsider", Email: "outsider@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+
+	project, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Apollo", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+		TeamMemberIDs: []string{member.ID},
+	})
+	require.NoError(t, err)
+
+	return resolver, ctx, project, member, outsider
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// TestCreateMilestoneAndTasks tests milestones and tasks are listed under their project
+func TestCreateMilestoneAndTasks(t *testing.T) {
+	resolver, ctx, project, member, _ := setupTaskResolverTest(t)
+
+	milestone, err := resolver.Mutation().CreateMilestone(ctx, model.CreateMilestoneInput{
+		ProjectID: project.ID, Title: "Beta", DueDate: ptr("2025-06-30"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.MilestoneStatusOpen, milestone.Status)
+
+	task, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID:     project.ID,
+		MilestoneID:   &milestone.ID,
+		Title:         "Write docs",
+		AssigneeID:    &member.ID,
+		EstimateHours: ptr(4.5),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.TaskStatusTodo, task.Status)
+
+	milestones, err := resolver.Project().Milestones(ctx, project)
+	require.NoError(t, err)
+	require.Len(t, milestones, 1)
+	assert.Equal(t, "2025-06-30", *milestones[0].DueDate)
+
+	tasks, err := resolver.Milestone().Tasks(ctx, milestone)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, 4.5, *tasks[0].EstimateHours)
+
+	assignee, err := resolver.Task().Assignee(ctx, tasks[0])
+	require.NoError(t, err)
+	assert.Equal(t, member.ID, assignee.ID)
+}
+
+// TestCreateTask_AssigneeMustBeTeamMember tests assignees outside the project team are rejected
+func TestCreateTask_AssigneeMustBeTeamMember(t *testing.T) {
+	resolver, ctx, project, member, outsider := setupTaskResolverTest(t)
+
+	_, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID: project.ID, Title: "Review", AssigneeID: &outsider.ID,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a member of the project team")
+
+	// Reassigning an existing task is checked too
+	task, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID: project.ID, Title: "Review", AssigneeID: &member.ID,
+	})
+	require.NoError(t, err)
+	_, err = resolver.Mutation().UpdateTask(ctx, task.ID, model.UpdateTaskInput{AssigneeID: &outsider.ID})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a member of the project team")
+
+	// After joining the team the outsider can be assigned
+	_, err = resolver.Mutation().AddEmployeeToProject(ctx, project.ID, outsider.ID)
+	require.NoError(t, err)
+	updated, err := resolver.Mutation().UpdateTask(ctx, task.ID, model.UpdateTaskInput{AssigneeID: &outsider.ID})
+	require.NoError(t, err)
+	assert.Equal(t, outsider.ID, *updated.AssigneeID)
+}
+
+// TestCreateTask_MilestoneOfAnotherProject tests tasks cannot use another project's milestone
+func TestCreateTask_MilestoneOfAnotherProject(t *testing.T) {
+	resolver, ctx, project, _, _ := setupTaskResolverTest(t)
+
+	other, err := resolver.Mutation().CreateProject(ctx, model.CreateProjectInput{
+		Name: "Gemini", StartDate: "2025-01-01", EndDate: "2025-12-31", Budget: 1000,
+	})
+	require.NoError(t, err)
+	milestone, err := resolver.Mutation().CreateMilestone(ctx, model.CreateMilestoneInput{ProjectID: other.ID, Title: "Launch"})
+	require.NoError(t, err)
+
+	_, err = resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID: project.ID, Title: "Prepare", MilestoneID: &milestone.ID,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "belongs to another project")
+}
+
+// TestCreateTask_Validation tests the default task rules
+func TestCreateTask_Validation(t *testing.T) {
+	resolver, ctx, project, _, _ := setupTaskResolverTest(t)
+
+	_, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID: project.ID, Title: "", EstimateHours: ptr(-1.0),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "task title is required")
+	assert.Contains(t, err.Error(), "task estimateHours must be at least 0")
+}
+
+// TestUpdateTask_ClearsReferences tests empty IDs unassign and unschedule a task
+func TestUpdateTask_ClearsReferences(t *testing.T) {
+	resolver, ctx, project, member, _ := setupTaskResolverTest(t)
+
+	milestone, err := resolver.Mutation().CreateMilestone(ctx, model.CreateMilestoneInput{ProjectID: project.ID, Title: "Beta"})
+	require.NoError(t, err)
+	task, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{
+		ProjectID: project.ID, Title: "Ship", MilestoneID: &milestone.ID, AssigneeID: &member.ID,
+	})
+	require.NoError(t, err)
+
+	// Omitted references are kept
+	updated, err := resolver.Mutation().UpdateTask(ctx, task.ID, model.UpdateTaskInput{Status: ptr(model.TaskStatusInProgress)})
+	require.NoError(t, err)
+	assert.Equal(t, &member.ID, updated.AssigneeID)
+	assert.Equal(t, &milestone.ID, updated.MilestoneID)
+
+	updated, err = resolver.Mutation().UpdateTask(ctx, task.ID, model.UpdateTaskInput{AssigneeID: ptr(""), MilestoneID: ptr("")})
+	require.NoError(t, err)
+	assert.Nil(t, updated.AssigneeID)
+	assert.Nil(t, updated.MilestoneID)
+
+	found, err := resolver.Query().Task(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.AssigneeID)
+	assert.Equal(t, model.TaskStatusInProgress, found.Status)
+}
+
+// TestProjectProgress tests progress is the share of DONE tasks
+func TestProjectProgress(t *testing.T) {
+	resolver, ctx, project, _, _ := setupTaskResolverTest(t)
+
+	progress, err := resolver.Project().Progress(ctx, project)
+	require.NoError(t, err)
+	assert.Zero(t, progress)
+
+	for _, status := range []model.TaskStatus{model.TaskStatusDone, model.TaskStatusInProgress, model.TaskStatusTodo, model.TaskStatusDone} {
+		_, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{ProjectID: project.ID, Title: "Step", Status: &status})
+		require.NoError(t, err)
+	}
+
+	progress, err = resolver.Project().Progress(ctx, project)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, progress)
+}
+
+// TestDeleteMilestoneAndProject tests tasks outlive their milestone but not their project
+func TestDeleteMilestoneAndProject(t *testing.T) {
+	resolver, ctx, project, _, _ := setupTaskResolverTest(t)
+
+	milestone, err := resolver.Mutation().CreateMilestone(ctx, model.CreateMilestoneInput{ProjectID: project.ID, Title: "Beta"})
+	require.NoError(t, err)
+	task, err := resolver.Mutation().CreateTask(ctx, model.CreateTaskInput{ProjectID: project.ID, Title: "Ship", MilestoneID: &milestone.ID})
+	require.NoError(t, err)
+
+	ok, err := resolver.Mutation().DeleteMilestone(ctx, milestone.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	found, err := resolver.Query().Task(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Nil(t, found.MilestoneID)
+
+	_, err = resolver.Mutation().DeleteProject(ctx, project.ID, nil)
+	require.NoError(t, err)
+
+	found, err = resolver.Query().Task(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+// TestMilestone_NotFound tests missing milestones and tasks
+func TestMilestone_NotFound(t *testing.T) {
+	resolver, ctx, _, _, _ := setupTaskResolverTest(t)
+
+	milestone, err := resolver.Query().Milestone(ctx, "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	assert.Nil(t, milestone)
+
+	_, err = resolver.Mutation().UpdateTask(ctx, "00000000-0000-0000-0000-000000000000", model.UpdateTaskInput{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+// TestUpdateMilestone tests partial milestone updates
+func TestUpdateMilestone(t *testing.T) {
+	resolver, ctx, project, _, _ := setupTaskResolverTest(t)
+
+	milestone, err := resolver.Mutation().CreateMilestone(ctx, model.CreateMilestoneInput{
+		ProjectID: project.ID, Title: "Beta", DueDate: ptr("2025-06-30"),
+	})
+	require.NoError(t, err)
+
+	updated, err := resolver.Mutation().UpdateMilestone(ctx, milestone.ID, model.UpdateMilestoneInput{
+		Status: ptr(model.MilestoneStatusCompleted),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Beta", updated.Title)
+	assert.Equal(t, "2025-06-30", *updated.DueDate)
+
+	found, err := resolver.Query().Milestone(ctx, milestone.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.MilestoneStatusCompleted, found.Status)
+
+	_, err = resolver.Mutation().UpdateMilestone(ctx, milestone.ID, model.UpdateMilestoneInput{DueDate: ptr("June")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid due date format")
+}
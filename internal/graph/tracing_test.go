@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/tracing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// TestTracing_PropagatesTraceContext tests that HTTP, operation, resolver and SQL
+// spans form a single trace continuing the caller's traceparent header
+func TestTracing_PropagatesTraceContext(t *testing.T) {
+	// Create the client first so migration statements are not recorded
+	client := testutil.NewTestEntClientWithDriver(t, tracing.NewDriver(testutil.NewTestEntDriver(t)))
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(middleware.NewTracingMiddleware())
+	srv.AroundOperations(middleware.LoggingMiddleware())
+	httpHandler := otelhttp.NewHandler(auth.Disabled()(srv), "http.server")
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	const query = `query ListDepartments { departments { id } }`
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	httpHandler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		assert.Equal(t, traceID, span.SpanContext().TraceID().String(), "span %s", span.Name())
+		spans[span.Name()] = span
+	}
+
+	require.Contains(t, spans, "http.server")
+	require.Contains(t, spans, "graphql.query ListDepartments")
+	require.Contains(t, spans, "Query.departments")
+	require.Contains(t, spans, "db.select")
+
+	// Each layer is nested under the previous one
+	assert.Equal(t, spans["http.server"].SpanContext().SpanID(), spans["graphql.query ListDepartments"].Parent().SpanID())
+	assert.Equal(t, spans["graphql.query ListDepartments"].SpanContext().SpanID(), spans["Query.departments"].Parent().SpanID())
+	assert.Equal(t, spans["Query.departments"].SpanContext().SpanID(), spans["db.select"].Parent().SpanID())
+
+	// The request ID from LoggingMiddleware and the hash of the document are
+	// recorded on the operation span; the document is not
+	attrs := map[string]string{}
+	for _, attr := range spans["graphql.query ListDepartments"].Attributes() {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+		assert.NotContains(t, attr.Value.Emit(), "{", "attribute %s", attr.Key)
+	}
+	assert.NotEmpty(t, attrs["request.id"])
+	sum := sha256.Sum256([]byte(query))
+	assert.Equal(t, hex.EncodeToString(sum[:]), attrs["graphql.document.hash"])
+}
@@ -1,13 +1,50 @@
 package graph
 
-import "regexp"
+import (
+	"errors"
+	"time"
+
+	"gin-crud-api/internal/validation"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
 
 // ============================================================================
 // Validation Helper Functions
 // ============================================================================
 
-// isValidEmail validates email format using a regular expression
-func isValidEmail(email string) bool {
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-	return emailRegex.MatchString(email)
+// validationError turns validation.Errors into a GraphQL error listing every
+// violation in extensions.violations; other errors are returned unchanged
+func validationError(err error) error {
+	var violations validation.Errors
+	if !errors.As(err, &violations) {
+		return err
+	}
+
+	gqlErr := gqlerror.Errorf("%s", violations.Error())
+	errcode.Set(gqlErr, validation.ErrCodeValidationFailed)
+	gqlErr.Extensions["violations"] = violations
+	return gqlErr
+}
+
+// normalizeDate checks an optional YYYY-MM-DD date, returning nil for nil input
+func normalizeDate(s *string) (*string, error) {
+	if s == nil {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", *s)
+	if err != nil {
+		return nil, err
+	}
+	date := t.Format("2006-01-02")
+	return &date, nil
+}
+
+// dateOrToday parses an optional YYYY-MM-DD date, defaulting to today (UTC)
+func dateOrToday(s *string) (time.Time, error) {
+	if s == nil {
+		return time.Now().UTC().Truncate(24 * time.Hour), nil
+	}
+	return time.Parse("2006-01-02", *s)
 }
@@ -0,0 +1,229 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.82
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookSubscription is the resolver for the createWebhookSubscription field.
+func (r *mutationResolver) CreateWebhookSubscription(ctx context.Context, input model.CreateWebhookSubscriptionInput) (*model.WebhookSubscription, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "createWebhookSubscription").
+		Str("url", input.URL).
+		Msg("Creating webhook subscription")
+
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate input against the configured rules
+	if err := r.Validator.Validate("webhook", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+	if err := checkWebhookTarget(ctx, &input.URL, input.EventTypes, true, r.AllowPrivateWebhookTargets); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, err
+	}
+
+	sub := &model.WebhookSubscription{
+		ID:         uuid.New().String(),
+		URL:        input.URL,
+		EventTypes: input.EventTypes,
+		Active:     input.Active == nil || *input.Active,
+	}
+
+	if err := r.WebhookRepo.SaveSubscription(ctx, sub, input.Secret); err != nil {
+		log.Error().Err(err).Msg("Failed to save webhook subscription")
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+
+	log.Info().
+		Str("subscription_id", sub.ID).
+		Msg("Webhook subscription created successfully")
+
+	return sub, nil
+}
+
+// UpdateWebhookSubscription is the resolver for the updateWebhookSubscription field.
+func (r *mutationResolver) UpdateWebhookSubscription(ctx context.Context, id string, input model.UpdateWebhookSubscriptionInput) (*model.WebhookSubscription, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "updateWebhookSubscription").
+		Str("subscription_id", id).
+		Msg("Updating webhook subscription")
+
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Validate provided fields against the configured rules
+	if err := r.Validator.ValidatePartial("webhook", "input", input); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, validationError(err)
+	}
+	if err := checkWebhookTarget(ctx, input.URL, input.EventTypes, input.EventTypes != nil, r.AllowPrivateWebhookTargets); err != nil {
+		log.Error().Err(err).Msg("Validation failed")
+		return nil, err
+	}
+
+	existing, err := r.WebhookRepo.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("webhook subscription with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+
+	// Apply updates (only update provided fields)
+	if input.URL != nil {
+		existing.URL = *input.URL
+	}
+	if input.EventTypes != nil {
+		existing.EventTypes = input.EventTypes
+	}
+	if input.Active != nil {
+		existing.Active = *input.Active
+	}
+
+	if err := r.WebhookRepo.UpdateSubscription(ctx, existing, input.Secret); err != nil {
+		log.Error().Err(err).Msg("Failed to update webhook subscription")
+		return nil, fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+
+	log.Info().
+		Str("subscription_id", id).
+		Msg("Webhook subscription updated successfully")
+
+	return existing, nil
+}
+
+// DeleteWebhookSubscription is the resolver for the deleteWebhookSubscription field.
+func (r *mutationResolver) DeleteWebhookSubscription(ctx context.Context, id string, clientMutationID *string) (bool, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "deleteWebhookSubscription").
+		Str("subscription_id", id).
+		Msg("Deleting webhook subscription")
+
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return false, err
+	}
+	if err := r.WebhookRepo.DeleteSubscription(ctx, id); err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, fmt.Errorf("webhook subscription with ID %s not found", id)
+		}
+		log.Error().Err(err).Msg("Failed to delete webhook subscription")
+		return false, fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+
+	log.Info().
+		Str("subscription_id", id).
+		Msg("Webhook subscription deleted successfully")
+
+	return true, nil
+}
+
+// RedeliverWebhook is the resolver for the redeliverWebhook field.
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "redeliverWebhook").
+		Str("delivery_id", deliveryID).
+		Msg("Queueing webhook delivery again")
+
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+	delivery, err := r.WebhookRepo.Redeliver(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, fmt.Errorf("webhook delivery with ID %s not found", deliveryID)
+		}
+		if errors.Is(err, database.ErrStatusConflict) {
+			return nil, fmt.Errorf("only DEAD deliveries can be redelivered")
+		}
+		log.Error().Err(err).Msg("Failed to redeliver webhook")
+		return nil, fmt.Errorf("failed to redeliver webhook: %w", err)
+	}
+
+	log.Info().
+		Str("delivery_id", deliveryID).
+		Msg("Webhook delivery queued again")
+
+	return delivery, nil
+}
+
+// WebhookSubscription is the resolver for the webhookSubscription field.
+func (r *queryResolver) WebhookSubscription(ctx context.Context, id string) (*model.WebhookSubscription, error) {
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+	sub, err := r.WebhookRepo.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil // GraphQL convention: return nil for not found
+		}
+		return nil, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// WebhookSubscriptions is the resolver for the webhookSubscriptions field.
+func (r *queryResolver) WebhookSubscriptions(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+	subs, err := r.WebhookRepo.FindSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+// Deliveries is the resolver for the deliveries field.
+func (r *webhookSubscriptionResolver) Deliveries(ctx context.Context, obj *model.WebhookSubscription, status *model.WebhookDeliveryStatus, first *int) ([]*model.WebhookDelivery, error) {
+	if err := requireWebhookAdmin(ctx); err != nil {
+		return nil, err
+	}
+	limit := 20
+	if first != nil {
+		limit = *first
+	}
+	if limit < 1 || limit > maxWebhookDeliveries {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxWebhookDeliveries)
+	}
+
+	deliveries, err := r.WebhookRepo.FindDeliveries(ctx, obj.ID, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// WebhookSubscription returns WebhookSubscriptionResolver implementation.
+func (r *Resolver) WebhookSubscription() WebhookSubscriptionResolver {
+	return &webhookSubscriptionResolver{r}
+}
+
+type webhookSubscriptionResolver struct{ *Resolver }
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/middleware"
+	"gin-crud-api/internal/testutil"
+	"gin-crud-api/internal/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func setupWebhookResolverTest(t *testing.T) (*Resolver, *webhook.Dispatcher, context.Context) {
+	client := testutil.NewTestEntClient(t)
+	t.Cleanup(func() { client.Close() })
+
+	resolver := NewResolver(
+		database.NewEntDepartmentRepo(client),
+		database.NewEntEmployeeRepo(client),
+		database.NewEntProjectRepo(client),
+	)
+	webhookRepo := database.NewEntWebhookRepo(client)
+	resolver.WebhookRepo = webhookRepo
+	dispatcher := webhook.NewDispatcher(webhookRepo, database.NewEntOutboxRepo(client), config.WebhookConfig{}, config.OutboxConfig{})
+
+	ctx := context.WithValue(auth.WithSystem(context.Background()), middleware.RequestIDKey, "test-request-id")
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{ID: "admin", Roles: []string{auth.RoleAdmin}})
+	return resolver, dispatcher, ctx
+}
+
+// TestCreateWebhookSubscription tests subscriptions receive deliveries for their event types
+func TestCreateWebhookSubscription(t *testing.T) {
+	resolver, dispatcher, ctx := setupWebhookResolverTest(t)
+
+	sub, err := resolver.Mutation().CreateWebhookSubscription(ctx, model.CreateWebhookSubscriptionInput{
+		URL:        "https://hooks.example.com/hr",
+		Secret:     "0123456789abcdef",
+		EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated},
+	})
+	require.NoError(t, err)
+	assert.True(t, sub.Active)
+
+	dept, err := resolver.Mutation().CreateDepartment(ctx, model.CreateDepartmentInput{Name: "Engineering"})
+	require.NoError(t, err)
+	_, err = resolver.Mutation().CreateEmployee(ctx, model.CreateEmployeeInput{
+		Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID,
+	})
+	require.NoError(t, err)
+	n, err := dispatcher.QueueEvents(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n) // DepartmentCreated is not sent to webhooks
+
+	deliveries, err := resolver.WebhookSubscription().Deliveries(ctx, sub, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, model.WebhookEventTypeEmployeeCreated, deliveries[0].EventType)
+
+	_, err = resolver.WebhookSubscription().Deliveries(ctx, sub, nil, ptr(0))
+	require.Error(t, err)
+}
+
+// TestCreateWebhookSubscription_Invalid tests URL, secret and event type checks
+func TestCreateWebhookSubscription_Invalid(t *testing.T) {
+	resolver, _, ctx := setupWebhookResolverTest(t)
+
+	tests := []struct {
+		name  string
+		input model.CreateWebhookSubscriptionInput
+		field string
+	}{
+		{
+			name:  "relative URL",
+			input: model.CreateWebhookSubscriptionInput{URL: "/hooks", Secret: "0123456789abcdef", EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated}},
+			field: "input.url",
+		},
+		{
+			name:  "loopback URL",
+			input: model.CreateWebhookSubscriptionInput{URL: "http://127.0.0.1:8080/hooks", Secret: "0123456789abcdef", EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated}},
+			field: "input.url",
+		},
+		{
+			name:  "metadata URL",
+			input: model.CreateWebhookSubscriptionInput{URL: "http://169.254.169.254/latest/meta-data", Secret: "0123456789abcdef", EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated}},
+			field: "input.url",
+		},
+		{
+			name:  "short secret",
+			input: model.CreateWebhookSubscriptionInput{URL: "https://hooks.example.com", Secret: "short", EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated}},
+			field: "input.secret",
+		},
+		{
+			name:  "no event types",
+			input: model.CreateWebhookSubscriptionInput{URL: "https://hooks.example.com", Secret: "0123456789abcdef"},
+			field: "input.eventTypes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolver.Mutation().CreateWebhookSubscription(ctx, tt.input)
+			require.Error(t, err)
+			var gqlErr *gqlerror.Error
+			require.True(t, errors.As(err, &gqlErr))
+			assert.Equal(t, "VALIDATION_FAILED", gqlErr.Extensions["code"])
+			assert.Contains(t, gqlErr.Error(), tt.field)
+		})
+	}
+}
+
+// TestWebhooks_AdminOnly tests webhooks are hidden from and unchangeable by other viewers
+func TestWebhooks_AdminOnly(t *testing.T) {
+	resolver, _, adminCtx := setupWebhookResolverTest(t)
+	sub, err := resolver.Mutation().CreateWebhookSubscription(adminCtx, model.CreateWebhookSubscriptionInput{
+		URL:        "https://hooks.example.com/hr",
+		Secret:     "0123456789abcdef",
+		EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated},
+	})
+	require.NoError(t, err)
+
+	viewers := map[string]context.Context{
+		"anonymous": context.Background(),
+		"manager":   auth.WithPrincipal(context.Background(), &auth.Principal{ID: "m", Roles: []string{auth.RoleManager}}),
+	}
+	for name, ctx := range viewers {
+		t.Run(name, func(t *testing.T) {
+			_, createErr := resolver.Mutation().CreateWebhookSubscription(ctx, model.CreateWebhookSubscriptionInput{
+				URL:        "https://hooks.example.com/other",
+				Secret:     "0123456789abcdef",
+				EventTypes: []model.WebhookEventType{model.WebhookEventTypeEmployeeCreated},
+			})
+			_, updateErr := resolver.Mutation().UpdateWebhookSubscription(ctx, sub.ID, model.UpdateWebhookSubscriptionInput{Active: ptr(false)})
+			_, deleteErr := resolver.Mutation().DeleteWebhookSubscription(ctx, sub.ID, nil)
+			_, redeliverErr := resolver.Mutation().RedeliverWebhook(ctx, sub.ID)
+			_, getErr := resolver.Query().WebhookSubscription(ctx, sub.ID)
+			_, listErr := resolver.Query().WebhookSubscriptions(ctx)
+			_, deliveriesErr := resolver.WebhookSubscription().Deliveries(ctx, sub, nil, nil)
+
+			for _, err := range []error{createErr, updateErr, deleteErr, redeliverErr, getErr, listErr, deliveriesErr} {
+				var gqlErr *gqlerror.Error
+				require.True(t, errors.As(err, &gqlErr))
+				assert.Equal(t, ErrCodeForbidden, gqlErr.Extensions["code"])
+			}
+		})
+	}
+
+	// The subscription is unchanged
+	subs, err := resolver.Query().WebhookSubscriptions(adminCtx)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.True(t, subs[0].Active)
+}
+
+// TestRedeliverWebhook_NotFound tests redelivering an unknown delivery
+func TestRedeliverWebhook_NotFound(t *testing.T) {
+	resolver, _, ctx := setupWebhookResolverTest(t)
+
+	_, err := resolver.Mutation().RedeliverWebhook(ctx, "00000000-0000-0000-0000-000000000000")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
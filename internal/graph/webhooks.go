@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"context"
+	"net/url"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/validation"
+	"gin-crud-api/internal/webhook"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ============================================================================
+// Webhook Helper Functions
+// ============================================================================
+
+// maxWebhookDeliveries caps the first argument of WebhookSubscription.deliveries
+const maxWebhookDeliveries = 100
+
+// requireWebhookAdmin returns a FORBIDDEN error unless the viewer is an
+// admin: subscriptions choose where employee data is sent
+func requireWebhookAdmin(ctx context.Context) error {
+	if auth.FromContext(ctx).HasRole(auth.RoleAdmin) {
+		return nil
+	}
+	gqlErr := gqlerror.Errorf("webhooks can only be managed by admins")
+	errcode.Set(gqlErr, ErrCodeForbidden)
+	return gqlErr
+}
+
+// checkWebhookTarget adds violations for a receiver URL that is not absolute
+// http(s) or, unless allowPrivate, targets a non-public address, and for an
+// empty list of event types. Nil values are skipped.
+func checkWebhookTarget(ctx context.Context, rawURL *string, eventTypes []model.WebhookEventType, eventTypesSet, allowPrivate bool) error {
+	var violations validation.Errors
+
+	if rawURL != nil {
+		u, err := url.Parse(*rawURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			violations = append(violations, validation.Violation{
+				Field:   "input.url",
+				Rule:    "url",
+				Message: "url must be an absolute http or https URL",
+			})
+		} else if !allowPrivate && webhook.CheckTarget(ctx, u) != nil {
+			violations = append(violations, validation.Violation{
+				Field:   "input.url",
+				Rule:    "public_host",
+				Message: "url must not target a loopback, private or link-local address",
+			})
+		}
+	}
+	if eventTypesSet && len(eventTypes) == 0 {
+		violations = append(violations, validation.Violation{
+			Field:   "input.eventTypes",
+			Rule:    "required",
+			Message: "at least one event type is required",
+		})
+	}
+
+	if len(violations) > 0 {
+		return validationError(violations)
+	}
+	return nil
+}
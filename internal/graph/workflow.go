@@ -0,0 +1,34 @@
+package graph
+
+import (
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/workflow"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ============================================================================
+// Workflow Helper Functions
+// ============================================================================
+
+// invalidTransitionError reports a status transition the workflow does not
+// allow, listing the statuses that are allowed in extensions.allowed
+func invalidTransitionError(from, to model.ProjectStatus, allowed []model.ProjectStatus) error {
+	gqlErr := gqlerror.Errorf("project cannot move from %s to %s", from, to)
+	errcode.Set(gqlErr, workflow.ErrCodeInvalidTransition)
+	gqlErr.Extensions["from"] = from
+	gqlErr.Extensions["to"] = to
+	gqlErr.Extensions["allowed"] = allowed
+	return gqlErr
+}
+
+// invalidInitialStatusError reports a status a project cannot be created in,
+// listing the statuses that are allowed in extensions.allowed
+func invalidInitialStatusError(status model.ProjectStatus, allowed []model.ProjectStatus) error {
+	gqlErr := gqlerror.Errorf("project cannot be created in status %s", status)
+	errcode.Set(gqlErr, workflow.ErrCodeInvalidTransition)
+	gqlErr.Extensions["to"] = status
+	gqlErr.Extensions["allowed"] = allowed
+	return gqlErr
+}
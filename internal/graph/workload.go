@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+)
+
+// ============================================================================
+// Workload Helper Functions
+// ============================================================================
+
+// Limits used when the configuration leaves them at zero
+const (
+	defaultMaxProjects = 3
+	defaultWeeklyHours = 40.0
+)
+
+// buildWorkload scores each employee's load, raises overallocation flags and
+// rolls the employees up per department. Capacity hours are the weekly hours
+// prorated over the days of the window.
+func buildWorkload(loads []*database.EmployeeLoad, depts []*model.Department, from, to time.Time, limits config.WorkloadConfig) *model.Workload {
+	maxProjects := limits.MaxProjects
+	if maxProjects <= 0 {
+		maxProjects = defaultMaxProjects
+	}
+	weeklyHours := limits.WeeklyHours
+	if weeklyHours <= 0 {
+		weeklyHours = defaultWeeklyHours
+	}
+	days := to.Sub(from).Hours()/24 + 1
+	capacityHours := weeklyHours * days / 7
+
+	workload := &model.Workload{
+		From:        from.Format("2006-01-02"),
+		To:          to.Format("2006-01-02"),
+		Employees:   make([]*model.EmployeeWorkload, len(loads)),
+		Departments: make([]*model.DepartmentWorkload, 0, len(depts)),
+	}
+
+	rollups := make(map[string]*model.DepartmentWorkload, len(depts))
+	for _, dept := range depts {
+		rollup := &model.DepartmentWorkload{Department: dept}
+		rollups[dept.ID] = rollup
+		workload.Departments = append(workload.Departments, rollup)
+	}
+
+	for i, load := range loads {
+		ew := &model.EmployeeWorkload{
+			Employee:      load.Employee,
+			Projects:      load.Projects,
+			ProjectCount:  load.ProjectCount,
+			OpenTaskHours: load.OpenTaskHours,
+			Flags:         []model.WorkloadFlag{},
+		}
+		if ew.Projects == nil {
+			ew.Projects = []*model.Project{}
+		}
+		ew.LoadScore = math.Max(
+			float64(ew.ProjectCount)/float64(maxProjects),
+			ew.OpenTaskHours/capacityHours,
+		)
+		if ew.ProjectCount > maxProjects {
+			ew.Flags = append(ew.Flags, model.WorkloadFlagTooManyProjects)
+		}
+		if ew.OpenTaskHours > capacityHours {
+			ew.Flags = append(ew.Flags, model.WorkloadFlagTooManyTaskHours)
+		}
+		ew.Overallocated = len(ew.Flags) > 0
+		workload.Employees[i] = ew
+
+		if rollup, ok := rollups[load.Employee.DepartmentID]; ok {
+			rollup.EmployeeCount++
+			rollup.ProjectAssignments += ew.ProjectCount
+			rollup.OpenTaskHours += ew.OpenTaskHours
+			rollup.AverageLoad += ew.LoadScore
+			if ew.Overallocated {
+				rollup.OverallocatedCount++
+			}
+		}
+	}
+
+	for _, rollup := range workload.Departments {
+		if rollup.EmployeeCount > 0 {
+			rollup.AverageLoad /= float64(rollup.EmployeeCount)
+		}
+	}
+
+	// Highest load first; loads are ordered by name already
+	sort.SliceStable(workload.Employees, func(i, j int) bool {
+		return workload.Employees[i].LoadScore > workload.Employees[j].LoadScore
+	})
+	sort.SliceStable(workload.Departments, func(i, j int) bool {
+		return workload.Departments[i].Department.Name < workload.Departments[j].Department.Name
+	})
+
+	return workload
+}
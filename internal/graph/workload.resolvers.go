@@ -0,0 +1,56 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.82
+
+import (
+	"context"
+	"fmt"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/middleware"
+	"time"
+)
+
+// Workload is the resolver for the workload field.
+func (r *queryResolver) Workload(ctx context.Context, from string, to string) (*model.Workload, error) {
+	requestID := middleware.GetRequestID(ctx)
+	log := logger.WithRequestID(ctx, requestID)
+
+	log.Info().
+		Str("operation", "workload").
+		Str("from", from).
+		Str("to", to).
+		Msg("Computing workload")
+
+	fromDate, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from date format (use YYYY-MM-DD): %w", err)
+	}
+	toDate, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to date format (use YYYY-MM-DD): %w", err)
+	}
+	if toDate.Before(fromDate) {
+		return nil, fmt.Errorf("to date must not be before from date")
+	}
+
+	loads, err := r.ProjRepo.Workload(ctx, fromDate, toDate)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to compute workload")
+		return nil, fmt.Errorf("failed to compute workload: %w", err)
+	}
+	depts, err := r.DeptRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch departments: %w", err)
+	}
+
+	workload := buildWorkload(loads, depts, fromDate, toDate, r.WorkloadLimits)
+
+	log.Info().
+		Int("employees", len(workload.Employees)).
+		Msg("Workload computed successfully")
+
+	return workload, nil
+}
@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuildWorkload tests load scores, flags and the department rollup
+func TestBuildWorkload(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 13) // Two weeks, 80 capacity hours
+
+	eng := &model.Department{ID: "d1", Name: "Engineering"}
+	ops := &model.Department{ID: "d2", Name: "Operations"}
+	sales := &model.Department{ID: "d3", Name: "Sales"}
+	projects := func(n int) []*model.Project {
+		ps := make([]*model.Project, n)
+		for i := range ps {
+			ps[i] = &model.Project{ID: string(rune('a' + i))}
+		}
+		return ps
+	}
+	loads := []*database.EmployeeLoad{
+		{Employee: &model.Employee{ID: "ann", DepartmentID: "d1"}, Projects: projects(1), ProjectCount: 1, OpenTaskHours: 20},
+		{Employee: &model.Employee{ID: "bob", DepartmentID: "d1"}, Projects: projects(3), ProjectCount: 3, OpenTaskHours: 100},
+		{Employee: &model.Employee{ID: "cid", DepartmentID: "d2"}},
+	}
+
+	workload := buildWorkload(loads, []*model.Department{sales, ops, eng}, from, to, config.WorkloadConfig{MaxProjects: 2})
+
+	assert.Equal(t, "2025-03-01", workload.From)
+	assert.Equal(t, "2025-03-14", workload.To)
+
+	// Highest load first
+	require.Len(t, workload.Employees, 3)
+	bob := workload.Employees[0]
+	assert.Equal(t, "bob", bob.Employee.ID)
+	assert.InDelta(t, 1.5, bob.LoadScore, 1e-9)
+	assert.True(t, bob.Overallocated)
+	assert.Equal(t, []model.WorkloadFlag{model.WorkloadFlagTooManyProjects, model.WorkloadFlagTooManyTaskHours}, bob.Flags)
+
+	ann := workload.Employees[1]
+	assert.InDelta(t, 0.5, ann.LoadScore, 1e-9)
+	assert.False(t, ann.Overallocated)
+	assert.Empty(t, ann.Flags)
+
+	cid := workload.Employees[2]
+	assert.Zero(t, cid.LoadScore)
+	assert.NotNil(t, cid.Projects)
+
+	// Departments ordered by name, empty ones included
+	require.Len(t, workload.Departments, 3)
+	engineering := workload.Departments[0]
+	assert.Equal(t, eng, engineering.Department)
+	assert.Equal(t, 2, engineering.EmployeeCount)
+	assert.Equal(t, 1, engineering.OverallocatedCount)
+	assert.Equal(t, 4, engineering.ProjectAssignments)
+	assert.Equal(t, 120.0, engineering.OpenTaskHours)
+	assert.InDelta(t, 1.0, engineering.AverageLoad, 1e-9)
+	assert.Equal(t, 1, workload.Departments[1].EmployeeCount)
+	assert.Zero(t, workload.Departments[2].EmployeeCount)
+}
@@ -0,0 +1,163 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gin-crud-api/internal/ent"
+)
+
+// DatabaseCheck pings the database behind the Ent driver
+type DatabaseCheck struct {
+	db *sql.DB
+}
+
+// NewDatabaseCheck creates a check pinging db
+func NewDatabaseCheck(db *sql.DB) *DatabaseCheck {
+	return &DatabaseCheck{db: db}
+}
+
+// Name implements Check
+func (c *DatabaseCheck) Name() string {
+	return "database"
+}
+
+// Check reports DOWN when the database does not answer a ping
+func (c *DatabaseCheck) Check(ctx context.Context) CheckResult {
+	if err := c.db.PingContext(ctx); err != nil {
+		return CheckResult{Status: StatusDown, Message: fmt.Sprintf("ping failed: %v", err)}
+	}
+	return CheckResult{Status: StatusOK}
+}
+
+// DefaultPoolSaturationThreshold is the in-use ratio above which the pool is DEGRADED
+const DefaultPoolSaturationThreshold = 0.9
+
+// PoolCheck reports connection pool saturation
+type PoolCheck struct {
+	db        *sql.DB
+	threshold float64
+}
+
+// NewPoolCheck creates a check reporting DEGRADED once the share of
+// connections in use reaches threshold (0.0-1.0)
+// A threshold outside (0, 1] falls back to DefaultPoolSaturationThreshold
+func NewPoolCheck(db *sql.DB, threshold float64) *PoolCheck {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultPoolSaturationThreshold
+	}
+	return &PoolCheck{db: db, threshold: threshold}
+}
+
+// Name implements Check
+func (c *PoolCheck) Name() string {
+	return "connection_pool"
+}
+
+// Check compares connections in use with the pool size
+// An unlimited pool (MaxOpenConnections == 0) is never saturated
+func (c *PoolCheck) Check(ctx context.Context) CheckResult {
+	stats := c.db.Stats()
+	res := CheckResult{
+		Status: StatusOK,
+		Details: map[string]any{
+			"maxOpen":   stats.MaxOpenConnections,
+			"open":      stats.OpenConnections,
+			"inUse":     stats.InUse,
+			"idle":      stats.Idle,
+			"waitCount": stats.WaitCount,
+		},
+	}
+
+	if stats.MaxOpenConnections == 0 {
+		return res
+	}
+
+	saturation := float64(stats.InUse) / float64(stats.MaxOpenConnections)
+	res.Details["saturation"] = saturation
+	if saturation >= c.threshold {
+		res.Status = StatusDegraded
+		res.Message = fmt.Sprintf("%d of %d connections in use", stats.InUse, stats.MaxOpenConnections)
+	}
+	return res
+}
+
+// MigrationCheckTTL is how long MigrationCheck reuses the result of a schema diff
+const MigrationCheckTTL = time.Minute
+
+// MigrationCheck compares the database schema with the Ent schema
+// Inspecting the schema runs a query per table, so the result of a diff is
+// reused for MigrationCheckTTL instead of diffing on every probe
+type MigrationCheck struct {
+	client *ent.Client
+	now    func() time.Time
+
+	mu      sync.Mutex
+	result  CheckResult
+	checked time.Time // Time of the cached diff; zero when there is none
+}
+
+// NewMigrationCheck creates a check diffing the live schema against client's schema
+func NewMigrationCheck(client *ent.Client) *MigrationCheck {
+	return &MigrationCheck{client: client, now: time.Now}
+}
+
+// Name implements Check
+func (c *MigrationCheck) Name() string {
+	return "migrations"
+}
+
+// Check reports DOWN when migrations are pending, since resolvers would
+// then query tables or columns that do not exist yet
+func (c *MigrationCheck) Check(ctx context.Context) CheckResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.checked.IsZero() && c.now().Sub(c.checked) < MigrationCheckTTL {
+		return c.result
+	}
+	res, ok := c.diff(ctx)
+	if ok {
+		// Failed inspections are retried on the next probe
+		c.result, c.checked = res, c.now()
+	}
+	return res
+}
+
+// diff compares the schemas; ok is false when the database could not be inspected
+func (c *MigrationCheck) diff(ctx context.Context) (res CheckResult, ok bool) {
+	// WriteTo prints the statements auto-migration would run, without executing them
+	var buf bytes.Buffer
+	if err := c.client.Schema.WriteTo(ctx, &buf); err != nil {
+		return CheckResult{Status: StatusDown, Message: fmt.Sprintf("schema inspection failed: %v", err)}, false
+	}
+
+	pending := pendingStatements(buf.String())
+	if len(pending) > 0 {
+		return CheckResult{
+			Status:  StatusDown,
+			Message: fmt.Sprintf("%d pending migration statement(s)", len(pending)),
+			Details: map[string]any{"pending": pending},
+		}, true
+	}
+	return CheckResult{Status: StatusOK}, true
+}
+
+// pendingStatements splits the migration plan into statements, ignoring
+// SQLite's foreign_keys pragmas that wrap every plan
+func pendingStatements(plan string) []string {
+	var stmts []string
+	for _, line := range strings.Split(plan, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(strings.ToUpper(line), "PRAGMA ") {
+			continue
+		}
+		stmts = append(stmts, line)
+	}
+	return stmts
+}
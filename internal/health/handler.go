@@ -0,0 +1,63 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"gin-crud-api/internal/logger"
+)
+
+// LivenessHandler answers /livez
+// Liveness only tells the orchestrator the process is responsive, so it never
+// checks dependencies: a dead database must not get healthy pods restarted
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, Report{
+			Status:    StatusOK,
+			Checks:    []CheckResult{},
+			Timestamp: time.Now().UTC(),
+		})
+	})
+}
+
+// ReadinessHandler answers /readyz with the checker's report
+// It returns 503 when any check is DOWN so traffic is routed elsewhere;
+// DEGRADED still returns 200 since the pod can serve requests.
+// The endpoint is unauthenticated, so it answers with the statuses only;
+// the messages of failing checks are logged instead.
+func ReadinessHandler(checker *Checker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := checker.Run(r.Context())
+
+		code := http.StatusOK
+		if report.Status == StatusDown {
+			code = http.StatusServiceUnavailable
+		}
+		logFailedChecks(report)
+		writeJSON(w, code, report.Redacted())
+	})
+}
+
+// logFailedChecks logs every check of report that is not OK
+func logFailedChecks(report Report) {
+	log := logger.WithComponent("Health")
+	for _, res := range report.Checks {
+		if res.Status == StatusOK {
+			continue
+		}
+		log.Warn().
+			Str("check", res.Name).
+			Str("status", string(res.Status)).
+			Str("message", res.Message).
+			Msg("Readiness check failed")
+	}
+}
+
+// writeJSON writes v as the JSON response body; probes must never be cached
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status is the outcome of a single check or of the whole report
+type Status string
+
+const (
+	StatusOK       Status = "OK"       // Dependency is healthy
+	StatusDegraded Status = "DEGRADED" // Serving traffic, but needs attention
+	StatusDown     Status = "DOWN"     // Not able to serve traffic
+)
+
+// severity orders statuses so the worst one wins in a report
+func (s Status) severity() int {
+	switch s {
+	case StatusOK:
+		return 0
+	case StatusDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// CheckResult is the outcome of one dependency check
+type CheckResult struct {
+	Name     string         `json:"name"`              // Check name, e.g. database
+	Status   Status         `json:"status"`            // OK, DEGRADED or DOWN
+	Message  string         `json:"message,omitempty"` // Human-readable reason for a non-OK status
+	Duration time.Duration  `json:"-"`                 // Time spent running the check
+	Details  map[string]any `json:"details,omitempty"` // Check-specific data (pool stats, ...)
+
+	DurationMs float64 `json:"durationMs"` // Duration in milliseconds, for JSON output
+}
+
+// Report aggregates the results of all checks
+// Its status is the worst status of any check
+type Report struct {
+	Status    Status        `json:"status"`
+	Checks    []CheckResult `json:"checks"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// Redacted returns a copy of the report without the messages and details of
+// the checks. Those hold raw dependency errors (hosts, SQL, pending statements),
+// so only the statuses are shown to callers that are not admins.
+func (r Report) Redacted() Report {
+	checks := make([]CheckResult, len(r.Checks))
+	for i, res := range r.Checks {
+		res.Message = ""
+		res.Details = nil
+		checks[i] = res
+	}
+	r.Checks = checks
+	return r
+}
+
+// Check verifies a single dependency
+// Implementations must honor ctx cancellation; the checker enforces a timeout
+type Check interface {
+	Name() string
+	Check(ctx context.Context) CheckResult
+}
+
+// Checker runs a set of checks concurrently
+type Checker struct {
+	checks  []Check
+	timeout time.Duration
+}
+
+// DefaultTimeout bounds each check when no timeout is configured
+const DefaultTimeout = 2 * time.Second
+
+// NewChecker creates a checker running checks with a per-check timeout
+// A zero or negative timeout falls back to DefaultTimeout
+func NewChecker(timeout time.Duration, checks ...Check) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{checks: checks, timeout: timeout}
+}
+
+// Run executes all checks concurrently and returns the aggregated report
+// Results keep the order in which checks were registered
+func (c *Checker) Run(ctx context.Context) Report {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:    StatusOK,
+		Checks:    results,
+		Timestamp: time.Now().UTC(),
+	}
+	for _, res := range results {
+		if res.Status.severity() > report.Status.severity() {
+			report.Status = res.Status
+		}
+	}
+	return report
+}
+
+// run executes one check with the configured timeout and fills in name and duration
+func (c *Checker) run(ctx context.Context, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	res := check.Check(ctx)
+	res.Name = check.Name()
+	res.Duration = time.Since(start)
+	res.DurationMs = float64(res.Duration.Microseconds()) / 1000
+	if res.Status == "" {
+		res.Status = StatusOK
+	}
+	return res
+}
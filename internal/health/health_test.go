@@ -0,0 +1,202 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/ent"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCheck returns a fixed result, optionally waiting for ctx to expire first
+type stubCheck struct {
+	name   string
+	result CheckResult
+	block  bool
+}
+
+func (s stubCheck) Name() string { return s.name }
+
+func (s stubCheck) Check(ctx context.Context) CheckResult {
+	if s.block {
+		<-ctx.Done()
+		return CheckResult{Status: StatusDown, Message: ctx.Err().Error()}
+	}
+	return s.result
+}
+
+// TestChecker_WorstStatusWins tests report aggregation and check ordering
+func TestChecker_WorstStatusWins(t *testing.T) {
+	checker := NewChecker(time.Second,
+		stubCheck{name: "a", result: CheckResult{Status: StatusOK}},
+		stubCheck{name: "b", result: CheckResult{Status: StatusDegraded, Message: "slow"}},
+		stubCheck{name: "c"}, // Empty status defaults to OK
+	)
+
+	report := checker.Run(context.Background())
+
+	assert.Equal(t, StatusDegraded, report.Status)
+	require.Len(t, report.Checks, 3)
+	assert.Equal(t, "a", report.Checks[0].Name)
+	assert.Equal(t, "slow", report.Checks[1].Message)
+	assert.Equal(t, StatusOK, report.Checks[2].Status)
+	assert.False(t, report.Timestamp.IsZero())
+}
+
+// TestChecker_Timeout tests that a hanging check is cut off by the per-check timeout
+func TestChecker_Timeout(t *testing.T) {
+	checker := NewChecker(20*time.Millisecond, stubCheck{name: "hang", block: true})
+
+	start := time.Now()
+	report := checker.Run(context.Background())
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Contains(t, report.Checks[0].Message, "deadline exceeded")
+}
+
+// TestDatabaseCheck tests ping success and failure on a closed connection
+func TestDatabaseCheck(t *testing.T) {
+	drv := testutil.NewTestEntDriver(t)
+	check := NewDatabaseCheck(drv.DB())
+
+	assert.Equal(t, StatusOK, check.Check(context.Background()).Status)
+
+	require.NoError(t, drv.Close())
+	res := check.Check(context.Background())
+	assert.Equal(t, StatusDown, res.Status)
+	assert.Contains(t, res.Message, "ping failed")
+}
+
+// TestPoolCheck tests saturation reporting against the configured threshold
+func TestPoolCheck(t *testing.T) {
+	drv := testutil.NewTestEntDriver(t)
+	t.Cleanup(func() { drv.Close() })
+	db := drv.DB()
+	db.SetMaxOpenConns(2)
+
+	check := NewPoolCheck(db, 0.5)
+	res := check.Check(context.Background())
+	assert.Equal(t, StatusOK, res.Status)
+	assert.Equal(t, 2, res.Details["maxOpen"])
+
+	// Hold one of two connections: 50% in use reaches the threshold
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	res = check.Check(context.Background())
+	assert.Equal(t, StatusDegraded, res.Status)
+	assert.Equal(t, "1 of 2 connections in use", res.Message)
+}
+
+// TestPoolCheck_Unlimited tests that a pool without a limit is never saturated
+func TestPoolCheck_Unlimited(t *testing.T) {
+	drv := testutil.NewTestEntDriver(t)
+	t.Cleanup(func() { drv.Close() })
+
+	res := NewPoolCheck(drv.DB(), 0).Check(context.Background())
+	assert.Equal(t, StatusOK, res.Status)
+	assert.NotContains(t, res.Details, "saturation")
+}
+
+// TestMigrationCheck tests pending and applied migrations
+func TestMigrationCheck(t *testing.T) {
+	drv := testutil.NewTestEntDriver(t)
+
+	// No migrations run yet: every table is pending
+	pending := NewMigrationCheck(ent.NewClient(ent.Driver(drv))).Check(context.Background())
+	assert.Equal(t, StatusDown, pending.Status)
+	assert.Contains(t, pending.Message, "pending migration")
+	assert.NotEmpty(t, pending.Details["pending"])
+
+	// After migrating the same database the schema is up to date
+	client := testutil.NewTestEntClientWithDriver(t, drv)
+	applied := NewMigrationCheck(client).Check(context.Background())
+	assert.Equal(t, StatusOK, applied.Status)
+	assert.Empty(t, applied.Message)
+}
+
+// TestLivenessHandler tests that liveness always answers 200
+func TestLivenessHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, StatusOK, report.Status)
+}
+
+// TestMigrationCheck_CachesDiff tests that the schema is diffed once per MigrationCheckTTL
+func TestMigrationCheck_CachesDiff(t *testing.T) {
+	// Setup: a check on a database that is not migrated yet
+	drv := testutil.NewTestEntDriver(t)
+	check := NewMigrationCheck(ent.NewClient(ent.Driver(drv)))
+	now := time.Now()
+	check.now = func() time.Time { return now }
+	assert.Equal(t, StatusDown, check.Check(context.Background()).Status)
+
+	// Test: migrate behind the check's back
+	testutil.NewTestEntClientWithDriver(t, drv)
+
+	// Assert: the cached diff is reused until the TTL has passed
+	assert.Equal(t, StatusDown, check.Check(context.Background()).Status)
+	now = now.Add(MigrationCheckTTL)
+	assert.Equal(t, StatusOK, check.Check(context.Background()).Status)
+}
+
+// TestReadinessHandler tests status codes and that only statuses are returned
+func TestReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   Status
+		wantCode int
+	}{
+		{"ok", StatusOK, http.StatusOK},
+		{"degraded still serves traffic", StatusDegraded, http.StatusOK},
+		{"down", StatusDown, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(time.Second, stubCheck{
+				name:   "database",
+				result: CheckResult{Status: tt.status, Message: "dial tcp 10.0.0.5:5432: refused", Details: map[string]any{"inUse": 1}},
+			})
+
+			rec := httptest.NewRecorder()
+			ReadinessHandler(checker).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var body struct {
+				Status Status `json:"status"`
+				Checks []struct {
+					Name       string         `json:"name"`
+					Status     Status         `json:"status"`
+					Message    string         `json:"message"`
+					DurationMs *float64       `json:"durationMs"`
+					Details    map[string]any `json:"details"`
+				} `json:"checks"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.status, body.Status)
+			require.Len(t, body.Checks, 1)
+			assert.Equal(t, "database", body.Checks[0].Name)
+			assert.NotNil(t, body.Checks[0].DurationMs)
+			assert.Empty(t, body.Checks[0].Message)
+			assert.Nil(t, body.Checks[0].Details)
+		})
+	}
+}
@@ -3,27 +3,28 @@ package legacy
 // Legacy REST API Request DTOs
 // These are only used by the legacy REST handlers in internal/legacy/rest/
 // For the current GraphQL API, use the auto-generated types from internal/graph/model/
+// Fields are checked by the shared rules in internal/validation, not binding tags
 
 // CreateDepartmentRequest DTO for REST API
 type CreateDepartmentRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name string `json:"name"`
 }
 
 // UpdateDepartmentRequest DTO for REST API
 type UpdateDepartmentRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name string `json:"name"`
 }
 
 // CreateEmployeeRequest DTO for REST API
 type CreateEmployeeRequest struct {
-	Name         string `json:"name" binding:"required"`
-	Email        string `json:"email" binding:"required,email"`
-	DepartmentID string `json:"department_id" binding:"required"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	DepartmentID string `json:"department_id"`
 }
 
 // UpdateEmployeeRequest DTO for REST API
 type UpdateEmployeeRequest struct {
-	Name         string `json:"name" binding:"required"`
-	Email        string `json:"email" binding:"required,email"`
-	DepartmentID string `json:"department_id" binding:"required"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	DepartmentID string `json:"department_id"`
 }
@@ -4,6 +4,7 @@ import (
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/legacy"
 	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/validation"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,14 +12,16 @@ import (
 )
 
 type Handler struct {
-	repo    database.DepartmentRepository
-	empRepo database.EmployeeRepository // Required for cascade delete
+	repo      database.DepartmentRepository
+	empRepo   database.EmployeeRepository // Required for cascade delete
+	validator *validation.Validator
 }
 
-func NewHandler(repo database.DepartmentRepository, empRepo database.EmployeeRepository) *Handler {
+func NewHandler(repo database.DepartmentRepository, empRepo database.EmployeeRepository, validator *validation.Validator) *Handler {
 	return &Handler{
-		repo:    repo,
-		empRepo: empRepo,
+		repo:      repo,
+		empRepo:   empRepo,
+		validator: validator,
 	}
 }
 
@@ -30,13 +33,17 @@ func (h *Handler) Create(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := h.validator.Validate("department", "", req); err != nil {
+		c.JSON(http.StatusBadRequest, legacy.ValidationErrorBody(err))
+		return
+	}
 
 	dept := &model.Department{
 		ID:   uuid.NewString(),
 		Name: req.Name,
 	}
 
-	if err := h.repo.Save(dept); err != nil {
+	if err := h.repo.Save(c.Request.Context(), dept); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create department"})
 		return
 	}
@@ -47,7 +54,7 @@ func (h *Handler) Create(c *gin.Context) {
 // Get handles GET /departments/:id
 func (h *Handler) Get(c *gin.Context) {
 	id := c.Param("id")
-	dept, err := h.repo.FindByID(id)
+	dept, err := h.repo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
 		return
@@ -57,7 +64,7 @@ func (h *Handler) Get(c *gin.Context) {
 
 // List handles GET /departments
 func (h *Handler) List(c *gin.Context) {
-	departments, err := h.repo.FindAll()
+	departments, err := h.repo.FindAll(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve departments"})
 		return
@@ -74,7 +81,7 @@ func (h *Handler) List(c *gin.Context) {
 func (h *Handler) Update(c *gin.Context) {
 	id := c.Param("id")
 
-	existingDept, err := h.repo.FindByID(id)
+	existingDept, err := h.repo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
 		return
@@ -85,10 +92,14 @@ func (h *Handler) Update(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := h.validator.Validate("department", "", req); err != nil {
+		c.JSON(http.StatusBadRequest, legacy.ValidationErrorBody(err))
+		return
+	}
 
 	existingDept.Name = req.Name
 
-	if err := h.repo.Update(existingDept); err != nil {
+	if err := h.repo.Update(c.Request.Context(), existingDept); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update department"})
 		return
 	}
@@ -100,27 +111,27 @@ func (h *Handler) Update(c *gin.Context) {
 func (h *Handler) Delete(c *gin.Context) {
 	id := c.Param("id")
 
-	_, err := h.repo.FindByID(id)
+	_, err := h.repo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
 		return
 	}
 
 	// Cascade delete: find and delete all employees in this department
-	employees, err := h.empRepo.FindByDepartmentID(id)
+	employees, err := h.empRepo.FindByDepartmentID(c.Request.Context(), id)
 	if err != nil && err != database.ErrNotFound {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check department employees"})
 		return
 	}
 
 	for _, emp := range employees {
-		if err := h.empRepo.Delete(emp.ID); err != nil {
+		if err := h.empRepo.Delete(c.Request.Context(), emp.ID); err != nil {
 			// TODO: In production, use database transactions for atomic operations
 			continue
 		}
 	}
 
-	if err := h.repo.Delete(id); err != nil {
+	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete department"})
 		return
 	}
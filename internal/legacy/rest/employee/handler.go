@@ -4,6 +4,8 @@ import (
 	"gin-crud-api/internal/database"
 	"gin-crud-api/internal/legacy"
 	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/pii"
+	"gin-crud-api/internal/validation"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,12 +13,13 @@ import (
 )
 
 type Handler struct {
-	empRepo  database.EmployeeRepository
-	deptRepo database.DepartmentRepository // Required for department validation
+	empRepo   database.EmployeeRepository
+	deptRepo  database.DepartmentRepository // Required for department validation
+	validator *validation.Validator
 }
 
-func NewHandler(empRepo database.EmployeeRepository, deptRepo database.DepartmentRepository) *Handler {
-	return &Handler{empRepo: empRepo, deptRepo: deptRepo}
+func NewHandler(empRepo database.EmployeeRepository, deptRepo database.DepartmentRepository, validator *validation.Validator) *Handler {
+	return &Handler{empRepo: empRepo, deptRepo: deptRepo, validator: validator}
 }
 
 // Create handles POST /employees
@@ -26,9 +29,13 @@ func (h *Handler) Create(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := h.validator.Validate("employee", "", req); err != nil {
+		c.JSON(http.StatusBadRequest, legacy.ValidationErrorBody(err))
+		return
+	}
 
 	// Validate department exists
-	_, err := h.deptRepo.FindByID(req.DepartmentID)
+	_, err := h.deptRepo.FindByID(c.Request.Context(), req.DepartmentID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
 		return
@@ -41,45 +48,46 @@ func (h *Handler) Create(c *gin.Context) {
 		DepartmentID: req.DepartmentID,
 	}
 
-	if err := h.empRepo.Save(emp); err != nil {
+	if err := h.empRepo.Save(c.Request.Context(), emp); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create employee"})
 		return
 	}
 
-	c.JSON(http.StatusCreated, emp)
+	c.JSON(http.StatusCreated, present(c, emp))
 }
 
 // Get handles GET /employees/:id
 func (h *Handler) Get(c *gin.Context) {
 	id := c.Param("id")
-	emp, err := h.empRepo.FindByID(id)
+	emp, err := h.empRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Employee not found"})
 		return
 	}
-	c.JSON(http.StatusOK, emp)
+	c.JSON(http.StatusOK, present(c, emp))
 }
 
 // List handles GET /employees
 func (h *Handler) List(c *gin.Context) {
-	employees, err := h.empRepo.FindAll()
+	employees, err := h.empRepo.FindAll(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve employees"})
 		return
 	}
 
-	if employees == nil {
-		employees = []*model.Employee{}
+	result := make([]*model.Employee, 0, len(employees))
+	for _, emp := range employees {
+		result = append(result, present(c, emp))
 	}
 
-	c.JSON(http.StatusOK, employees)
+	c.JSON(http.StatusOK, result)
 }
 
 // Update handles PUT /employees/:id
 func (h *Handler) Update(c *gin.Context) {
 	id := c.Param("id")
 
-	existingEmp, err := h.empRepo.FindByID(id)
+	existingEmp, err := h.empRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Employee not found"})
 		return
@@ -90,9 +98,13 @@ func (h *Handler) Update(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	if err := h.validator.Validate("employee", "", req); err != nil {
+		c.JSON(http.StatusBadRequest, legacy.ValidationErrorBody(err))
+		return
+	}
 
 	// Verify new department exists
-	_, err = h.deptRepo.FindByID(req.DepartmentID)
+	_, err = h.deptRepo.FindByID(c.Request.Context(), req.DepartmentID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department ID"})
 		return
@@ -102,25 +114,40 @@ func (h *Handler) Update(c *gin.Context) {
 	existingEmp.Email = req.Email
 	existingEmp.DepartmentID = req.DepartmentID
 
-	if err := h.empRepo.Update(existingEmp); err != nil {
+	if err := h.empRepo.Update(c.Request.Context(), existingEmp); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update employee"})
 		return
 	}
 
-	c.JSON(http.StatusOK, existingEmp)
+	c.JSON(http.StatusOK, present(c, existingEmp))
+}
+
+// present returns emp as the caller may see it, with PII masked unless the
+// caller holds the pii:read permission
+func present(c *gin.Context, emp *model.Employee) *model.Employee {
+	if pii.CanRead(c.Request.Context()) {
+		return emp
+	}
+	masked := *emp
+	masked.Email = pii.Mask(emp.Email)
+	if emp.Phone != nil {
+		phone := pii.Mask(*emp.Phone)
+		masked.Phone = &phone
+	}
+	return &masked
 }
 
 // Delete handles DELETE /employees/:id
 func (h *Handler) Delete(c *gin.Context) {
 	id := c.Param("id")
 
-	_, err := h.empRepo.FindByID(id)
+	_, err := h.empRepo.FindByID(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Employee not found"})
 		return
 	}
 
-	if err := h.empRepo.Delete(id); err != nil {
+	if err := h.empRepo.Delete(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete employee"})
 		return
 	}
@@ -1,9 +1,9 @@
 package router
 
 import (
+	"gin-crud-api/internal/health"
 	"gin-crud-api/internal/legacy/rest/department"
 	"gin-crud-api/internal/legacy/rest/employee"
-	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,6 +12,7 @@ import (
 func Setup(
 	deptHandler *department.Handler,
 	empHandler *employee.Handler,
+	checker *health.Checker,
 ) *gin.Engine {
 
 	r := gin.Default()
@@ -44,9 +45,8 @@ func Setup(
 		}
 	}
     
-	r.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{"status": "ok"})
-	})
+	// Same readiness report as the GraphQL server's /readyz (503 when the database is down)
+	r.GET("/health", gin.WrapH(health.ReadinessHandler(checker)))
 
 	return r
 }
\ No newline at end of file
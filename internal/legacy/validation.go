@@ -0,0 +1,23 @@
+package legacy
+
+import (
+	"errors"
+
+	"gin-crud-api/internal/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ValidationErrorBody builds the 400 response body for a failed validation,
+// listing every violation with its field path
+func ValidationErrorBody(err error) gin.H {
+	var violations validation.Errors
+	if !errors.As(err, &violations) {
+		return gin.H{"error": err.Error()}
+	}
+	return gin.H{
+		"error":      "validation failed",
+		"code":       validation.ErrCodeValidationFailed,
+		"violations": violations,
+	}
+}
@@ -1,12 +1,14 @@
 package logger
 
 import (
+	"context"
 	"io"
 	"os"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Global logger instance
@@ -16,14 +18,21 @@ var Logger zerolog.Logger
 // level: "debug", "info", "warn", "error" - default is "info"
 // pretty: if true, uses human-readable console output (good for development)
 func Init(level string, pretty bool) {
-	var output io.Writer = os.Stdout
+	InitWithWriter(level, pretty, os.Stdout)
+}
+
+// InitWithWriter initializes the global logger writing to w instead of stdout
+// (e.g. stderr when stdout carries program output)
+func InitWithWriter(level string, pretty bool, w io.Writer) {
+	// Classified values are scrubbed before formatting, see redactWriter
+	var output io.Writer = redactWriter{out: w}
 
 	// Pretty logging for development (with colors and human-readable format)
 	if pretty {
-		output = zerolog.ConsoleWriter{
-			Out:        os.Stdout,
+		output = redactWriter{out: zerolog.ConsoleWriter{
+			Out:        w,
 			TimeFormat: time.RFC3339,
-		}
+		}}
 	}
 
 	// Set log level
@@ -64,8 +73,18 @@ func GetLogger() zerolog.Logger {
 }
 
 // WithRequestID creates a child logger with request ID for tracing
-func WithRequestID(requestID string) zerolog.Logger {
-	return Logger.With().Str("request_id", requestID).Logger()
+// When ctx carries an OpenTelemetry span, its trace_id and span_id are added
+// so log lines can be correlated with traces
+func WithRequestID(ctx context.Context, requestID string) zerolog.Logger {
+	logCtx := Logger.With().Str("request_id", requestID)
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logCtx = logCtx.
+			Str("trace_id", sc.TraceID().String()).
+			Str("span_id", sc.SpanID().String())
+	}
+
+	return logCtx.Logger()
 }
 
 // WithComponent creates a child logger with component name
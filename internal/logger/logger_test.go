@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInitWithWriter_RedactsPII tests that classified fields and email
+// addresses are masked in JSON and console output
+func TestInitWithWriter_RedactsPII(t *testing.T) {
+	for _, pretty := range []bool{false, true} {
+		// Setup
+		var buf bytes.Buffer
+		InitWithWriter("info", pretty, &buf)
+
+		// Test
+		Logger.Info().
+			Str("email", "ann@example.com").
+			Str("phone", "+1 555 0100").
+			Str("name", "Ann").
+			Msg("Created employee bob@example.com")
+
+		// Assert
+		out := buf.String()
+		assert.NotContains(t, out, "ann@example.com")
+		assert.NotContains(t, out, "bob@example.com")
+		assert.NotContains(t, out, "555 0100")
+		assert.Contains(t, out, "a***@example.com")
+		assert.Contains(t, out, "b***@example.com")
+		assert.Contains(t, out, "Ann")
+	}
+}
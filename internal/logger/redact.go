@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gin-crud-api/internal/pii"
+)
+
+var (
+	// piiFieldPattern matches JSON string fields named after a classified field
+	piiFieldPattern = regexp.MustCompile(`"(?i:` + strings.Join(pii.Keys, "|") + `)":"(?:[^"\\]|\\.)*"`)
+
+	// emailPattern catches addresses logged under other keys or inside messages
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+// redactWriter scrubs classified values from each JSON log event before
+// passing it on, so PII never reaches the log output
+type redactWriter struct {
+	out io.Writer
+}
+
+// Write implements io.Writer. zerolog writes one event per call.
+func (w redactWriter) Write(p []byte) (int, error) {
+	redacted := piiFieldPattern.ReplaceAllFunc(p, func(field []byte) []byte {
+		key, value, _ := strings.Cut(string(field), ":")
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			unquoted = value
+		}
+		return []byte(key + ":" + strconv.Quote(pii.Mask(unquoted)))
+	})
+	redacted = emailPattern.ReplaceAllFunc(redacted, func(email []byte) []byte {
+		return []byte(pii.Mask(string(email)))
+	})
+	if _, err := w.out.Write(redacted); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
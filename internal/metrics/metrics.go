@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every metric exported by the application
+const namespace = "ginapi"
+
+// Metrics holds the Prometheus registry and all application collectors
+// A dedicated registry (instead of the global default) keeps tests isolated
+type Metrics struct {
+	Registry *prometheus.Registry
+
+	// GraphQL operation metrics
+	RequestsTotal    *prometheus.CounterVec   // Operations by name, type and outcome
+	RequestDuration  *prometheus.HistogramVec // Operation latency by name and type
+	RequestsInFlight prometheus.Gauge         // Operations currently executing
+
+	// GraphQL resolver metrics
+	ErrorsTotal      *prometheus.CounterVec   // Response errors by extensions.code
+	ResolverDuration *prometheus.HistogramVec // Resolver latency by object.field
+}
+
+// New creates a registry with Go runtime, process and GraphQL collectors registered
+func New() *Metrics {
+	m := &Metrics{
+		Registry: prometheus.NewRegistry(),
+
+		RequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "requests_total",
+			Help:      "Total number of GraphQL operations by root field, type and status.",
+		}, []string{"operation", "type", "status"}),
+
+		RequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "request_duration_seconds",
+			Help:      "GraphQL operation latency in seconds by root field and type.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "type"}),
+
+		RequestsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "requests_in_flight",
+			Help:      "Number of GraphQL operations currently being executed.",
+		}),
+
+		ErrorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "errors_total",
+			Help:      "Total number of GraphQL response errors by extensions.code.",
+		}, []string{"code"}),
+
+		ResolverDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "resolver_duration_seconds",
+			Help:      "Latency of GraphQL field resolvers in seconds by object and field.",
+			Buckets:   []float64{.0005, .001, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"field"}),
+	}
+
+	m.Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.RequestsTotal,
+		m.RequestDuration,
+		m.RequestsInFlight,
+		m.ErrorsTotal,
+		m.ResolverDuration,
+	)
+
+	return m
+}
+
+// RegisterDBStats exports connection pool statistics from sql.DB.Stats()
+// (open, in-use and idle connections, wait counts and durations)
+func (m *Metrics) RegisterDBStats(db *sql.DB, dbName string) error {
+	return m.Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Handler returns the HTTP handler serving the registry in Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.Registry, promhttp.HandlerOpts{Registry: m.Registry})
+}
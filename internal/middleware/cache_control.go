@@ -0,0 +1,255 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const cacheControlExtension = "CacheControl"
+
+// cacheControlDirective is the schema directive carrying cache hints
+const cacheControlDirective = "cacheControl"
+
+// CachePolicy is how long a response may be cached
+type CachePolicy struct {
+	MaxAge int `json:"maxAge"` // Seconds (0 means not cacheable)
+}
+
+// cachePolicy collects the hints of the fields resolved for one response
+type cachePolicy struct {
+	mu     sync.Mutex
+	maxAge int
+	hinted bool // Whether any field restricted the policy
+}
+
+// restrict lowers the policy to maxAge
+func (p *cachePolicy) restrict(maxAge int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.hinted || maxAge < p.maxAge {
+		p.maxAge = maxAge
+		p.hinted = true
+	}
+}
+
+func (p *cachePolicy) result() CachePolicy {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return CachePolicy{MaxAge: p.maxAge}
+}
+
+type cachePolicyKey struct{}
+
+// CacheControl computes the cache policy of query responses from the
+// @cacheControl(maxAge:) hints of the schema and returns it in
+// extensions.cacheControl. Mutations, subscriptions and responses with
+// errors are never cacheable.
+type CacheControl struct {
+	schema *ast.Schema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = &CacheControl{}
+
+// NewCacheControl creates the cache control extension
+func NewCacheControl() *CacheControl {
+	return &CacheControl{}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (c *CacheControl) ExtensionName() string {
+	return cacheControlExtension
+}
+
+// Validate implements graphql.HandlerExtension
+func (c *CacheControl) Validate(schema graphql.ExecutableSchema) error {
+	c.schema = schema.Schema()
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor
+func (c *CacheControl) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	collected := &cachePolicy{}
+	resp := next(context.WithValue(ctx, cachePolicyKey{}, collected))
+	if resp == nil {
+		return nil
+	}
+
+	policy := collected.result()
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation != ast.Query || len(resp.Errors) > 0 {
+		policy.MaxAge = 0
+	}
+	if resp.Extensions == nil {
+		resp.Extensions = map[string]any{}
+	}
+	resp.Extensions["cacheControl"] = policy
+
+	if holder, ok := ctx.Value(httpCachePolicyKey{}).(*CachePolicy); ok {
+		*holder = policy
+	}
+	return resp
+}
+
+// InterceptField implements graphql.FieldInterceptor
+func (c *CacheControl) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	if collected, ok := ctx.Value(cachePolicyKey{}).(*cachePolicy); ok {
+		if maxAge, ok := c.fieldMaxAge(graphql.GetFieldContext(ctx)); ok {
+			collected.restrict(maxAge)
+		}
+	}
+	return next(ctx)
+}
+
+// fieldMaxAge returns the hint of a field: its own @cacheControl, else the
+// one of the object type it returns. Unhinted root fields and fields
+// returning composite types are not cacheable (0); unhinted scalar fields
+// keep their parent's policy (ok is false).
+func (c *CacheControl) fieldMaxAge(fc *graphql.FieldContext) (maxAge int, ok bool) {
+	if fc == nil || fc.Field.Definition == nil {
+		return 0, false
+	}
+	def := fc.Field.Definition
+	if maxAge, ok := hintMaxAge(def.Directives); ok {
+		return maxAge, true
+	}
+
+	var returned *ast.Definition
+	if c.schema != nil {
+		returned = c.schema.Types[def.Type.Name()]
+	}
+	if returned != nil {
+		if maxAge, ok := hintMaxAge(returned.Directives); ok {
+			return maxAge, true
+		}
+	}
+	if fc.Parent == nil || (returned != nil && returned.IsCompositeType()) {
+		return 0, true
+	}
+	return 0, false
+}
+
+// hintMaxAge returns the maxAge of a @cacheControl directive among directives
+func hintMaxAge(directives ast.DirectiveList) (int, bool) {
+	d := directives.ForName(cacheControlDirective)
+	if d == nil {
+		return 0, false
+	}
+	arg := d.Arguments.ForName("maxAge")
+	if arg == nil || arg.Value == nil {
+		return 0, false
+	}
+	maxAge, err := strconv.Atoi(arg.Value.Raw)
+	if err != nil || maxAge < 0 {
+		return 0, false
+	}
+	return maxAge, true
+}
+
+type httpCachePolicyKey struct{}
+
+// HTTPCacheMiddleware makes GraphQL GET responses cacheable by clients: it
+// sets Cache-Control from the policy computed by the CacheControl extension
+// and an ETag hashed from the body, and answers a matching If-None-Match with
+// 304 Not Modified. Responses depend on the caller, so they are private and
+// vary by the given request headers (e.g. Authorization and the tenant
+// header). Other methods pass through unchanged.
+func HTTPCacheMiddleware(varyHeaders ...string) func(http.Handler) http.Handler {
+	var names []string
+	for _, name := range varyHeaders {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	vary := strings.Join(names, ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Websocket upgrades are GET requests too, but need the original writer for hijacking
+			if r.Method != http.MethodGet || strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			policy := &CachePolicy{}
+			buf := &bufferedResponse{header: w.Header(), status: http.StatusOK}
+			next.ServeHTTP(buf, r.WithContext(context.WithValue(r.Context(), httpCachePolicyKey{}, policy)))
+
+			if vary != "" {
+				w.Header().Add("Vary", vary)
+			}
+			if buf.status != http.StatusOK {
+				w.Header().Set("Cache-Control", "no-store")
+				w.WriteHeader(buf.status)
+				_, _ = w.Write(buf.body.Bytes())
+				return
+			}
+
+			sum := sha256.Sum256(buf.body.Bytes())
+			etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+			w.Header().Set("ETag", etag)
+			if policy.MaxAge > 0 {
+				w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(policy.MaxAge))
+			} else {
+				w.Header().Set("Cache-Control", "private, no-cache")
+			}
+
+			if etagMatches(r.Header.Get("If-None-Match"), etag) {
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.WriteHeader(buf.status)
+			_, _ = w.Write(buf.body.Bytes())
+		})
+	}
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using
+// the weak comparison RFC 9110 prescribes for If-None-Match
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse holds a response until its ETag is known. Headers are
+// written to the real response directly.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
@@ -0,0 +1,218 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCORS = config.CORSConfig{
+	AllowedOrigins: []string{"https://app.example.com", "https://*.preview.example.com"},
+	AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+	AllowedHeaders: []string{"Content-Type", "Authorization"},
+	ExposedHeaders: []string{"Retry-After"},
+	MaxAge:         10 * time.Minute,
+}
+
+// corsRequest sends a request through CORSMiddleware and reports whether it reached the handler
+func corsRequest(cfg config.CORSConfig, method, origin string, headers map[string]string) (*httptest.ResponseRecorder, bool) {
+	reached := false
+	h := CORSMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+
+	req := httptest.NewRequest(method, "/query", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec, reached
+}
+
+func TestCORS_AllowedOrigin(t *testing.T) {
+	rec, reached := corsRequest(testCORS, http.MethodPost, "https://app.example.com", nil)
+
+	assert.True(t, reached)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Retry-After", rec.Header().Get("Access-Control-Expose-Headers"))
+	assert.Contains(t, rec.Header().Values("Vary"), "Origin")
+}
+
+func TestCORS_WildcardSubdomain(t *testing.T) {
+	rec, _ := corsRequest(testCORS, http.MethodPost, "https://pr-42.preview.example.com", nil)
+	assert.Equal(t, "https://pr-42.preview.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	rec, _ = corsRequest(testCORS, http.MethodPost, "https://preview.example.com.evil.com", nil)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORS_DisallowedOrigin(t *testing.T) {
+	rec, reached := corsRequest(testCORS, http.MethodPost, "https://evil.com", nil)
+
+	// Non-browser semantics are unchanged; the browser hides the response
+	assert.True(t, reached)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORS_Preflight(t *testing.T) {
+	rec, reached := corsRequest(testCORS, http.MethodOptions, "https://app.example.com", map[string]string{
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "content-type, authorization",
+	})
+
+	assert.False(t, reached)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST, OPTIONS", rec.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Content-Type, Authorization", rec.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestCORS_PreflightRejectsUnknownMethodOrHeader(t *testing.T) {
+	rec, _ := corsRequest(testCORS, http.MethodOptions, "https://app.example.com", map[string]string{
+		"Access-Control-Request-Method": "DELETE",
+	})
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+
+	rec, _ = corsRequest(testCORS, http.MethodOptions, "https://app.example.com", map[string]string{
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "X-Custom",
+	})
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+}
+
+func TestCORS_AnyOrigin(t *testing.T) {
+	cfg := testCORS
+	cfg.AllowedOrigins = []string{"*"}
+
+	rec, _ := corsRequest(cfg, http.MethodGet, "https://anything.dev", nil)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// Credentials cannot be combined with "*", so the origin is echoed
+	cfg.AllowCredentials = true
+	rec, _ = corsRequest(cfg, http.MethodGet, "https://anything.dev", nil)
+	assert.Equal(t, "https://anything.dev", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	cfg := config.SecurityHeadersConfig{
+		Enabled:               true,
+		ContentSecurityPolicy: "default-src 'none'",
+		FrameOptions:          "DENY",
+		HSTSMaxAge:            365 * 24 * time.Hour,
+	}
+	h := SecurityHeadersMiddleware(cfg)(http.NotFoundHandler())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/query", nil))
+
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "no-referrer", rec.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "DENY", rec.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "default-src 'none'", rec.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, "max-age=31536000; includeSubDomains", rec.Header().Get("Strict-Transport-Security"))
+
+	// Disabled: no headers at all
+	cfg.Enabled = false
+	rec = httptest.NewRecorder()
+	noop := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	SecurityHeadersMiddleware(cfg)(noop).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Empty(t, rec.Header().Get("X-Frame-Options"))
+	assert.Empty(t, rec.Header().Get("Referrer-Policy"))
+}
+
+// tenantRequest sends a request through TenantMiddleware and returns the tenant the handler saw
+func tenantRequest(cfg config.TenancyConfig, principal *auth.Principal, header string) (*httptest.ResponseRecorder, string) {
+	seen := ""
+	h := TenantMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = tenant.FromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	if principal != nil {
+		req = req.WithContext(auth.WithPrincipal(req.Context(), principal))
+	}
+	if header != "" {
+		req.Header.Set("X-Tenant-ID", header)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestTenant_Resolution(t *testing.T) {
+	cfg := config.TenancyConfig{Header: "X-Tenant-ID", AllowHeader: true}
+
+	// No claim and no header: default tenant
+	rec, seen := tenantRequest(cfg, nil, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, tenant.Default, seen)
+
+	// Header
+	_, seen = tenantRequest(cfg, nil, "acme")
+	assert.Equal(t, "acme", seen)
+
+	// Token claim, with or without a matching header
+	_, seen = tenantRequest(cfg, &auth.Principal{ID: "u1", TenantID: "globex"}, "")
+	assert.Equal(t, "globex", seen)
+	_, seen = tenantRequest(cfg, &auth.Principal{ID: "u1", TenantID: "globex"}, "globex")
+	assert.Equal(t, "globex", seen)
+}
+
+func TestTenant_Rejected(t *testing.T) {
+	cfg := config.TenancyConfig{Header: "X-Tenant-ID", AllowHeader: true}
+
+	// A header cannot switch away from the token's tenant
+	rec, seen := tenantRequest(cfg, &auth.Principal{ID: "u1", TenantID: "globex"}, "acme")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrCodeTenantInvalid)
+	assert.Empty(t, seen)
+
+	// Malformed IDs
+	rec, seen = tenantRequest(cfg, nil, "Acme Corp")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, seen)
+
+	// Header not trusted
+	cfg.AllowHeader = false
+	rec, seen = tenantRequest(cfg, nil, "acme")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, seen)
+}
+
+func TestRequestID(t *testing.T) {
+	// Setup
+	var seen []string
+	h := RequestIDMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, GetRequestID(r.Context()))
+	}))
+
+	// Test
+	first := httptest.NewRecorder()
+	h.ServeHTTP(first, httptest.NewRequest(http.MethodPost, "/query", nil))
+	second := httptest.NewRecorder()
+	h.ServeHTTP(second, httptest.NewRequest(http.MethodPost, "/query", nil))
+	upgrade := httptest.NewRequest(http.MethodGet, "/query", nil)
+	upgrade.Header.Set("Upgrade", "websocket")
+	h.ServeHTTP(httptest.NewRecorder(), upgrade)
+
+	// Assert: each request has its own ID; websocket operations get theirs later
+	assert.Len(t, seen, 3)
+	assert.NotEmpty(t, seen[0])
+	assert.Equal(t, seen[0], first.Header().Get(RequestIDHeader))
+	assert.Equal(t, seen[1], second.Header().Get(RequestIDHeader))
+	assert.NotEqual(t, seen[0], seen[1])
+	assert.Empty(t, seen[2])
+}
@@ -0,0 +1,276 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/tenant"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying an idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader is set to "true" when a response was replayed from a stored result
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// ErrCodeIdempotencyKeyReused is returned when a key is sent again with a different request
+	ErrCodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+
+	// ErrCodeIdempotencyKeyInProgress is returned while the first request with a key is still executing
+	ErrCodeIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+
+	// ErrCodeIdempotencyKeyInvalid is returned for keys longer than maxIdempotencyKeyLength
+	ErrCodeIdempotencyKeyInvalid = "IDEMPOTENCY_KEY_INVALID"
+
+	// clientMutationIDArg names the argument (or input field) carrying a key
+	clientMutationIDArg = "clientMutationId"
+
+	// maxIdempotencyKeyLength matches the column size of idempotency_keys.key
+	maxIdempotencyKeyLength = 255
+)
+
+// Idempotency is a gqlgen extension that makes create and delete mutations
+// safe to retry. A key comes from the field's clientMutationId (input field
+// for creates, argument for deletes) or, failing that, the Idempotency-Key
+// header. The first request with a key executes and its result is stored for
+// the TTL; retries by the same caller replay the stored result.
+// Keys are scoped to the tenant, the caller (see clientKey) and the field's response key,
+// so one header can cover several mutation fields of a single request.
+type Idempotency struct {
+	repo database.IdempotencyRepository
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+var _ interface {
+	graphql.RootFieldInterceptor
+	graphql.HandlerExtension
+} = &Idempotency{}
+
+// NewIdempotency creates the extension storing results in repo for cfg.TTL
+func NewIdempotency(repo database.IdempotencyRepository, cfg *config.IdempotencyConfig) *Idempotency {
+	return &Idempotency{
+		repo: repo,
+		ttl:  cfg.TTL,
+		now:  time.Now,
+	}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (i *Idempotency) ExtensionName() string {
+	return "Idempotency"
+}
+
+// Validate implements graphql.HandlerExtension
+func (i *Idempotency) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptRootField implements graphql.RootFieldInterceptor
+func (i *Idempotency) InterceptRootField(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+	oc := graphql.GetOperationContext(ctx)
+	rfc := graphql.GetRootFieldContext(ctx)
+	if oc.Operation.Operation != ast.Mutation || rfc == nil || !idempotentField(rfc.Field.Name) {
+		return next(ctx)
+	}
+
+	args := rfc.Field.ArgumentMap(oc.Variables)
+	key := idempotencyKey(ctx, args)
+	if key == "" {
+		return next(ctx)
+	}
+
+	alias := rfc.Field.Alias
+	if len(key) > maxIdempotencyKeyLength {
+		return fieldError(ctx, alias, ErrCodeIdempotencyKeyInvalid,
+			"idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	log := logger.WithComponent("Idempotency")
+	now := i.now()
+	rec := &database.IdempotencyRecord{
+		Principal:   tenant.FromContext(ctx) + "/" + clientKey(ctx),
+		Key:         key,
+		Operation:   alias,
+		RequestHash: requestHash(rfc.Field, args, oc),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(i.ttl),
+	}
+
+	existing, err := i.repo.Acquire(ctx, rec)
+	if err != nil {
+		// Without the store the mutation runs unprotected rather than failing
+		log.Warn().
+			Err(err).
+			Str("operation", alias).
+			Msg("Idempotency store unavailable, executing mutation")
+		return next(ctx)
+	}
+
+	if existing != nil {
+		switch {
+		case existing.RequestHash != rec.RequestHash:
+			return fieldError(ctx, alias, ErrCodeIdempotencyKeyReused,
+				"idempotency key was already used for a different %s request", rfc.Field.Name)
+		case !existing.Completed:
+			return fieldError(ctx, alias, ErrCodeIdempotencyKeyInProgress,
+				"a request with this idempotency key is still in progress")
+		}
+
+		log.Debug().
+			Str("idempotency_id", existing.ID).
+			Str("operation", alias).
+			Msg("Replaying stored mutation result")
+		if ri := GetRequestInfo(ctx); ri != nil {
+			ri.ResponseHeader().Set(IdempotentReplayedHeader, "true")
+		}
+		return rawJSON(existing.Response)
+	}
+
+	res := next(ctx)
+
+	// A failed mutation did not take effect, so the key is freed for a retry
+	if res == graphql.Null {
+		if err := i.repo.Release(ctx, rec.ID); err != nil {
+			log.Warn().
+				Err(err).
+				Str("idempotency_id", rec.ID).
+				Msg("Failed to release idempotency key")
+		}
+		return res
+	}
+
+	var buf bytes.Buffer
+	res.MarshalGQL(&buf)
+	if err := i.repo.Complete(ctx, rec.ID, buf.Bytes()); err != nil {
+		log.Warn().
+			Err(err).
+			Str("idempotency_id", rec.ID).
+			Msg("Failed to store mutation result for idempotency key")
+	}
+	return rawJSON(buf.Bytes())
+}
+
+// RunCleanup deletes expired keys every interval until ctx is canceled
+func (i *Idempotency) RunCleanup(ctx context.Context, interval time.Duration) {
+	log := logger.WithComponent("Idempotency")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := i.repo.DeleteExpired(ctx, i.now())
+			if err != nil {
+				log.Warn().
+					Err(err).
+					Msg("Failed to delete expired idempotency keys")
+				continue
+			}
+			if n > 0 {
+				log.Debug().
+					Int("count", n).
+					Msg("Deleted expired idempotency keys")
+			}
+		}
+	}
+}
+
+// idempotentField reports whether a mutation field honors idempotency keys
+func idempotentField(name string) bool {
+	return strings.HasPrefix(name, "create") || strings.HasPrefix(name, "delete")
+}
+
+// idempotencyKey returns the field's clientMutationId, else the Idempotency-Key header
+func idempotencyKey(ctx context.Context, args map[string]any) string {
+	if id, ok := args[clientMutationIDArg].(string); ok && id != "" {
+		return id
+	}
+	if input, ok := args["input"].(map[string]any); ok {
+		if id, ok := input[clientMutationIDArg].(string); ok && id != "" {
+			return id
+		}
+	}
+	if ri := GetRequestInfo(ctx); ri != nil && ri.RequestHeader() != nil {
+		return strings.TrimSpace(ri.RequestHeader().Get(IdempotencyKeyHeader))
+	}
+	return ""
+}
+
+// requestHash fingerprints the field name, its arguments and its selection,
+// so a key reused for a different request is detected rather than replayed
+func requestHash(field graphql.CollectedField, args map[string]any, oc *graphql.OperationContext) string {
+	h := sha256.New()
+	io.WriteString(h, field.Name)
+	// Map keys are sorted by encoding/json, so equal arguments hash equally
+	argsJSON, _ := json.Marshal(args)
+	h.Write(argsJSON)
+	writeSelection(h, field.Selections, oc, map[string]bool{})
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeSelection writes a canonical form of a selection set, expanding fragment spreads
+func writeSelection(w io.Writer, set ast.SelectionSet, oc *graphql.OperationContext, visited map[string]bool) {
+	io.WriteString(w, "{")
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			io.WriteString(w, s.Alias+":"+s.Name)
+			for _, arg := range s.Arguments {
+				value, _ := arg.Value.Value(oc.Variables)
+				valueJSON, _ := json.Marshal(value)
+				io.WriteString(w, "("+arg.Name+"=")
+				w.Write(valueJSON)
+				io.WriteString(w, ")")
+			}
+			writeSelection(w, s.SelectionSet, oc, visited)
+		case *ast.InlineFragment:
+			io.WriteString(w, "...on "+s.TypeCondition)
+			writeSelection(w, s.SelectionSet, oc, visited)
+		case *ast.FragmentSpread:
+			if visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			if def := oc.Doc.Fragments.ForName(s.Name); def != nil {
+				io.WriteString(w, "...on "+def.TypeCondition)
+				writeSelection(w, def.SelectionSet, oc, visited)
+			}
+		}
+		io.WriteString(w, ",")
+	}
+	io.WriteString(w, "}")
+}
+
+// rawJSON writes an already marshaled field result
+func rawJSON(b []byte) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		w.Write(b)
+	})
+}
+
+// fieldError reports an error on the root field and resolves it to null
+func fieldError(ctx context.Context, alias, code, format string, args ...any) graphql.Marshaler {
+	err := gqlerror.Errorf(format, args...)
+	err.Path = ast.Path{ast.PathName(alias)}
+	errcode.Set(err, code)
+	graphql.AddError(ctx, err)
+	return graphql.Null
+}
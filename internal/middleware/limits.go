@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeDepthLimit is the extensions.code returned when an operation is nested too deeply
+const ErrCodeDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// ErrCodeComplexityLimit is the extensions.code gqlgen's ComplexityLimit extension returns
+const ErrCodeComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+
+const depthExtension = "DepthLimit"
+
+func init() {
+	// Rejected operations are protocol errors, so HTTP transports answer with 422
+	errcode.RegisterErrorType(ErrCodeDepthLimit, errcode.KindProtocol)
+	errcode.RegisterErrorType(ErrCodeComplexityLimit, errcode.KindProtocol)
+}
+
+// DepthStats holds the computed depth of an operation and the limit it was checked against
+type DepthStats struct {
+	Depth      int
+	DepthLimit int
+}
+
+// DepthLimit rejects operations whose selection sets are nested deeper than Limit
+// Introspection fields (__schema, __type) are not counted so the Playground keeps working
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &DepthLimit{}
+
+// FixedDepthLimit creates a depth limit extension with a constant limit
+func FixedDepthLimit(limit int) *DepthLimit {
+	return &DepthLimit{Limit: limit}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (d DepthLimit) ExtensionName() string {
+	return depthExtension
+}
+
+// Validate implements graphql.HandlerExtension
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext computes the operation depth before execution and rejects it if too deep
+func (d DepthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	depth := selectionDepth(opCtx.Operation.SelectionSet, map[string]bool{})
+
+	opCtx.Stats.SetExtension(depthExtension, &DepthStats{
+		Depth:      depth,
+		DepthLimit: d.Limit,
+	})
+
+	if depth > d.Limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, ErrCodeDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// GetDepthStats returns the depth statistics recorded for the current operation
+func GetDepthStats(ctx context.Context) *DepthStats {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+
+	s, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(depthExtension).(*DepthStats)
+	return s
+}
+
+// selectionDepth returns the deepest field nesting within a selection set
+// Fragments do not add a level of their own; visited guards against fragment cycles
+func selectionDepth(set ast.SelectionSet, visited map[string]bool) int {
+	maxDepth := 0
+	for _, sel := range set {
+		depth := 0
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet, visited)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet, visited)
+		case *ast.FragmentSpread:
+			if s.Definition == nil || visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			depth = selectionDepth(s.Definition.SelectionSet, visited)
+			delete(visited, s.Name)
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	return maxDepth
+}
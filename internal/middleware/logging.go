@@ -2,13 +2,19 @@ package middleware
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"time"
 
 	"gin-crud-api/internal/logger"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // RequestIDKey is the context key for request ID
@@ -16,24 +22,49 @@ type contextKey string
 
 const RequestIDKey contextKey = "request_id"
 
+// RequestIDHeader is the response header carrying the request ID
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDMiddleware assigns every HTTP request an ID, stores it in the
+// context and returns it in the X-Request-ID header. The GraphQL logging
+// middlewares use it, so rejected operations can be matched to the request.
+// Websocket connections carry many operations, which get their own IDs.
+func RequestIDMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			requestID := uuid.New().String()
+			w.Header().Set(RequestIDHeader, requestID)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), RequestIDKey, requestID)))
+		})
+	}
+}
+
 // LoggingMiddleware creates a GraphQL operation middleware for structured logging
 // Logs each GraphQL operation (query/mutation) with timing and error information
 func LoggingMiddleware() graphql.OperationMiddleware {
 	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
-		// Generate request ID for tracing
-		requestID := uuid.New().String()
-		ctx = context.WithValue(ctx, RequestIDKey, requestID)
+		// Use the request ID of the HTTP request, or generate one (e.g. for websocket operations)
+		requestID := GetRequestID(ctx)
+		if requestID == "" {
+			requestID = uuid.New().String()
+			ctx = context.WithValue(ctx, RequestIDKey, requestID)
+		}
+
+		// Attach the request ID to the operation span so traces and logs can be joined
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
 
 		// Get operation context
 		oc := graphql.GetOperationContext(ctx)
 
 		// Create logger with request ID
-		log := logger.WithRequestID(requestID)
+		log := logger.WithRequestID(ctx, requestID)
 
 		// Log operation start
-		log.Info().
-			Str("operation", oc.OperationName).
-			Str("query", oc.RawQuery).
+		logDocument(log.Info().Str("operation", oc.OperationName), oc).
 			Msg("GraphQL operation started")
 
 		// Record start time
@@ -74,6 +105,63 @@ func LoggingMiddleware() graphql.OperationMiddleware {
 	}
 }
 
+// RejectionLoggingMiddleware creates a GraphQL response middleware that logs operations
+// rejected by the depth and complexity limits. Rejected operations never reach
+// LoggingMiddleware because gqlgen refuses them before dispatching the operation.
+func RejectionLoggingMiddleware() graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		res := next(ctx)
+		if res == nil {
+			return res
+		}
+
+		for _, err := range res.Errors {
+			code, _ := err.Extensions["code"].(string)
+			if code != ErrCodeDepthLimit && code != ErrCodeComplexityLimit {
+				continue
+			}
+
+			requestID := GetRequestID(ctx)
+			if requestID == "" {
+				requestID = uuid.New().String()
+			}
+			log := logger.WithRequestID(ctx, requestID)
+			logEvent := log.Warn().
+				Str("code", code).
+				Str("error", err.Message)
+
+			if graphql.HasOperationContext(ctx) {
+				oc := graphql.GetOperationContext(ctx)
+				logEvent = logDocument(logEvent.Str("operation", oc.OperationName), oc)
+			}
+			if stats := GetDepthStats(ctx); stats != nil {
+				logEvent = logEvent.
+					Int("depth", stats.Depth).
+					Int("depth_limit", stats.DepthLimit)
+			}
+			if stats := extension.GetComplexityStats(ctx); stats != nil {
+				logEvent = logEvent.
+					Int("complexity", stats.Complexity).
+					Int("complexity_limit", stats.ComplexityLimit)
+			}
+
+			logEvent.Msg("GraphQL operation rejected")
+		}
+
+		return res
+	}
+}
+
+// logDocument adds the GraphQL document of oc to a log event. Mutations and
+// subscriptions may carry PII as inline argument literals, which the log
+// redaction cannot recognize, so only their document hash is logged.
+func logDocument(event *zerolog.Event, oc *graphql.OperationContext) *zerolog.Event {
+	if oc.Operation != nil && oc.Operation.Operation == ast.Query {
+		return event.Str("query", oc.RawQuery)
+	}
+	return event.Str("document_hash", documentHash(oc.RawQuery))
+}
+
 // GetRequestID retrieves the request ID from context
 func GetRequestID(ctx context.Context) string {
 	if requestID, ok := ctx.Value(RequestIDKey).(string); ok {
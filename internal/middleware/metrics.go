@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gin-crud-api/internal/metrics"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// errCodeNone labels response errors that carry no extensions.code
+const errCodeNone = "NONE"
+
+// Operation labels that are not root field names
+const (
+	operationLabelMultiple = "multiple"
+	operationLabelOther    = "other"
+)
+
+// MetricsMiddleware is a gqlgen handler extension recording Prometheus metrics
+// It wraps operations (counts, latency, in-flight), resolver fields (latency)
+// and responses (error counts by extensions.code, including rejected operations)
+type MetricsMiddleware struct {
+	metrics *metrics.Metrics
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+	graphql.ResponseInterceptor
+} = &MetricsMiddleware{}
+
+// NewMetricsMiddleware creates a metrics extension recording into m
+func NewMetricsMiddleware(m *metrics.Metrics) *MetricsMiddleware {
+	return &MetricsMiddleware{metrics: m}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (mw *MetricsMiddleware) ExtensionName() string {
+	return "Metrics"
+}
+
+// Validate implements graphql.HandlerExtension
+func (mw *MetricsMiddleware) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptOperation records operation count, latency and in-flight gauge
+func (mw *MetricsMiddleware) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	name := operationLabel(oc)
+	opType := operationType(oc)
+
+	mw.metrics.RequestsInFlight.Inc()
+	start := time.Now()
+
+	response := next(ctx)
+
+	// Deferred and subscription responses call the handler repeatedly;
+	// the operation is accounted for once, on its first response
+	var once sync.Once
+
+	return func(ctx context.Context) *graphql.Response {
+		res := response(ctx)
+
+		once.Do(func() {
+			status := "success"
+			if res != nil && len(res.Errors) > 0 {
+				status = "error"
+			}
+
+			mw.metrics.RequestsInFlight.Dec()
+			mw.metrics.RequestsTotal.WithLabelValues(name, opType, status).Inc()
+			mw.metrics.RequestDuration.
+				WithLabelValues(name, opType).
+				Observe(time.Since(start).Seconds())
+		})
+
+		return res
+	}
+}
+
+// InterceptField records the latency of fields backed by a resolver function
+func (mw *MetricsMiddleware) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	mw.metrics.ResolverDuration.
+		WithLabelValues(fc.Object + "." + fc.Field.Name).
+		Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+// InterceptResponse counts response errors by extensions.code
+func (mw *MetricsMiddleware) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	res := next(ctx)
+	if res == nil {
+		return res
+	}
+
+	for _, err := range res.Errors {
+		code, _ := err.Extensions["code"].(string)
+		if code == "" {
+			code = errCodeNone
+		}
+		mw.metrics.ErrorsTotal.WithLabelValues(code).Inc()
+	}
+
+	return res
+}
+
+// operationLabel returns the operation label of the metrics: the root field
+// of the operation, "multiple" for operations selecting several root fields
+// and "other" otherwise. Root fields are defined by the schema; the client's
+// operation name is not used, as every distinct name would add a time series.
+func operationLabel(oc *graphql.OperationContext) string {
+	if oc == nil || oc.Operation == nil || oc.Doc == nil {
+		return operationLabelOther
+	}
+	fields := map[string]bool{}
+	for _, name := range rootFields(oc.Operation.SelectionSet, oc.Doc.Fragments, map[string]bool{}) {
+		fields[name] = true
+	}
+	switch len(fields) {
+	case 0:
+		return operationLabelOther
+	case 1:
+		for name := range fields {
+			return name
+		}
+	}
+	return operationLabelMultiple
+}
+
+// operationType returns query, mutation or subscription for the operation
+func operationType(oc *graphql.OperationContext) string {
+	if oc == nil || oc.Operation == nil {
+		return "unknown"
+	}
+	return string(oc.Operation.Operation)
+}
@@ -0,0 +1,215 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/ratelimit"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeRateLimited is the extensions.code returned when a client exceeds its budget
+const ErrCodeRateLimited = "RATE_LIMITED"
+
+func init() {
+	// The transport answers 422; RateLimit upgrades it to 429 when RequestInfoMiddleware is installed
+	errcode.RegisterErrorType(ErrCodeRateLimited, errcode.KindProtocol)
+}
+
+// RateLimit is a gqlgen extension applying token-bucket rate limits per client
+// Every query takes a token from the client's query budget and every mutation
+// from its mutation budget; root fields with an override (e.g. createEmployee)
+// additionally take a token from their own budget.
+type RateLimit struct {
+	store      ratelimit.Store
+	query      ratelimit.Limit
+	mutation   ratelimit.Limit
+	operations map[string]ratelimit.Limit // Keyed by lower-cased root field name
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &RateLimit{}
+
+// NewRateLimit creates the extension with budgets from cfg
+func NewRateLimit(store ratelimit.Store, cfg *config.RateLimitConfig) *RateLimit {
+	rl := &RateLimit{
+		store:      store,
+		query:      toLimit(cfg.Query),
+		mutation:   toLimit(cfg.Mutation),
+		operations: make(map[string]ratelimit.Limit, len(cfg.Operations)),
+	}
+	// Config keys are case-insensitive (viper lower-cases map keys)
+	for name, rule := range cfg.Operations {
+		rl.operations[strings.ToLower(name)] = toLimit(rule)
+	}
+	return rl
+}
+
+func toLimit(rule config.RateLimitRule) ratelimit.Limit {
+	return ratelimit.Limit{Rate: rule.RequestsPerSecond, Burst: rule.Burst}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (rl *RateLimit) ExtensionName() string {
+	return "RateLimit"
+}
+
+// Validate implements graphql.HandlerExtension
+func (rl *RateLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// budget is one bucket an operation takes a token from
+type budget struct {
+	name  string
+	limit ratelimit.Limit
+}
+
+// MutateOperationContext takes tokens for the operation before it executes
+// An operation rejected by one bucket gets the tokens it took from the others
+// back, so e.g. a rejected createEmployee does not use up the mutation budget.
+// Store failures are logged and the operation is allowed (fail open) so an
+// unavailable Redis does not take the API down.
+func (rl *RateLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	client := clientKey(ctx)
+
+	var taken []budget
+	for _, b := range rl.budgets(opCtx) {
+		if b.limit.Unlimited() {
+			continue
+		}
+
+		res, err := rl.store.Take(ctx, client+":"+b.name, b.limit)
+		if err != nil {
+			log := logger.GetLogger()
+			log.Warn().
+				Err(err).
+				Str("client", client).
+				Str("budget", b.name).
+				Msg("Rate limit store unavailable, allowing operation")
+			continue
+		}
+
+		if !res.Allowed {
+			rl.refund(ctx, client, taken)
+			return rl.reject(ctx, b.name, res.RetryAfter)
+		}
+		taken = append(taken, b)
+	}
+
+	return nil
+}
+
+// refund puts back the tokens taken from budgets
+func (rl *RateLimit) refund(ctx context.Context, client string, budgets []budget) {
+	for _, b := range budgets {
+		if err := rl.store.Refund(ctx, client+":"+b.name, b.limit); err != nil {
+			log := logger.GetLogger()
+			log.Warn().
+				Err(err).
+				Str("client", client).
+				Str("budget", b.name).
+				Msg("Failed to refund rate limit token")
+		}
+	}
+}
+
+// budgets returns the buckets an operation takes tokens from
+func (rl *RateLimit) budgets(opCtx *graphql.OperationContext) []budget {
+	var budgets []budget
+
+	switch opCtx.Operation.Operation {
+	case ast.Mutation:
+		budgets = append(budgets, budget{name: "mutation", limit: rl.mutation})
+	default:
+		// Subscriptions are charged once, when they start, like queries
+		budgets = append(budgets, budget{name: "query", limit: rl.query})
+	}
+
+	if len(rl.operations) == 0 {
+		return budgets
+	}
+
+	seen := map[string]bool{}
+	for _, field := range rootFields(opCtx.Operation.SelectionSet, opCtx.Doc.Fragments, map[string]bool{}) {
+		name := strings.ToLower(field)
+		limit, ok := rl.operations[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		budgets = append(budgets, budget{name: "field:" + field, limit: limit})
+	}
+
+	return budgets
+}
+
+// reject builds the RATE_LIMITED error and sets Retry-After / 429 on the HTTP response
+func (rl *RateLimit) reject(ctx context.Context, budgetName string, retryAfter time.Duration) *gqlerror.Error {
+	// Retry-After has second granularity; round up so clients never retry too early
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	if ri := GetRequestInfo(ctx); ri != nil {
+		ri.ResponseHeader().Set("Retry-After", strconv.Itoa(seconds))
+		ri.SetStatus(http.StatusTooManyRequests)
+	}
+
+	err := gqlerror.Errorf("rate limit exceeded for %s, retry after %d second(s)", budgetName, seconds)
+	errcode.Set(err, ErrCodeRateLimited)
+	err.Extensions["retryAfter"] = seconds
+	err.Extensions["budget"] = budgetName
+	return err
+}
+
+// clientKey identifies the caller: the authenticated principal, else the client IP
+func clientKey(ctx context.Context) string {
+	if p := auth.FromContext(ctx); p != nil {
+		return "principal:" + p.ID
+	}
+	if ri := GetRequestInfo(ctx); ri != nil && ri.ClientIP != "" {
+		return "ip:" + ri.ClientIP
+	}
+	return "anonymous"
+}
+
+// rootFields returns the names of the top-level fields of an operation,
+// looking through inline fragments and fragment spreads
+func rootFields(set ast.SelectionSet, fragments ast.FragmentDefinitionList, visited map[string]bool) []string {
+	var names []string
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(s.Name, "__") {
+				names = append(names, s.Name)
+			}
+		case *ast.InlineFragment:
+			names = append(names, rootFields(s.SelectionSet, fragments, visited)...)
+		case *ast.FragmentSpread:
+			if visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			if def := fragments.ForName(s.Name); def != nil {
+				names = append(names, rootFields(def.SelectionSet, fragments, visited)...)
+			}
+		}
+	}
+	return names
+}
+
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+
+	"gin-crud-api/internal/database"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ReadYourWritesMiddleware creates a GraphQL operation middleware that keeps
+// each operation consistent when reads are routed to replicas: once it writes,
+// its remaining reads go to the primary. Mutations use the primary from the
+// start, so the records they change are never read from a lagging replica.
+func ReadYourWritesMiddleware() graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		ctx = database.WithReadYourWrites(ctx)
+		if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Mutation {
+			database.UsePrimary(ctx)
+		}
+		return next(ctx)
+	}
+}
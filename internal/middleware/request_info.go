@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RequestInfo carries HTTP-level details of a request to GraphQL extensions,
+// which only see the context
+type RequestInfo struct {
+	ClientIP string // Remote address, or the forwarded client when proxy headers are trusted
+
+	requestHeader http.Header
+	header        http.Header
+	status        int
+}
+
+// RequestHeader returns the headers of the HTTP request (e.g. Idempotency-Key)
+func (ri *RequestInfo) RequestHeader() http.Header {
+	return ri.requestHeader
+}
+
+// ResponseHeader returns the headers of the HTTP response, which may be
+// modified until the GraphQL transport writes the response
+func (ri *RequestInfo) ResponseHeader() http.Header {
+	return ri.header
+}
+
+// SetStatus overrides the status code the GraphQL transport writes
+// (e.g. 429 for rate limited operations, which gqlgen would answer with 422)
+func (ri *RequestInfo) SetStatus(code int) {
+	ri.status = code
+}
+
+type requestInfoKey struct{}
+
+// GetRequestInfo returns the request info stored by RequestInfoMiddleware, or nil
+func GetRequestInfo(ctx context.Context) *RequestInfo {
+	ri, _ := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return ri
+}
+
+// RequestInfoMiddleware stores RequestInfo in the request context
+// When trustProxy is true the client IP is taken from X-Forwarded-For or
+// X-Real-IP; only enable it behind a proxy that overwrites these headers.
+func RequestInfoMiddleware(trustProxy bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ri := &RequestInfo{
+				ClientIP:      clientIP(r, trustProxy),
+				requestHeader: r.Header,
+				header:        w.Header(),
+			}
+			ctx := context.WithValue(r.Context(), requestInfoKey{}, ri)
+
+			// Websocket upgrades need the original writer for hijacking
+			if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			next.ServeHTTP(&statusWriter{ResponseWriter: w, info: ri}, r.WithContext(ctx))
+		})
+	}
+}
+
+// statusWriter applies the status override from RequestInfo
+type statusWriter struct {
+	http.ResponseWriter
+	info        *RequestInfo
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if w.info.status != 0 {
+		code = w.info.status
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush supports streaming transports (multipart, SSE)
+func (w *statusWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// clientIP returns the IP address of the client that sent r
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		// The left-most X-Forwarded-For entry is the original client
+		if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+			first, _, _ := strings.Cut(fwd, ",")
+			if ip := strings.TrimSpace(first); ip != "" {
+				return ip
+			}
+		}
+		if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/tenant"
+)
+
+// ErrCodeTenantInvalid is the extensions.code returned when the tenant of a request is rejected
+const ErrCodeTenantInvalid = "TENANT_INVALID"
+
+// TenantMiddleware resolves the tenant of a request and stores it in the context
+// The tenant claim of the authenticated principal wins; a header naming another
+// tenant is rejected with 403. Without a claim the header is used when
+// cfg.AllowHeader is set, otherwise the request acts for tenant.Default.
+// Must run after auth.Middleware so the principal is available.
+func TenantMiddleware(cfg config.TenancyConfig) func(http.Handler) http.Handler {
+	header := cfg.Header
+	if header == "" {
+		header = "X-Tenant-ID"
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requested := r.Header.Get(header)
+
+			id := tenant.Default
+			if p := auth.FromContext(r.Context()); p != nil && p.TenantID != "" {
+				if requested != "" && requested != p.TenantID {
+					rejectTenant(w, http.StatusForbidden, "tenant does not match the authenticated principal")
+					return
+				}
+				id = p.TenantID
+			} else if requested != "" {
+				if !cfg.AllowHeader {
+					rejectTenant(w, http.StatusForbidden, "tenant header is not accepted, use a token with a tenant claim")
+					return
+				}
+				id = requested
+			}
+
+			if !tenant.Valid(id) {
+				rejectTenant(w, http.StatusBadRequest, "invalid tenant ID")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(tenant.NewContext(r.Context(), id)))
+		})
+	}
+}
+
+func rejectTenant(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]any{{
+			"message":    message,
+			"extensions": map[string]any{"code": ErrCodeTenantInvalid},
+		}},
+	})
+}
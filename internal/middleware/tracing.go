@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"gin-crud-api/internal/tracing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware is a gqlgen handler extension creating OpenTelemetry spans
+// for each GraphQL operation and each field backed by a resolver function.
+// Register it before LoggingMiddleware so log lines carry the operation's trace ID.
+type TracingMiddleware struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = TracingMiddleware{}
+
+// NewTracingMiddleware creates the tracing extension (spans go to the global tracer provider)
+func NewTracingMiddleware() TracingMiddleware {
+	return TracingMiddleware{}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (TracingMiddleware) ExtensionName() string {
+	return "Tracing"
+}
+
+// Validate implements graphql.HandlerExtension
+func (TracingMiddleware) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptOperation wraps the whole operation, including resolver execution, in a span.
+// The document is only recorded as its SHA-256 hash (the hash of persisted
+// queries): its literals may hold personal data and secrets.
+func (TracingMiddleware) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	name := operationName(oc)
+	opType := operationType(oc)
+
+	ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("graphql.%s %s", opType, name),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("graphql.operation.name", name),
+			attribute.String("graphql.operation.type", opType),
+			attribute.String("graphql.document.hash", documentHash(oc.RawQuery)),
+		),
+	)
+
+	response := next(ctx)
+
+	// The span ends with the first response; see MetricsMiddleware for the same rule
+	var once sync.Once
+
+	return func(ctx context.Context) *graphql.Response {
+		res := response(ctx)
+
+		once.Do(func() {
+			if res != nil && len(res.Errors) > 0 {
+				span.SetAttributes(attribute.Int("graphql.errors.count", len(res.Errors)))
+				span.SetStatus(codes.Error, res.Errors[0].Message)
+			}
+			span.End()
+		})
+
+		return res
+	}
+}
+
+// operationName returns the name of the executed operation or "anonymous"
+func operationName(oc *graphql.OperationContext) string {
+	if oc == nil {
+		return "anonymous"
+	}
+	if oc.Operation != nil && oc.Operation.Name != "" {
+		return oc.Operation.Name
+	}
+	if oc.OperationName != "" {
+		return oc.OperationName
+	}
+	return "anonymous"
+}
+
+// documentHash returns the hex SHA-256 hash of a GraphQL document
+func documentHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// InterceptField creates a child span for each resolver call (e.g. Query.departments)
+func (TracingMiddleware) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || strings.HasPrefix(fc.Field.Name, "__") {
+		return next(ctx)
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(
+			attribute.String("graphql.field.path", fc.Path().String()),
+			attribute.String("graphql.field.name", fc.Field.Name),
+			attribute.String("graphql.field.parent_type", fc.Object),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return res, err
+}
@@ -0,0 +1,217 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Publisher is the part of a NATS connection the sink needs; *nats.Conn from
+// github.com/nats-io/nats.go satisfies it, as does the NATSConn below
+type Publisher interface {
+	Publish(subject string, data []byte) error
+	Flush() error
+}
+
+// NATSSink publishes each event to <prefix>.<aggregate type>.<event type>
+type NATSSink struct {
+	conn   Publisher
+	prefix string
+}
+
+// NewNATSSink creates a sink on an open connection; Close closes the
+// connection when it implements io.Closer
+func NewNATSSink(conn Publisher, subjectPrefix string) *NATSSink {
+	return &NATSSink{conn: conn, prefix: subjectPrefix}
+}
+
+// Publish sends the batch and waits for the server to acknowledge it with a flush
+func (s *NATSSink) Publish(_ context.Context, events []*Event) error {
+	for _, event := range events {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event %d: %w", event.Sequence, err)
+		}
+		if err := s.conn.Publish(s.Subject(event), body); err != nil {
+			return fmt.Errorf("failed to publish event %d: %w", event.Sequence, err)
+		}
+	}
+	if err := s.conn.Flush(); err != nil {
+		return fmt.Errorf("failed to flush events: %w", err)
+	}
+	return nil
+}
+
+// Subject returns the subject an event is published to
+func (s *NATSSink) Subject(event *Event) string {
+	subject := event.AggregateType + "." + event.Type
+	if s.prefix == "" {
+		return subject
+	}
+	return s.prefix + "." + subject
+}
+
+// Close closes the connection if it can be closed
+func (s *NATSSink) Close() error {
+	if c, ok := s.conn.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// NATSConn is a minimal publish-only client of the NATS text protocol, so the
+// relay needs no client library. TLS is not supported.
+type NATSConn struct {
+	mu      sync.Mutex
+	conn    net.Conn
+	r       *bufio.Reader
+	w       *bufio.Writer
+	timeout time.Duration
+}
+
+// DialNATS connects to a server given as nats://[user:pass@|token@]host[:port]
+func DialNATS(ctx context.Context, rawURL string, timeout time.Duration) (*NATSConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NATS URL: %w", err)
+	}
+	if u.Scheme != "nats" {
+		return nil, fmt.Errorf("unsupported NATS URL scheme %q", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	c := &NATSConn{
+		conn:    conn,
+		r:       bufio.NewReader(conn),
+		w:       bufio.NewWriter(conn),
+		timeout: timeout,
+	}
+	if err := c.handshake(u.User); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// handshake reads INFO, sends CONNECT and waits for the PONG of a PING
+func (c *NATSConn) handshake(user *url.Userinfo) error {
+	c.conn.SetReadDeadline(time.Now().Add(c.timeout))
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read NATS INFO: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected NATS greeting %q", strings.TrimSpace(line))
+	}
+	var info struct {
+		TLSRequired bool `json:"tls_required"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err == nil && info.TLSRequired {
+		return errors.New("NATS server requires TLS, which is not supported")
+	}
+
+	options := map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "gin-crud-api-outbox",
+		"lang":     "go",
+		"protocol": 1,
+	}
+	if user != nil {
+		if pass, ok := user.Password(); ok {
+			options["user"] = user.Username()
+			options["pass"] = pass
+		} else {
+			options["auth_token"] = user.Username()
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := fmt.Fprintf(c.w, "CONNECT %s\r\n", connect); err != nil {
+		return err
+	}
+	return c.flushLocked()
+}
+
+// Publish buffers a message; it is sent on the next Flush
+func (c *NATSConn) Publish(subject string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := fmt.Fprintf(c.w, "PUB %s %d\r\n", subject, len(data)); err != nil {
+		return err
+	}
+	if _, err := c.w.Write(data); err != nil {
+		return err
+	}
+	_, err := c.w.WriteString("\r\n")
+	return err
+}
+
+// Flush sends buffered messages and waits until the server has processed them
+func (c *NATSConn) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.flushLocked()
+}
+
+// flushLocked sends PING and reads until PONG, answering server PINGs;
+// the server processes commands in order, so PONG acknowledges everything before it
+func (c *NATSConn) flushLocked() error {
+	if _, err := c.w.WriteString("PING\r\n"); err != nil {
+		return err
+	}
+	if err := c.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write to NATS: %w", err)
+	}
+
+	c.conn.SetReadDeadline(time.Now().Add(c.timeout))
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read from NATS: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := c.w.WriteString("PONG\r\n"); err != nil {
+				return err
+			}
+			if err := c.w.Flush(); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+		// +OK and INFO updates need no answer
+	}
+}
+
+// Close closes the connection
+func (c *NATSConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,73 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveNATS accepts one connection and answers like a NATS server, sending
+// the subject of every PUB to subjects
+func serveNATS(t *testing.T, ln net.Listener, subjects chan<- string) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	conn.Write([]byte("INFO {\"server_id\":\"test\",\"max_payload\":1048576}\r\n"))
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			close(subjects)
+			return
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "PING":
+			conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			subjects <- fields[1]
+			// Skip the payload line
+			if _, err := r.ReadString('\n'); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+func TestNATSSink_PublishesToSubjects(t *testing.T) {
+	// Setup
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	subjects := make(chan string, 10)
+	go serveNATS(t, ln, subjects)
+
+	conn, err := DialNATS(context.Background(), "nats://"+ln.Addr().String(), time.Second)
+	require.NoError(t, err)
+	sink := NewNATSSink(conn, "hr.events")
+
+	// Test
+	err = sink.Publish(context.Background(), []*Event{
+		{Sequence: 1, Type: EmployeeHired, AggregateType: AggregateEmployee, Data: json.RawMessage(`{}`)},
+		{Sequence: 2, Type: ProjectStatusChanged, AggregateType: AggregateProject, Data: json.RawMessage(`{}`)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+
+	// Assert: the flush returned only after the server read both messages
+	var got []string
+	for s := range subjects {
+		got = append(got, s)
+	}
+	assert.Equal(t, []string{"hr.events.Employee.EmployeeHired", "hr.events.Project.ProjectStatusChanged"}, got)
+}
@@ -0,0 +1,158 @@
+// Package outbox publishes the domain events that repositories append to the
+// outbox table in the transaction of each change.
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/pii"
+)
+
+// Aggregate types, the entity an event belongs to
+const (
+	AggregateDepartment = "Department"
+	AggregateEmployee   = "Employee"
+	AggregateProject    = "Project"
+)
+
+// Event types
+const (
+	DepartmentCreated = "DepartmentCreated"
+	DepartmentUpdated = "DepartmentUpdated"
+	DepartmentDeleted = "DepartmentDeleted"
+
+	EmployeeHired       = "EmployeeHired"
+	EmployeeUpdated     = "EmployeeUpdated"
+	EmployeeTransferred = "EmployeeTransferred" // Department changed
+	EmployeeTerminated  = "EmployeeTerminated"
+	EmployeeRehired     = "EmployeeRehired"
+	EmployeeDeleted     = "EmployeeDeleted"
+
+	ProjectCreated       = "ProjectCreated"
+	ProjectUpdated       = "ProjectUpdated"
+	ProjectStatusChanged = "ProjectStatusChanged"
+	ProjectMemberAdded   = "ProjectMemberAdded"
+	ProjectMemberRemoved = "ProjectMemberRemoved"
+	ProjectDeleted       = "ProjectDeleted"
+)
+
+// Event is one domain event as published to sinks
+type Event struct {
+	Sequence      int64           `json:"sequence"` // Position in the stream, increasing
+	ID            string          `json:"id"`       // Stable across redeliveries, for deduplication
+	Type          string          `json:"type"`
+	TenantID      string          `json:"tenantID"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateID"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Department is the data of department events
+type Department struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	ParentID *string `json:"parentID,omitempty"`
+}
+
+// NewDepartment converts a department to event data
+func NewDepartment(dept *model.Department) *Department {
+	return &Department{ID: dept.ID, Name: dept.Name, ParentID: dept.ParentID}
+}
+
+// Employee is the data of employee events. Events leave the service through
+// the relay and webhooks, so classified fields are masked like for callers
+// without pii:read; consumers needing them query the API.
+type Employee struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	Email           string  `json:"email"` // Masked (a***@example.com)
+	DepartmentID    string  `json:"departmentID"`
+	JobTitle        *string `json:"jobTitle,omitempty"`
+	EmploymentType  string  `json:"employmentType"`
+	Status          string  `json:"status"`
+	HireDate        *string `json:"hireDate,omitempty"`
+	TerminationDate *string `json:"terminationDate,omitempty"`
+
+	// Set on EmployeeTransferred only
+	PreviousDepartmentID string `json:"previousDepartmentID,omitempty"`
+	// Set on EmployeeTerminated: projects the employee was removed from
+	LeftProjectIDs []string `json:"leftProjectIDs,omitempty"`
+}
+
+// NewEmployee converts an employee to event data
+func NewEmployee(emp *model.Employee) *Employee {
+	return &Employee{
+		ID:              emp.ID,
+		Name:            emp.Name,
+		Email:           pii.Mask(emp.Email),
+		DepartmentID:    emp.DepartmentID,
+		JobTitle:        emp.JobTitle,
+		EmploymentType:  string(emp.EmploymentType),
+		Status:          string(emp.Status),
+		HireDate:        emp.HireDate,
+		TerminationDate: emp.TerminationDate,
+	}
+}
+
+// Project is the data of project events
+type Project struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Description   *string  `json:"description,omitempty"`
+	Status        string   `json:"status"`
+	Priority      string   `json:"priority"`
+	StartDate     string   `json:"startDate"`
+	EndDate       string   `json:"endDate"`
+	Budget        float64  `json:"budget"`
+	LeadID        *string  `json:"leadID,omitempty"`
+	TeamMemberIDs []string `json:"teamMemberIDs"`
+}
+
+// NewProject converts a project with its loaded team to event data
+func NewProject(proj *model.Project) *Project {
+	data := &Project{
+		ID:            proj.ID,
+		Name:          proj.Name,
+		Description:   proj.Description,
+		Status:        string(proj.Status),
+		Priority:      string(proj.Priority),
+		StartDate:     proj.StartDate,
+		EndDate:       proj.EndDate,
+		Budget:        proj.Budget,
+		LeadID:        proj.LeadID,
+		TeamMemberIDs: make([]string, len(proj.TeamMembers)),
+	}
+	for i, member := range proj.TeamMembers {
+		data.TeamMemberIDs[i] = member.ID
+	}
+	return data
+}
+
+// StatusChange is the data of ProjectStatusChanged
+type StatusChange struct {
+	ProjectID string  `json:"projectID"`
+	From      string  `json:"from"`
+	To        string  `json:"to"`
+	Reason    *string `json:"reason,omitempty"`
+	ChangedBy *string `json:"changedBy,omitempty"`
+}
+
+// NewStatusChange converts a recorded status change to event data
+func NewStatusChange(change *model.ProjectStatusChange) *StatusChange {
+	return &StatusChange{
+		ProjectID: change.ProjectID,
+		From:      string(change.From),
+		To:        string(change.To),
+		Reason:    change.Reason,
+		ChangedBy: change.ChangedBy,
+	}
+}
+
+// Membership is the data of ProjectMemberAdded and ProjectMemberRemoved
+type Membership struct {
+	ProjectID  string `json:"projectID"`
+	EmployeeID string `json:"employeeID"`
+}
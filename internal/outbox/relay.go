@@ -0,0 +1,179 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/logger"
+)
+
+// Store reads the outbox and keeps consumer offsets
+type Store interface {
+	// Fetch returns up to limit events after position, in sequence order
+	Fetch(ctx context.Context, after int64, limit int) ([]*Event, error)
+	// FetchSequences returns the events among sequences that exist, in sequence order
+	FetchSequences(ctx context.Context, sequences []int64) ([]*Event, error)
+	// Offset returns the last published position of consumer (0 when new)
+	Offset(ctx context.Context, consumer string) (int64, error)
+	// CommitOffset stores the last published position of consumer
+	CommitOffset(ctx context.Context, consumer string, position int64) error
+}
+
+// Relay publishes outbox events to a sink in sequence order, committing the
+// consumer offset after each published batch.
+//
+// Sequences are assigned at insert, not commit, so a transaction can commit
+// an event below one the relay has already read. The relay remembers such
+// skipped sequences (gaps) and reads them again on every run until they
+// appear, published late, or the gap timeout passes and they are taken for
+// rolled back. The committed offset stays below the oldest gap.
+type Relay struct {
+	store Store
+	sink  Sink
+	cfg   config.OutboxConfig
+	now   func() time.Time
+
+	// Read state, loaded from the committed offset on the first run
+	loaded    bool
+	read      int64               // Highest sequence read
+	gaps      map[int64]time.Time // Skipped sequences below read, with the time they were first missed
+	committed int64               // Last committed offset
+}
+
+// NewRelay creates a relay; zero config values fall back to defaults
+func NewRelay(store Store, sink Sink, cfg config.OutboxConfig) *Relay {
+	if cfg.Consumer == "" {
+		cfg.Consumer = "relay"
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.GapTimeout <= 0 {
+		cfg.GapTimeout = time.Minute
+	}
+
+	return &Relay{
+		store: store,
+		sink:  sink,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// Run publishes new events every poll interval until ctx is canceled
+func (r *Relay) Run(ctx context.Context) {
+	log := logger.WithComponent("OutboxRelay")
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Keep going while full batches are waiting
+			for {
+				n, err := r.RelayOnce(ctx)
+				if err != nil {
+					log.Warn().
+						Err(err).
+						Str("consumer", r.cfg.Consumer).
+						Msg("Failed to relay outbox events")
+					break
+				}
+				if n < r.cfg.BatchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RelayOnce publishes the events that filled gaps and one batch after the
+// highest sequence read, and returns how many events it published.
+// A crash between publishing and committing publishes the events again.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	log := logger.WithComponent("OutboxRelay")
+
+	if !r.loaded {
+		offset, err := r.store.Offset(ctx, r.cfg.Consumer)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read offset: %w", err)
+		}
+		r.read, r.committed, r.gaps, r.loaded = offset, offset, map[int64]time.Time{}, true
+	}
+	now := r.now()
+
+	// A sequence missing for longer than the gap timeout was rolled back
+	for seq, since := range r.gaps {
+		if now.Sub(since) >= r.cfg.GapTimeout {
+			log.Warn().
+				Str("consumer", r.cfg.Consumer).
+				Int64("sequence", seq).
+				Msg("Outbox sequence never committed, skipping it")
+			delete(r.gaps, seq)
+		}
+	}
+
+	var late []*Event
+	if len(r.gaps) > 0 {
+		var err error
+		late, err = r.store.FetchSequences(ctx, slices.Sorted(maps.Keys(r.gaps)))
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch skipped events: %w", err)
+		}
+	}
+	fresh, err := r.store.Fetch(ctx, r.read, r.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	events := slices.Concat(late, fresh)
+	if len(events) > 0 {
+		if err := r.sink.Publish(ctx, events); err != nil {
+			return 0, err
+		}
+	}
+
+	// Close the gaps that filled and open those the new batch skipped
+	for _, e := range late {
+		delete(r.gaps, e.Sequence)
+	}
+	for _, e := range fresh {
+		for seq := r.read + 1; seq < e.Sequence; seq++ {
+			r.gaps[seq] = now
+		}
+		r.read = e.Sequence
+	}
+
+	// A restart resumes below the oldest gap
+	position := r.read
+	if len(r.gaps) > 0 {
+		position = slices.Min(slices.Collect(maps.Keys(r.gaps))) - 1
+	}
+	if position != r.committed {
+		if err := r.store.CommitOffset(ctx, r.cfg.Consumer, position); err != nil {
+			return 0, fmt.Errorf("failed to commit offset: %w", err)
+		}
+		r.committed = position
+	}
+
+	if len(events) > 0 {
+		log.Debug().
+			Str("consumer", r.cfg.Consumer).
+			Int("events", len(events)).
+			Int("late", len(late)).
+			Int("gaps", len(r.gaps)).
+			Int64("offset", position).
+			Msg("Outbox events published")
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,204 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is a Store over a slice of events; events of uncommitted
+// transactions hold a sequence but are not visible yet
+type memoryStore struct {
+	events      []*Event
+	uncommitted map[int64]bool
+	offsets     map[string]int64
+}
+
+func (s *memoryStore) Fetch(_ context.Context, after int64, limit int) ([]*Event, error) {
+	var events []*Event
+	for _, e := range s.events {
+		if e.Sequence > after && !s.uncommitted[e.Sequence] && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memoryStore) FetchSequences(_ context.Context, sequences []int64) ([]*Event, error) {
+	var events []*Event
+	for _, e := range s.events {
+		if slices.Contains(sequences, e.Sequence) && !s.uncommitted[e.Sequence] {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memoryStore) Offset(_ context.Context, consumer string) (int64, error) {
+	return s.offsets[consumer], nil
+}
+
+func (s *memoryStore) CommitOffset(_ context.Context, consumer string, position int64) error {
+	s.offsets[consumer] = position
+	return nil
+}
+
+// recordingSink keeps published events and fails while err is set
+type recordingSink struct {
+	published []*Event
+	err       error
+}
+
+func (s *recordingSink) Publish(_ context.Context, events []*Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.published = append(s.published, events...)
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func newTestStore(now time.Time, n int) *memoryStore {
+	store := &memoryStore{offsets: map[string]int64{}}
+	for i := 1; i <= n; i++ {
+		store.events = append(store.events, &Event{Sequence: int64(i), Type: EmployeeHired, OccurredAt: now.Add(-time.Minute)})
+	}
+	return store
+}
+
+func TestRelay_PublishesInOrderAndCommitsOffset(t *testing.T) {
+	// Setup
+	now := time.Now()
+	store := newTestStore(now, 5)
+	sink := &recordingSink{}
+	relay := NewRelay(store, sink, config.OutboxConfig{Consumer: "audit", BatchSize: 2})
+
+	// Test: three batches drain the outbox, the fourth finds nothing
+	for _, want := range []int{2, 2, 1, 0} {
+		n, err := relay.RelayOnce(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, want, n)
+	}
+
+	// Assert
+	require.Len(t, sink.published, 5)
+	for i, e := range sink.published {
+		assert.Equal(t, int64(i+1), e.Sequence)
+	}
+	assert.Equal(t, int64(5), store.offsets["audit"])
+}
+
+func TestRelay_FailedPublishIsRetried(t *testing.T) {
+	// Setup
+	now := time.Now()
+	store := newTestStore(now, 2)
+	sink := &recordingSink{err: errors.New("broker down")}
+	relay := NewRelay(store, sink, config.OutboxConfig{})
+
+	// Test: the failed batch keeps the offset, so it is published on the next run
+	_, err := relay.RelayOnce(context.Background())
+	require.Error(t, err)
+	assert.Zero(t, store.offsets["relay"])
+
+	sink.err = nil
+	n, err := relay.RelayOnce(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, int64(2), store.offsets["relay"])
+}
+
+func TestRelay_InterleavedTransactions(t *testing.T) {
+	// Setup: transaction A takes sequence 1, transaction B takes 2 and commits first
+	store := &memoryStore{
+		events: []*Event{
+			{Sequence: 1, Type: EmployeeHired},
+			{Sequence: 2, Type: ProjectCreated},
+		},
+		uncommitted: map[int64]bool{1: true},
+		offsets:     map[string]int64{},
+	}
+	sink := &recordingSink{}
+	relay := NewRelay(store, sink, config.OutboxConfig{})
+	ctx := context.Background()
+
+	// Test: B is published, the offset stays below A's sequence
+	n, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Zero(t, store.offsets["relay"])
+
+	// A commits and is published late
+	delete(store.uncommitted, 1)
+	n, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	n, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// Assert: nothing was lost or published twice
+	require.Len(t, sink.published, 2)
+	assert.Equal(t, int64(2), sink.published[0].Sequence)
+	assert.Equal(t, int64(1), sink.published[1].Sequence)
+	assert.Equal(t, int64(2), store.offsets["relay"])
+}
+
+func TestRelay_RestartResumesBelowGap(t *testing.T) {
+	// Setup: sequence 2 is still open when the relay stops
+	store := newTestStore(time.Now(), 3)
+	store.uncommitted = map[int64]bool{2: true}
+	sink := &recordingSink{}
+	ctx := context.Background()
+	_, err := NewRelay(store, sink, config.OutboxConfig{}).RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), store.offsets["relay"])
+
+	// Test: a new relay picks up the gap and publishes 3 again (at-least-once)
+	delete(store.uncommitted, 2)
+	n, err := NewRelay(store, sink, config.OutboxConfig{}).RelayOnce(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, int64(3), store.offsets["relay"])
+	require.Len(t, sink.published, 4)
+	assert.Equal(t, int64(2), sink.published[2].Sequence)
+}
+
+func TestRelay_GapTimeout(t *testing.T) {
+	// Setup: sequence 1 belongs to a transaction that rolled back
+	now := time.Now()
+	store := &memoryStore{
+		events:  []*Event{{Sequence: 2, Type: ProjectCreated}},
+		offsets: map[string]int64{},
+	}
+	sink := &recordingSink{}
+	relay := NewRelay(store, sink, config.OutboxConfig{GapTimeout: time.Minute})
+	relay.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Test: the gap holds the offset back until it times out
+	_, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, store.offsets["relay"])
+
+	relay.now = func() time.Time { return now.Add(time.Minute) }
+	n, err := relay.RelayOnce(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Equal(t, int64(2), store.offsets["relay"])
+	assert.Len(t, sink.published, 1)
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Sink receives published events. Publish returns only once every event of
+// the batch is durably handed over; the relay then commits its offset, so a
+// failed or interrupted batch is published again (at-least-once).
+type Sink interface {
+	Publish(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// WriterSink writes events as JSON lines, e.g. to stdout
+type WriterSink struct {
+	w    io.Writer
+	sync func() error
+	done func() error
+}
+
+// NewWriterSink creates a sink writing JSON lines to w
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// NewFileSink creates a sink appending JSON lines to the file at path,
+// syncing it to disk after every batch
+func NewFileSink(path string) (*WriterSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event file: %w", err)
+	}
+	return &WriterSink{w: f, sync: f.Sync, done: f.Close}, nil
+}
+
+// Publish writes one line per event
+func (s *WriterSink) Publish(_ context.Context, events []*Event) error {
+	buf := bufio.NewWriter(s.w)
+	enc := json.NewEncoder(buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("failed to write event %d: %w", event.Sequence, err)
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("failed to write events: %w", err)
+	}
+	if s.sync != nil {
+		if err := s.sync(); err != nil {
+			return fmt.Errorf("failed to sync events: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close closes the file of a file sink
+func (s *WriterSink) Close() error {
+	if s.done == nil {
+		return nil
+	}
+	return s.done()
+}
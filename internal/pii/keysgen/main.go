@@ -0,0 +1,68 @@
+// Command keysgen writes pii.Keys from the fields marked @pii in the GraphQL
+// schema, so log scrubbing follows the classification without a hand-kept list.
+// Run through go generate in internal/pii.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: keysgen <schema glob> <output file>")
+	}
+
+	paths, err := filepath.Glob(os.Args[1])
+	if err != nil || len(paths) == 0 {
+		log.Fatalf("no schema files match %q", os.Args[1])
+	}
+	var sources []*ast.Source
+	for _, path := range paths {
+		input, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sources = append(sources, &ast.Source{Name: path, Input: string(input)})
+	}
+	schema, gqlErr := gqlparser.LoadSchema(sources...)
+	if gqlErr != nil {
+		log.Fatal(gqlErr)
+	}
+
+	var keys []string
+	for _, def := range schema.Types {
+		for _, field := range def.Fields {
+			if field.Directives.ForName("pii") != nil && !slices.Contains(keys, field.Name) {
+				keys = append(keys, field.Name)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		log.Fatal("no fields are marked @pii")
+	}
+	slices.Sort(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by keysgen from the @pii fields of the GraphQL schema. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package pii")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Keys are the names of classified fields, used as log field keys")
+	fmt.Fprintf(&buf, "var Keys = %#v\n", keys)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(os.Args[2], src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
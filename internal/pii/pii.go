@@ -0,0 +1,60 @@
+// Package pii classifies personally identifiable information and masks it
+// for callers that may not read it. Classified fields carry Annotation in
+// the Ent schema and the @pii directive in the GraphQL schema; their names
+// are generated into Keys from the GraphQL schema so log output can be
+// scrubbed as well.
+package pii
+
+//go:generate go run ./keysgen ../graph/schema/*.graphql keys_gen.go
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"gin-crud-api/internal/auth"
+)
+
+// PermissionRead allows a principal to read unmasked PII
+const PermissionRead = "pii:read"
+
+// Annotation classifies an Ent schema field as PII
+type Annotation struct{}
+
+// Name implements schema.Annotation
+func (Annotation) Name() string {
+	return "PII"
+}
+
+// IsKey reports whether key names a classified field
+func IsKey(key string) bool {
+	for _, k := range Keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRead reports whether the caller of ctx may see unmasked PII: principals
+// with the pii:read permission, and system contexts without a principal,
+// which the privacy policies do not restrict either
+func CanRead(ctx context.Context) bool {
+	p := auth.FromContext(ctx)
+	return p.HasPermission(PermissionRead) || (p == nil && auth.IsSystem(ctx))
+}
+
+// Mask hides a classified value. Email addresses keep their first character
+// and domain (a***@example.com) so support can still tell them apart; other
+// values are replaced entirely.
+func Mask(value string) string {
+	if value == "" {
+		return ""
+	}
+	local, domain, ok := strings.Cut(value, "@")
+	if !ok || local == "" || domain == "" {
+		return "***"
+	}
+	_, size := utf8.DecodeRuneInString(local)
+	return local[:size] + "***@" + domain
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit configures a token bucket: it holds up to Burst tokens and refills
+// at Rate tokens per second. Each request takes one token.
+type Limit struct {
+	Rate  float64 // Tokens added per second
+	Burst int     // Bucket capacity
+}
+
+// Unlimited reports whether the limit disables rate limiting
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// Result is the outcome of taking a token
+type Result struct {
+	Allowed    bool          // Whether the request may proceed
+	Remaining  int           // Whole tokens left in the bucket
+	RetryAfter time.Duration // Time until a token is available (0 when allowed)
+}
+
+// Store keeps token buckets. Implementations must apply Take atomically so
+// concurrent requests (and, for shared stores, other API instances) cannot
+// overspend a bucket.
+type Store interface {
+	// Take removes one token from the bucket identified by key, creating a
+	// full bucket for limit if it does not exist yet
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+
+	// Refund puts back a token taken by Take, e.g. when another bucket
+	// rejected the request; the bucket never grows beyond its burst
+	Refund(ctx context.Context, key string, limit Limit) error
+}
+
+// bucket is the token bucket state shared by the store implementations
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket for the time elapsed since its last update and
+// tries to remove one token
+func (b *bucket) take(now time.Time, limit Limit) Result {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Result{Allowed: true, Remaining: int(b.tokens)}
+	}
+
+	wait := (1 - b.tokens) / limit.Rate
+	return Result{
+		Allowed:    false,
+		Remaining:  0,
+		RetryAfter: time.Duration(math.Ceil(wait * float64(time.Second))),
+	}
+}
+
+// refund refills the bucket for the time elapsed since its last update and
+// adds back one token
+func (b *bucket) refund(now time.Time, limit Limit) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * limit.Rate
+	}
+	b.last = now
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+1)
+}
+
+// refillDuration is how long an empty bucket takes to fill up again; a bucket
+// idle for longer is indistinguishable from a new one and can be dropped
+func refillDuration(limit Limit) time.Duration {
+	return time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+}
@@ -0,0 +1,238 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock shared by the stores under test
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1_700_000_000, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// newRedisStore starts a local Redis stand-in and returns a store using it
+func newRedisStore(t *testing.T, clock *fakeClock) (*RedisStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	store := NewRedisStore(client, "test:")
+	store.now = clock.Now
+	return store, mr
+}
+
+func newMemoryStore(clock *fakeClock) *MemoryStore {
+	store := NewMemoryStore()
+	store.now = clock.Now
+	store.lastSweep = clock.Now()
+	return store
+}
+
+// storeFactories runs the behavioral tests against both implementations
+func storeFactories() map[string]func(t *testing.T, clock *fakeClock) Store {
+	return map[string]func(t *testing.T, clock *fakeClock) Store{
+		"memory": func(t *testing.T, clock *fakeClock) Store { return newMemoryStore(clock) },
+		"redis": func(t *testing.T, clock *fakeClock) Store {
+			store, _ := newRedisStore(t, clock)
+			return store
+		},
+	}
+}
+
+func TestStore_BurstThenRejects(t *testing.T) {
+	for name, newStore := range storeFactories() {
+		t.Run(name, func(t *testing.T) {
+			clock := newFakeClock()
+			store := newStore(t, clock)
+			limit := Limit{Rate: 1, Burst: 3}
+			ctx := context.Background()
+
+			for i := 2; i >= 0; i-- {
+				res, err := store.Take(ctx, "client", limit)
+				require.NoError(t, err)
+				assert.True(t, res.Allowed)
+				assert.Equal(t, i, res.Remaining)
+			}
+
+			res, err := store.Take(ctx, "client", limit)
+			require.NoError(t, err)
+			assert.False(t, res.Allowed)
+			assert.Equal(t, time.Second, res.RetryAfter)
+		})
+	}
+}
+
+func TestStore_Refills(t *testing.T) {
+	for name, newStore := range storeFactories() {
+		t.Run(name, func(t *testing.T) {
+			clock := newFakeClock()
+			store := newStore(t, clock)
+			limit := Limit{Rate: 2, Burst: 2}
+			ctx := context.Background()
+
+			store.Take(ctx, "client", limit)
+			store.Take(ctx, "client", limit)
+
+			res, _ := store.Take(ctx, "client", limit)
+			require.False(t, res.Allowed)
+			assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+			// Half a second at 2 tokens/s adds one token
+			clock.Advance(500 * time.Millisecond)
+			res, err := store.Take(ctx, "client", limit)
+			require.NoError(t, err)
+			assert.True(t, res.Allowed)
+
+			// A long pause never fills the bucket beyond its burst
+			clock.Advance(time.Hour)
+			res, _ = store.Take(ctx, "client", limit)
+			assert.Equal(t, 1, res.Remaining)
+		})
+	}
+}
+
+func TestStore_KeysAreIndependent(t *testing.T) {
+	for name, newStore := range storeFactories() {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t, newFakeClock())
+			limit := Limit{Rate: 1, Burst: 1}
+			ctx := context.Background()
+
+			res, _ := store.Take(ctx, "alice", limit)
+			assert.True(t, res.Allowed)
+			res, _ = store.Take(ctx, "alice", limit)
+			assert.False(t, res.Allowed)
+
+			res, _ = store.Take(ctx, "bob", limit)
+			assert.True(t, res.Allowed)
+		})
+	}
+}
+
+func TestStore_Refund(t *testing.T) {
+	for name, newStore := range storeFactories() {
+		t.Run(name, func(t *testing.T) {
+			store := newStore(t, newFakeClock())
+			limit := Limit{Rate: 0.01, Burst: 2}
+			ctx := context.Background()
+
+			store.Take(ctx, "client", limit)
+			store.Take(ctx, "client", limit)
+			require.NoError(t, store.Refund(ctx, "client", limit))
+
+			res, _ := store.Take(ctx, "client", limit)
+			assert.True(t, res.Allowed)
+			res, _ = store.Take(ctx, "client", limit)
+			assert.False(t, res.Allowed)
+
+			// Refunds never fill a bucket beyond its burst, nor create one
+			require.NoError(t, store.Refund(ctx, "other", limit))
+			for i := 0; i < 3; i++ {
+				require.NoError(t, store.Refund(ctx, "client", limit))
+			}
+			res, _ = store.Take(ctx, "client", limit)
+			assert.Equal(t, 1, res.Remaining)
+			res, _ = store.Take(ctx, "other", limit)
+			assert.Equal(t, 1, res.Remaining)
+		})
+	}
+}
+
+func TestMemoryStore_SweepsIdleBuckets(t *testing.T) {
+	clock := newFakeClock()
+	store := newMemoryStore(clock)
+	ctx := context.Background()
+
+	store.Take(ctx, "idle", Limit{Rate: 1, Burst: 5})
+	require.Equal(t, 1, store.Len())
+
+	// After a sweep interval the idle bucket has refilled and is dropped
+	clock.Advance(sweepInterval + time.Second)
+	store.Take(ctx, "active", Limit{Rate: 1, Burst: 5})
+
+	assert.Equal(t, 1, store.Len())
+}
+
+func TestMemoryStore_Concurrent(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Rate: 0.001, Burst: 50}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		allowed int
+	)
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, _ := store.Take(context.Background(), "client", limit)
+			if res.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, allowed)
+}
+
+func TestRedisStore_SharedAcrossInstances(t *testing.T) {
+	clock := newFakeClock()
+	first, mr := newRedisStore(t, clock)
+
+	// A second API instance talking to the same server
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	second := NewRedisStore(client, "test:")
+	second.now = clock.Now
+
+	limit := Limit{Rate: 1, Burst: 2}
+	ctx := context.Background()
+
+	res, _ := first.Take(ctx, "client", limit)
+	assert.True(t, res.Allowed)
+	res, _ = second.Take(ctx, "client", limit)
+	assert.True(t, res.Allowed)
+	res, _ = first.Take(ctx, "client", limit)
+	assert.False(t, res.Allowed)
+
+	assert.True(t, mr.Exists("test:client"))
+	assert.Greater(t, mr.TTL("test:client"), time.Duration(0))
+}
+
+func TestRedisStore_ServerDown(t *testing.T) {
+	store, mr := newRedisStore(t, newFakeClock())
+	mr.Close()
+
+	_, err := store.Take(context.Background(), "client", Limit{Rate: 1, Burst: 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript applies the token bucket atomically inside Redis.
+// The bucket is a hash {tokens, ts}; it expires once it would be full again.
+// KEYS[1] = bucket key; ARGV = rate (tokens/s), burst, now (unix ms)
+// Returns {allowed (0/1), remaining tokens, retry after (ms)}
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+
+if now > ts then
+  tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+  ts = now
+end
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+
+return {allowed, math.floor(tokens), retry}
+`)
+
+// refundScript adds back one token to a bucket written by takeScript
+// KEYS[1] = bucket key; ARGV = rate (tokens/s), burst, now (unix ms)
+var refundScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  return 0
+end
+
+if now > ts then
+  tokens = tokens + (now - ts) * rate / 1000
+  ts = now
+end
+tokens = math.min(burst, tokens + 1)
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return 1
+`)
+
+// RedisStore keeps buckets in Redis (or any server speaking the Redis
+// protocol with Lua scripting), sharing limits across API instances
+type RedisStore struct {
+	client redis.Scripter
+	prefix string
+	now    func() time.Time
+}
+
+var _ Store = (*RedisStore)(nil)
+
+// NewRedisStore creates a store using client; keys are prefixed with prefix
+func NewRedisStore(client redis.Scripter, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix, now: time.Now}
+}
+
+// Take implements Store
+func (s *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	args := []any{
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64),
+		limit.Burst,
+		s.now().UnixMilli(),
+	}
+
+	values, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}, args...).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit script: %w", err)
+	}
+	if len(values) != 3 {
+		return Result{}, fmt.Errorf("rate limit script: unexpected reply %v", values)
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
+
+// Refund implements Store
+func (s *RedisStore) Refund(ctx context.Context, key string, limit Limit) error {
+	args := []any{
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64),
+		limit.Burst,
+		s.now().UnixMilli(),
+	}
+
+	if err := refundScript.Run(ctx, s.client, []string{s.prefix + key}, args...).Err(); err != nil {
+		return fmt.Errorf("rate limit refund script: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/logger"
+)
+
+// DefaultShutdownTimeout bounds graceful shutdown when none is configured
+const DefaultShutdownTimeout = 30 * time.Second
+
+// Server is an HTTP server with graceful shutdown that also drains websocket
+// subscriptions, which http.Server.Shutdown does not track once hijacked
+type Server struct {
+	httpServer      *http.Server
+	shutdownTimeout time.Duration
+
+	// Websocket connections run with a context canceled when shutdown starts;
+	// gqlgen then closes them with a normal closure frame
+	wsCtx    context.Context
+	wsCancel context.CancelFunc
+	wsConns  sync.WaitGroup
+}
+
+// New creates a server listening on addr with timeouts from cfg
+// Zero timeouts mean no timeout, except the shutdown timeout which defaults to
+// DefaultShutdownTimeout
+func New(addr string, cfg *config.ServerConfig, handler http.Handler) *Server {
+	s := &Server{
+		shutdownTimeout: cfg.ShutdownTimeout,
+	}
+	if s.shutdownTimeout <= 0 {
+		s.shutdownTimeout = DefaultShutdownTimeout
+	}
+	s.wsCtx, s.wsCancel = context.WithCancel(context.Background())
+
+	s.httpServer = &http.Server{
+		Addr:              addr,
+		Handler:           s.trackWebsockets(handler),
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+	s.httpServer.RegisterOnShutdown(s.wsCancel)
+
+	return s
+}
+
+// Run serves on the configured address until ctx is canceled (e.g. by SIGTERM),
+// then shuts down gracefully. It returns nil after a clean shutdown.
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, ln)
+}
+
+// Serve is like Run but accepts connections on ln
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.httpServer.Serve(ln)
+	}()
+
+	select {
+	case err := <-serveErr:
+		// Serve only returns early on listener failures
+		return err
+	case <-ctx.Done():
+	}
+
+	log := logger.GetLogger()
+	log.Info().
+		Dur("timeout", s.shutdownTimeout).
+		Msg("Shutdown signal received, draining in-flight requests")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	log.Info().Msg("Server stopped gracefully")
+	return nil
+}
+
+// Shutdown stops accepting connections, waits for in-flight requests, closes
+// websocket subscriptions and waits for their handlers to return.
+// If ctx expires first, remaining connections are closed and ctx's error is returned.
+func (s *Server) Shutdown(ctx context.Context) error {
+	// Cancels the websocket context via RegisterOnShutdown, then waits for HTTP requests
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.httpServer.Close()
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wsConns.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.httpServer.Close()
+		return ctx.Err()
+	}
+}
+
+// trackWebsockets runs websocket upgrade requests with the shutdown-aware
+// context and counts them so Shutdown can wait for them
+func (s *Server) trackWebsockets(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWebsocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		s.wsConns.Add(1)
+		defer s.wsConns.Done()
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(s.wsCtx, cancel)
+		defer stop()
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// isWebsocketUpgrade reports whether r asks to switch to the websocket protocol
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
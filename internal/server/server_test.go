@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/config"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer serves handler on a random local port until the returned cancel is called
+// The returned channel receives Serve's result
+func startServer(t *testing.T, cfg *config.ServerConfig, handler http.Handler) (string, context.CancelFunc, <-chan error) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	srv := New(ln.Addr().String(), cfg, handler)
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Serve(ctx, ln)
+	}()
+
+	return ln.Addr().String(), cancel, done
+}
+
+// TestServer_DrainsInFlightRequests tests that a request running when shutdown
+// starts completes, while new connections are refused
+func TestServer_DrainsInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		io.WriteString(w, "done")
+	})
+
+	addr, shutdown, done := startServer(t, &config.ServerConfig{ShutdownTimeout: 5 * time.Second}, handler)
+
+	type result struct {
+		body string
+		err  error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		res, err := http.Get("http://" + addr + "/query")
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		resCh <- result{body: string(body), err: err}
+	}()
+
+	<-started
+	shutdown()
+
+	// Listener closes promptly while the request is still running
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case err := <-done:
+		t.Fatalf("server stopped before in-flight request finished: %v", err)
+	default:
+	}
+
+	close(release)
+
+	res := <-resCh
+	require.NoError(t, res.err)
+	assert.Equal(t, "done", res.body)
+	assert.NoError(t, <-done)
+}
+
+// TestServer_ShutdownTimeout tests that a request outliving the deadline is cut off
+func TestServer_ShutdownTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+
+	addr, shutdown, done := startServer(t, &config.ServerConfig{ShutdownTimeout: 50 * time.Millisecond}, handler)
+
+	go http.Get("http://" + addr + "/query")
+	<-started
+	shutdown()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(2 * time.Second):
+		t.Fatal("shutdown did not honor its timeout")
+	}
+}
+
+// TestServer_ClosesWebsockets tests that subscriptions are told to stop and
+// waited for before shutdown completes
+func TestServer_ClosesWebsockets(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	handlerDone := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(handlerDone)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Like gqlgen's websocket transport: close normally once the context ends
+		<-r.Context().Done()
+		conn.WriteMessage(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, "terminated"))
+	})
+
+	addr, shutdown, done := startServer(t, &config.ServerConfig{ShutdownTimeout: 5 * time.Second}, handler)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr+"/query", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	shutdown()
+
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), "unexpected error: %v", err)
+
+	require.NoError(t, <-done)
+	select {
+	case <-handlerDone:
+	default:
+		t.Fatal("shutdown returned before the websocket handler finished")
+	}
+}
+
+// TestServer_AppliesTimeouts tests that ServerConfig timeouts reach http.Server
+func TestServer_AppliesTimeouts(t *testing.T) {
+	cfg := &config.ServerConfig{
+		ReadTimeout:       time.Second,
+		ReadHeaderTimeout: 2 * time.Second,
+		WriteTimeout:      3 * time.Second,
+		IdleTimeout:       4 * time.Second,
+	}
+
+	srv := New(":0", cfg, http.NotFoundHandler())
+
+	assert.Equal(t, time.Second, srv.httpServer.ReadTimeout)
+	assert.Equal(t, 2*time.Second, srv.httpServer.ReadHeaderTimeout)
+	assert.Equal(t, 3*time.Second, srv.httpServer.WriteTimeout)
+	assert.Equal(t, 4*time.Second, srv.httpServer.IdleTimeout)
+	assert.Equal(t, DefaultShutdownTimeout, srv.shutdownTimeout)
+}
+
+func TestIsWebsocketUpgrade(t *testing.T) {
+	r, _ := http.NewRequest(http.MethodGet, "/query", nil)
+	assert.False(t, isWebsocketUpgrade(r))
+
+	r.Header.Set("Connection", "keep-alive, Upgrade")
+	r.Header.Set("Upgrade", "websocket")
+	assert.True(t, isWebsocketUpgrade(r))
+
+	r.Header.Set("Upgrade", "h2c")
+	assert.False(t, isWebsocketUpgrade(r))
+}
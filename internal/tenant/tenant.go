@@ -0,0 +1,49 @@
+// Package tenant carries the tenant (subsidiary) a request acts for. The Ent
+// schemas read it to scope every query and mutation to that tenant's rows.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default is the tenant of contexts without one: single-tenant deployments,
+// tests and rows created before multi-tenancy
+const Default = "default"
+
+// validID matches tenant IDs: lower-case slugs such as "acme" or "acme-eu"
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+type contextKey struct{}
+
+type unscopedKey struct{}
+
+// NewContext returns a copy of ctx acting for tenant id
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant of ctx, or Default when none was set
+func FromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(contextKey{}).(string); ok && id != "" {
+		return id
+	}
+	return Default
+}
+
+// WithoutScope returns a copy of ctx whose queries see every tenant, for
+// background jobs that serve all tenants (e.g. the webhook dispatcher)
+func WithoutScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscopedKey{}, true)
+}
+
+// IsUnscoped reports whether ctx was marked by WithoutScope
+func IsUnscoped(ctx context.Context) bool {
+	unscoped, _ := ctx.Value(unscopedKey{}).(bool)
+	return unscoped
+}
+
+// Valid reports whether id is a well-formed tenant ID
+func Valid(id string) bool {
+	return validID.MatchString(id)
+}
@@ -0,0 +1,105 @@
+// Package conformance is a test suite shared by the storage backends. Every
+// backend runs the same tests against its DepartmentRepository,
+// EmployeeRepository and ProjectRepository, so the memory driver and the SQL
+// drivers cannot drift apart on CRUD, not-found semantics, cascades,
+// duplicate emails or team membership.
+//
+// It lives apart from package testutil because it imports package database,
+// whose own tests import testutil.
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// Repositories are the repositories of the backend under test. They must
+// share one store so that cascades reach across them.
+type Repositories struct {
+	Departments database.DepartmentRepository
+	Employees   database.EmployeeRepository
+	Projects    database.ProjectRepository
+}
+
+// Factory creates the repositories of a backend on an empty store. It is
+// called once per test with that test's t, so it can register cleanups.
+type Factory func(t *testing.T) Repositories
+
+// Run runs the conformance suite against the repositories created by newRepos
+func Run(t *testing.T, newRepos Factory) {
+	t.Run("Department", func(t *testing.T) { runDepartmentTests(t, newRepos) })
+	t.Run("Employee", func(t *testing.T) { runEmployeeTests(t, newRepos) })
+	t.Run("Project", func(t *testing.T) { runProjectTests(t, newRepos) })
+}
+
+// conformanceTest is one test of the suite
+type conformanceTest struct {
+	name string
+	run  func(t *testing.T, repos Repositories)
+}
+
+// runTests runs each test as a subtest on fresh repositories
+func runTests(t *testing.T, newRepos Factory, tests []conformanceTest) {
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.run(t, newRepos(t))
+		})
+	}
+}
+
+// missingID is a well-formed ID that no row has
+func missingID() string {
+	return uuid.NewString()
+}
+
+func saveDepartment(t *testing.T, repos Repositories, name string) *model.Department {
+	dept := &model.Department{ID: uuid.NewString(), Name: name}
+	require.NoError(t, repos.Departments.Save(auth.WithSystem(context.Background()), dept))
+	return dept
+}
+
+func saveEmployee(t *testing.T, repos Repositories, deptID, name, email string) *model.Employee {
+	emp := &model.Employee{ID: uuid.NewString(), Name: name, Email: email, DepartmentID: deptID}
+	require.NoError(t, repos.Employees.Save(auth.WithSystem(context.Background()), emp))
+	return emp
+}
+
+// newProject returns an ACTIVE project led by and staffed with members
+func newProject(name string, members ...*model.Employee) *model.Project {
+	proj := &model.Project{
+		ID:          uuid.NewString(),
+		Name:        name,
+		Status:      model.ProjectStatusActive,
+		Priority:    model.ProjectPriorityMedium,
+		StartDate:   "2025-01-01",
+		EndDate:     "2025-12-31",
+		Budget:      10000,
+		TeamMembers: members,
+	}
+	if len(members) > 0 {
+		proj.LeadID = &members[0].ID
+	}
+	return proj
+}
+
+func saveProject(t *testing.T, repos Repositories, name string, members ...*model.Employee) *model.Project {
+	proj := newProject(name, members...)
+	require.NoError(t, repos.Projects.Save(auth.WithSystem(context.Background()), proj))
+	return proj
+}
+
+// teamIDs returns the IDs of a project's team members
+func teamIDs(proj *model.Project) []string {
+	ids := make([]string, len(proj.TeamMembers))
+	for i, member := range proj.TeamMembers {
+		ids[i] = member.ID
+	}
+	return ids
+}
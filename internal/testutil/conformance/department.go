@@ -0,0 +1,144 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runDepartmentTests(t *testing.T, newRepos Factory) {
+	runTests(t, newRepos, []conformanceTest{
+		{"CRUD", testDepartmentCRUD},
+		{"NotFound", testDepartmentNotFound},
+		{"InvalidID", testDepartmentInvalidID},
+		{"EmptyName", testDepartmentEmptyName},
+		{"DeleteWithEmployees", testDepartmentDeleteWithEmployees},
+		{"DeleteParent", testDepartmentDeleteParent},
+	})
+}
+
+func testDepartmentCRUD(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	all, err := repos.Departments.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	eng := saveDepartment(t, repos, "Engineering")
+	sales := saveDepartment(t, repos, "Sales")
+
+	// Test: read back
+	found, err := repos.Departments.FindByID(ctx, eng.ID)
+	require.NoError(t, err)
+	assert.Equal(t, eng.Name, found.Name)
+	assert.Nil(t, found.ParentID)
+
+	all, err = repos.Departments.FindAll(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{eng.ID, sales.ID}, []string{all[0].ID, all[1].ID})
+
+	// Test: update moves the department under another
+	found.Name = "Platform"
+	found.ParentID = &sales.ID
+	require.NoError(t, repos.Departments.Update(ctx, found))
+
+	updated, err := repos.Departments.FindByID(ctx, eng.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Platform", updated.Name)
+	require.NotNil(t, updated.ParentID)
+	assert.Equal(t, sales.ID, *updated.ParentID)
+
+	// Test: delete
+	require.NoError(t, repos.Departments.Delete(ctx, eng.ID))
+
+	// Assert
+	_, err = repos.Departments.FindByID(ctx, eng.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+	all, err = repos.Departments.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func testDepartmentNotFound(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	_, err := repos.Departments.FindByID(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Departments.Update(ctx, &model.Department{ID: missingID(), Name: "Ghost"})
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Departments.Delete(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testDepartmentInvalidID(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	err := repos.Departments.Save(ctx, &model.Department{ID: "invalid-uuid", Name: "Engineering"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid department ID")
+
+	_, err = repos.Departments.FindByID(ctx, "invalid-uuid")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Departments.Delete(ctx, "invalid-uuid")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, database.ErrNotFound)
+}
+
+func testDepartmentEmptyName(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	err := repos.Departments.Save(ctx, &model.Department{ID: uuid.NewString(), Name: ""})
+	require.Error(t, err)
+
+	all, err := repos.Departments.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func testDepartmentDeleteWithEmployees(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	emp := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+
+	// Test: a department with employees cannot be deleted
+	err := repos.Departments.Delete(ctx, dept.ID)
+
+	// Assert: both rows are kept
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, database.ErrNotFound)
+	_, err = repos.Departments.FindByID(ctx, dept.ID)
+	assert.NoError(t, err)
+	_, err = repos.Employees.FindByID(ctx, emp.ID)
+	assert.NoError(t, err)
+}
+
+func testDepartmentDeleteParent(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	parent := saveDepartment(t, repos, "Engineering")
+	child := &model.Department{ID: uuid.NewString(), Name: "Platform", ParentID: &parent.ID}
+	require.NoError(t, repos.Departments.Save(ctx, child))
+
+	// Test
+	require.NoError(t, repos.Departments.Delete(ctx, parent.ID))
+
+	// Assert: the sub-department becomes top-level
+	found, err := repos.Departments.FindByID(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.ParentID)
+}
@@ -0,0 +1,290 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runEmployeeTests(t *testing.T, newRepos Factory) {
+	runTests(t, newRepos, []conformanceTest{
+		{"CRUD", testEmployeeCRUD},
+		{"Defaults", testEmployeeDefaults},
+		{"NotFound", testEmployeeNotFound},
+		{"InvalidID", testEmployeeInvalidID},
+		{"MissingDepartment", testEmployeeMissingDepartment},
+		{"DuplicateEmail", testEmployeeDuplicateEmail},
+		{"FindByDepartmentID", testEmployeeFindByDepartmentID},
+		{"DeleteCascades", testEmployeeDeleteCascades},
+		{"TerminateAndRehire", testEmployeeTerminateAndRehire},
+	})
+}
+
+func testEmployeeCRUD(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	eng := saveDepartment(t, repos, "Engineering")
+	sales := saveDepartment(t, repos, "Sales")
+	title, phone := "Engineer", "+1 555 0100"
+	emp := &model.Employee{
+		ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: eng.ID,
+		JobTitle: &title, Phone: &phone, EmploymentType: model.EmploymentTypeContractor,
+	}
+
+	// Test: save and read back
+	require.NoError(t, repos.Employees.Save(ctx, emp))
+
+	found, err := repos.Employees.FindByID(ctx, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ann", found.Name)
+	assert.Equal(t, "ann@example.com", found.Email)
+	assert.Equal(t, eng.ID, found.DepartmentID)
+	require.NotNil(t, found.JobTitle)
+	assert.Equal(t, title, *found.JobTitle)
+	require.NotNil(t, found.Phone)
+	assert.Equal(t, phone, *found.Phone)
+	assert.Nil(t, found.Location)
+	assert.Equal(t, model.EmploymentTypeContractor, found.EmploymentType)
+
+	// Test: update moves the employee and clears the job title
+	found.Name = "Ann Smith"
+	found.Email = "ann.smith@example.com"
+	found.DepartmentID = sales.ID
+	found.JobTitle = nil
+	require.NoError(t, repos.Employees.Update(ctx, found))
+
+	updated, err := repos.Employees.FindByID(ctx, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ann Smith", updated.Name)
+	assert.Equal(t, "ann.smith@example.com", updated.Email)
+	assert.Equal(t, sales.ID, updated.DepartmentID)
+	assert.Nil(t, updated.JobTitle)
+
+	all, err := repos.Employees.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, emp.ID, all[0].ID)
+
+	// Test: delete
+	require.NoError(t, repos.Employees.Delete(ctx, emp.ID))
+
+	// Assert
+	_, err = repos.Employees.FindByID(ctx, emp.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testEmployeeDefaults(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	emp := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+
+	// Assert: unset enums take the schema defaults
+	found, err := repos.Employees.FindByID(ctx, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentTypeFullTime, found.EmploymentType)
+	assert.Equal(t, model.EmploymentStatusActive, found.Status)
+
+	// Test: an update without enums keeps them
+	found.EmploymentType = ""
+	found.Status = ""
+	require.NoError(t, repos.Employees.Update(ctx, found))
+
+	updated, err := repos.Employees.FindByID(ctx, emp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentTypeFullTime, updated.EmploymentType)
+	assert.Equal(t, model.EmploymentStatusActive, updated.Status)
+}
+
+func testEmployeeNotFound(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+	dept := saveDepartment(t, repos, "Engineering")
+
+	_, err := repos.Employees.FindByID(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Employees.Update(ctx, &model.Employee{ID: missingID(), Name: "Ghost", Email: "ghost@example.com", DepartmentID: dept.ID})
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Employees.Delete(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Employees.Terminate(ctx, missingID(), time.Now())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Employees.Rehire(ctx, missingID(), time.Now())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testEmployeeInvalidID(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+	dept := saveDepartment(t, repos, "Engineering")
+
+	err := repos.Employees.Save(ctx, &model.Employee{ID: "invalid-uuid", Name: "Ann", Email: "ann@example.com", DepartmentID: dept.ID})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid employee ID")
+
+	err = repos.Employees.Save(ctx, &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: "invalid-uuid"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid department ID")
+
+	_, err = repos.Employees.FindByDepartmentID(ctx, "invalid-uuid")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, database.ErrNotFound)
+}
+
+func testEmployeeMissingDepartment(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Test: the department does not exist
+	err := repos.Employees.Save(ctx, &model.Employee{ID: uuid.NewString(), Name: "Ann", Email: "ann@example.com", DepartmentID: missingID()})
+
+	// Assert
+	require.Error(t, err)
+	all, err := repos.Employees.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func testEmployeeDuplicateEmail(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+
+	// Test: saving another employee with the same email fails
+	err := repos.Employees.Save(ctx, &model.Employee{ID: uuid.NewString(), Name: "Ann Again", Email: ann.Email, DepartmentID: dept.ID})
+	require.Error(t, err)
+
+	// Test: taking another employee's email fails
+	bob.Email = ann.Email
+	err = repos.Employees.Update(ctx, bob)
+	require.Error(t, err)
+
+	// Assert: nothing changed
+	all, err := repos.Employees.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	found, err := repos.Employees.FindByID(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", found.Email)
+
+	// Test: keeping one's own email is not a duplicate
+	found.Name = "Robert"
+	assert.NoError(t, repos.Employees.Update(ctx, found))
+}
+
+func testEmployeeFindByDepartmentID(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	eng := saveDepartment(t, repos, "Engineering")
+	sales := saveDepartment(t, repos, "Sales")
+	empty := saveDepartment(t, repos, "Legal")
+	ann := saveEmployee(t, repos, eng.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, eng.ID, "Bob", "bob@example.com")
+	saveEmployee(t, repos, sales.ID, "Cid", "cid@example.com")
+
+	// Test
+	inEng, err := repos.Employees.FindByDepartmentID(ctx, eng.ID)
+	require.NoError(t, err)
+	inEmpty, err := repos.Employees.FindByDepartmentID(ctx, empty.ID)
+	require.NoError(t, err)
+	inMissing, err := repos.Employees.FindByDepartmentID(ctx, missingID())
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, inEng, 2)
+	assert.ElementsMatch(t, []string{ann.ID, bob.ID}, []string{inEng[0].ID, inEng[1].ID})
+	assert.Empty(t, inEmpty)
+	assert.Empty(t, inMissing)
+}
+
+func testEmployeeDeleteCascades(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup: Ann leads a project she works on and has a task in it
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	proj := saveProject(t, repos, "Apollo", ann, bob)
+	task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Design", AssigneeID: &ann.ID}
+	require.NoError(t, repos.Projects.SaveTask(ctx, task))
+
+	// Test
+	require.NoError(t, repos.Employees.Delete(ctx, ann.ID))
+
+	// Assert: the project and task are kept without her
+	found, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{bob.ID}, teamIDs(found))
+	assert.Nil(t, found.LeadID)
+
+	foundTask, err := repos.Projects.FindTaskByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, foundTask.AssigneeID)
+}
+
+func testEmployeeTerminateAndRehire(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup: Ann works on an active and a completed project, with a task on each
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	active := saveProject(t, repos, "Active", ann)
+	completed := newProject("Done", ann)
+	completed.Status = model.ProjectStatusCompleted
+	require.NoError(t, repos.Projects.Save(ctx, completed))
+	activeTask := &model.Task{ID: uuid.NewString(), ProjectID: active.ID, Title: "Ship", AssigneeID: &ann.ID}
+	require.NoError(t, repos.Projects.SaveTask(ctx, activeTask))
+	completedTask := &model.Task{ID: uuid.NewString(), ProjectID: completed.ID, Title: "Land", AssigneeID: &ann.ID}
+	require.NoError(t, repos.Projects.SaveTask(ctx, completedTask))
+
+	// Test: terminate
+	require.NoError(t, repos.Employees.Terminate(ctx, ann.ID, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+	// Assert: terminated and only kept on the completed project
+	found, err := repos.Employees.FindByID(ctx, ann.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusTerminated, found.Status)
+	require.NotNil(t, found.TerminationDate)
+	assert.Equal(t, "2025-06-30", *found.TerminationDate)
+
+	onActive, err := repos.Projects.IsTeamMember(ctx, active.ID, ann.ID)
+	require.NoError(t, err)
+	assert.False(t, onActive)
+	onCompleted, err := repos.Projects.IsTeamMember(ctx, completed.ID, ann.ID)
+	require.NoError(t, err)
+	assert.True(t, onCompleted)
+
+	// Her task on the active project is unassigned in the same transaction
+	task, err := repos.Projects.FindTaskByID(ctx, activeTask.ID)
+	require.NoError(t, err)
+	assert.Nil(t, task.AssigneeID)
+	task, err = repos.Projects.FindTaskByID(ctx, completedTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &ann.ID, task.AssigneeID)
+
+	// Test: rehire
+	require.NoError(t, repos.Employees.Rehire(ctx, ann.ID, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)))
+
+	// Assert: active again with the new hire date
+	found, err = repos.Employees.FindByID(ctx, ann.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EmploymentStatusActive, found.Status)
+	require.NotNil(t, found.HireDate)
+	assert.Equal(t, "2026-01-05", *found.HireDate)
+	assert.Nil(t, found.TerminationDate)
+}
@@ -0,0 +1,485 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/database"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runProjectTests(t *testing.T, newRepos Factory) {
+	runTests(t, newRepos, []conformanceTest{
+		{"CRUD", testProjectCRUD},
+		{"UpdateKeepsStatus", testProjectUpdateKeepsStatus},
+		{"UpdateTeam", testProjectUpdateTeam},
+		{"NotFound", testProjectNotFound},
+		{"InvalidInput", testProjectInvalidInput},
+		{"FindByStatus", testProjectFindByStatus},
+		{"TeamMembership", testProjectTeamMembership},
+		{"TerminatedCannotJoin", testProjectTerminatedCannotJoin},
+		{"DeleteCascades", testProjectDeleteCascades},
+		{"DeleteMilestone", testProjectDeleteMilestone},
+		{"TaskAssigneeIsMember", testProjectTaskAssigneeIsMember},
+		{"RemoveMemberUnassignsTasks", testProjectRemoveMemberUnassignsTasks},
+		{"TransitionStatus", testProjectTransitionStatus},
+	})
+}
+
+func testProjectCRUD(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	description := "Moon landing"
+	proj := newProject("Apollo", ann)
+	proj.Description = &description
+
+	// Test: save and read back
+	require.NoError(t, repos.Projects.Save(ctx, proj))
+
+	found, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Apollo", found.Name)
+	require.NotNil(t, found.Description)
+	assert.Equal(t, description, *found.Description)
+	assert.Equal(t, model.ProjectStatusActive, found.Status)
+	assert.Equal(t, model.ProjectPriorityMedium, found.Priority)
+	assert.Equal(t, "2025-01-01", found.StartDate)
+	assert.Equal(t, "2025-12-31", found.EndDate)
+	assert.InDelta(t, 10000, found.Budget, 0.001)
+	require.NotNil(t, found.LeadID)
+	assert.Equal(t, ann.ID, *found.LeadID)
+	require.Len(t, found.TeamMembers, 1)
+	assert.Equal(t, "Ann", found.TeamMembers[0].Name)
+
+	// Test: update
+	found.Name = "Artemis"
+	found.Priority = model.ProjectPriorityHigh
+	found.Budget = 25000
+	found.Description = nil
+	found.LeadID = nil
+	require.NoError(t, repos.Projects.Update(ctx, found))
+
+	updated, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Artemis", updated.Name)
+	assert.Equal(t, model.ProjectPriorityHigh, updated.Priority)
+	assert.InDelta(t, 25000, updated.Budget, 0.001)
+	assert.Nil(t, updated.Description)
+	assert.Nil(t, updated.LeadID)
+
+	all, err := repos.Projects.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, proj.ID, all[0].ID)
+
+	// Test: delete
+	require.NoError(t, repos.Projects.Delete(ctx, proj.ID))
+
+	// Assert: the project is gone, its team members are not
+	_, err = repos.Projects.FindByID(ctx, proj.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+	_, err = repos.Employees.FindByID(ctx, ann.ID)
+	assert.NoError(t, err)
+}
+
+func testProjectUpdateKeepsStatus(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	proj := saveProject(t, repos, "Apollo")
+
+	// Test: Update ignores the status; only TransitionStatus changes it
+	proj.Status = model.ProjectStatusCompleted
+	require.NoError(t, repos.Projects.Update(ctx, proj))
+
+	// Assert
+	found, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusActive, found.Status)
+}
+
+func testProjectUpdateTeam(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	proj := saveProject(t, repos, "Apollo", ann)
+
+	// Test: a nil team keeps the members
+	proj.TeamMembers = nil
+	require.NoError(t, repos.Projects.Update(ctx, proj))
+
+	found, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ann.ID}, teamIDs(found))
+
+	// Test: a non-nil team replaces them
+	found.TeamMembers = []*model.Employee{{ID: bob.ID}}
+	require.NoError(t, repos.Projects.Update(ctx, found))
+
+	found, err = repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{bob.ID}, teamIDs(found))
+
+	// Test: an empty team removes everyone
+	found.TeamMembers = []*model.Employee{}
+	require.NoError(t, repos.Projects.Update(ctx, found))
+
+	// Assert
+	found, err = repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, found.TeamMembers)
+}
+
+func testProjectNotFound(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+
+	_, err := repos.Projects.FindByID(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Projects.Update(ctx, newProject("Ghost"))
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Projects.Delete(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	err = repos.Projects.AddTeamMember(ctx, missingID(), ann.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	_, err = repos.Projects.FindMilestoneByID(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	_, err = repos.Projects.FindTaskByID(ctx, missingID())
+	assert.ErrorIs(t, err, database.ErrNotFound)
+
+	isMember, err := repos.Projects.IsTeamMember(ctx, missingID(), ann.ID)
+	require.NoError(t, err)
+	assert.False(t, isMember)
+}
+
+func testProjectInvalidInput(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	invalidID := newProject("Apollo")
+	invalidID.ID = "invalid-uuid"
+	err := repos.Projects.Save(ctx, invalidID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid project ID")
+
+	invalidDate := newProject("Apollo")
+	invalidDate.StartDate = "01/01/2025"
+	assert.Error(t, repos.Projects.Save(ctx, invalidDate))
+
+	zeroBudget := newProject("Apollo")
+	zeroBudget.Budget = 0
+	assert.Error(t, repos.Projects.Save(ctx, zeroBudget))
+
+	missingLead := newProject("Apollo")
+	lead := missingID()
+	missingLead.LeadID = &lead
+	assert.Error(t, repos.Projects.Save(ctx, missingLead))
+
+	all, err := repos.Projects.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func testProjectFindByStatus(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	active := saveProject(t, repos, "Active")
+	planned := newProject("Planned")
+	planned.Status = model.ProjectStatusPlanned
+	require.NoError(t, repos.Projects.Save(ctx, planned))
+
+	// Test
+	activeProjects, err := repos.Projects.FindByStatus(ctx, model.ProjectStatusActive)
+	require.NoError(t, err)
+	plannedProjects, err := repos.Projects.FindByStatus(ctx, model.ProjectStatusPlanned)
+	require.NoError(t, err)
+	cancelledProjects, err := repos.Projects.FindByStatus(ctx, model.ProjectStatusCancelled)
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, activeProjects, 1)
+	assert.Equal(t, active.ID, activeProjects[0].ID)
+	require.Len(t, plannedProjects, 1)
+	assert.Equal(t, planned.ID, plannedProjects[0].ID)
+	assert.Empty(t, cancelledProjects)
+}
+
+func testProjectTeamMembership(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	apollo := saveProject(t, repos, "Apollo", ann)
+	artemis := saveProject(t, repos, "Artemis")
+
+	// Test: add Bob to both projects
+	require.NoError(t, repos.Projects.AddTeamMember(ctx, apollo.ID, bob.ID))
+	require.NoError(t, repos.Projects.AddTeamMember(ctx, artemis.ID, bob.ID))
+
+	// Assert
+	isMember, err := repos.Projects.IsTeamMember(ctx, apollo.ID, bob.ID)
+	require.NoError(t, err)
+	assert.True(t, isMember)
+
+	found, err := repos.Projects.FindByID(ctx, apollo.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ann.ID, bob.ID}, teamIDs(found))
+
+	bobProjects, err := repos.Projects.FindByEmployeeID(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, bobProjects, 2)
+	assert.ElementsMatch(t, []string{apollo.ID, artemis.ID}, []string{bobProjects[0].ID, bobProjects[1].ID})
+
+	// Test: adding a member again keeps one membership
+	require.NoError(t, repos.Projects.AddTeamMember(ctx, apollo.ID, ann.ID))
+	found, err = repos.Projects.FindByID(ctx, apollo.ID)
+	require.NoError(t, err)
+	assert.Len(t, found.TeamMembers, 2)
+
+	// Test: an employee that does not exist cannot join
+	err = repos.Projects.AddTeamMember(ctx, apollo.ID, missingID())
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, database.ErrNotFound)
+
+	// Test: remove Bob from Apollo
+	require.NoError(t, repos.Projects.RemoveTeamMember(ctx, apollo.ID, bob.ID))
+
+	// Assert
+	isMember, err = repos.Projects.IsTeamMember(ctx, apollo.ID, bob.ID)
+	require.NoError(t, err)
+	assert.False(t, isMember)
+
+	bobProjects, err = repos.Projects.FindByEmployeeID(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, bobProjects, 1)
+	assert.Equal(t, artemis.ID, bobProjects[0].ID)
+
+	annProjects, err := repos.Projects.FindByEmployeeID(ctx, ann.ID)
+	require.NoError(t, err)
+	require.Len(t, annProjects, 1)
+	assert.Equal(t, apollo.ID, annProjects[0].ID)
+}
+
+func testProjectTerminatedCannotJoin(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup: Bob is terminated while on the completed Apollo team
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	apollo := newProject("Apollo", ann, bob)
+	apollo.Status = model.ProjectStatusCompleted
+	require.NoError(t, repos.Projects.Save(ctx, apollo))
+	artemis := saveProject(t, repos, "Artemis", ann)
+	require.NoError(t, repos.Employees.Terminate(ctx, bob.ID, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+	// Test: Bob cannot be added to a team
+	err := repos.Projects.AddTeamMember(ctx, artemis.ID, bob.ID)
+	assert.ErrorIs(t, err, database.ErrEmployeeTerminated)
+
+	// Test: nor be put on a team by an update
+	artemis.TeamMembers = []*model.Employee{ann, bob}
+	err = repos.Projects.Update(ctx, artemis)
+	assert.ErrorIs(t, err, database.ErrEmployeeTerminated)
+
+	// Test: nor join a new project
+	err = repos.Projects.Save(ctx, newProject("Gemini", ann, bob))
+	assert.ErrorIs(t, err, database.ErrEmployeeTerminated)
+
+	// Assert: Artemis kept its team and Gemini was not created
+	found, err := repos.Projects.FindByID(ctx, artemis.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ann.ID}, teamIDs(found))
+
+	all, err := repos.Projects.FindAll(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	// Test: Bob stays on the team he was on when terminated
+	apollo.Name = "Apollo 11"
+	require.NoError(t, repos.Projects.Update(ctx, apollo))
+	require.NoError(t, repos.Projects.AddTeamMember(ctx, apollo.ID, bob.ID))
+
+	// Assert
+	found, err = repos.Projects.FindByID(ctx, apollo.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ann.ID, bob.ID}, teamIDs(found))
+}
+
+func testProjectDeleteCascades(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup: a project with a milestone, a task and a status change
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	proj := saveProject(t, repos, "Apollo", ann)
+	milestone := &model.Milestone{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Beta"}
+	require.NoError(t, repos.Projects.SaveMilestone(ctx, milestone))
+	task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, MilestoneID: &milestone.ID, Title: "Ship", AssigneeID: &ann.ID}
+	require.NoError(t, repos.Projects.SaveTask(ctx, task))
+	change := &model.ProjectStatusChange{ProjectID: proj.ID, From: model.ProjectStatusActive, To: model.ProjectStatusOnHold}
+	require.NoError(t, repos.Projects.TransitionStatus(ctx, change))
+
+	// Test
+	require.NoError(t, repos.Projects.Delete(ctx, proj.ID))
+
+	// Assert: the planning and history are deleted with it
+	_, err := repos.Projects.FindMilestoneByID(ctx, milestone.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+	_, err = repos.Projects.FindTaskByID(ctx, task.ID)
+	assert.ErrorIs(t, err, database.ErrNotFound)
+	history, err := repos.Projects.FindStatusChanges(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	annProjects, err := repos.Projects.FindByEmployeeID(ctx, ann.ID)
+	require.NoError(t, err)
+	assert.Empty(t, annProjects)
+}
+
+func testProjectDeleteMilestone(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	proj := saveProject(t, repos, "Apollo")
+	milestone := &model.Milestone{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Beta"}
+	require.NoError(t, repos.Projects.SaveMilestone(ctx, milestone))
+	task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, MilestoneID: &milestone.ID, Title: "Ship"}
+	require.NoError(t, repos.Projects.SaveTask(ctx, task))
+
+	scheduled, err := repos.Projects.FindTasksByMilestoneID(ctx, milestone.ID)
+	require.NoError(t, err)
+	require.Len(t, scheduled, 1)
+
+	// Test
+	require.NoError(t, repos.Projects.DeleteMilestone(ctx, milestone.ID))
+
+	// Assert: the task is kept without a milestone
+	found, err := repos.Projects.FindTaskByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.MilestoneID)
+	assert.Equal(t, model.TaskStatusTodo, found.Status)
+
+	milestones, err := repos.Projects.FindMilestonesByProjectID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Empty(t, milestones)
+}
+
+func testProjectTaskAssigneeIsMember(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	proj := saveProject(t, repos, "Apollo", ann)
+
+	// Test: an employee off the team cannot be assigned
+	task := &model.Task{ID: uuid.NewString(), ProjectID: proj.ID, Title: "Ship", Status: model.TaskStatusTodo, AssigneeID: &bob.ID}
+	assert.ErrorIs(t, repos.Projects.SaveTask(ctx, task), database.ErrNotTeamMember)
+
+	task.AssigneeID = &ann.ID
+	require.NoError(t, repos.Projects.SaveTask(ctx, task))
+	task.AssigneeID = &bob.ID
+	assert.ErrorIs(t, repos.Projects.UpdateTask(ctx, task), database.ErrNotTeamMember)
+
+	// Assert: the task is still Ann's
+	found, err := repos.Projects.FindTaskByID(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.AssigneeID)
+	assert.Equal(t, ann.ID, *found.AssigneeID)
+}
+
+func testProjectRemoveMemberUnassignsTasks(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup: Bob has an open and a done task on Apollo and an open task on Artemis
+	dept := saveDepartment(t, repos, "Engineering")
+	ann := saveEmployee(t, repos, dept.ID, "Ann", "ann@example.com")
+	bob := saveEmployee(t, repos, dept.ID, "Bob", "bob@example.com")
+	apollo := saveProject(t, repos, "Apollo", ann, bob)
+	artemis := saveProject(t, repos, "Artemis", bob)
+	open := &model.Task{ID: uuid.NewString(), ProjectID: apollo.ID, Title: "Ship", AssigneeID: &bob.ID}
+	done := &model.Task{ID: uuid.NewString(), ProjectID: apollo.ID, Title: "Plan", AssigneeID: &bob.ID, Status: model.TaskStatusDone}
+	other := &model.Task{ID: uuid.NewString(), ProjectID: artemis.ID, Title: "Land", AssigneeID: &bob.ID}
+	annTask := &model.Task{ID: uuid.NewString(), ProjectID: apollo.ID, Title: "Test", AssigneeID: &ann.ID}
+	for _, task := range []*model.Task{open, done, other, annTask} {
+		require.NoError(t, repos.Projects.SaveTask(ctx, task))
+	}
+
+	// Test
+	require.NoError(t, repos.Projects.RemoveTeamMember(ctx, apollo.ID, bob.ID))
+
+	// Assert: only Bob's open task on Apollo is unassigned
+	assignees := map[string]*string{}
+	for _, task := range []*model.Task{open, done, other, annTask} {
+		found, err := repos.Projects.FindTaskByID(ctx, task.ID)
+		require.NoError(t, err)
+		assignees[task.Title] = found.AssigneeID
+	}
+	assert.Nil(t, assignees["Ship"])
+	assert.Equal(t, &bob.ID, assignees["Plan"])
+	assert.Equal(t, &bob.ID, assignees["Land"])
+	assert.Equal(t, &ann.ID, assignees["Test"])
+
+	// Test: replacing the team unassigns the tasks of members that left
+	found, err := repos.Projects.FindByID(ctx, apollo.ID)
+	require.NoError(t, err)
+	found.TeamMembers = []*model.Employee{bob}
+	require.NoError(t, repos.Projects.Update(ctx, found))
+
+	annFound, err := repos.Projects.FindTaskByID(ctx, annTask.ID)
+	require.NoError(t, err)
+	assert.Nil(t, annFound.AssigneeID)
+}
+
+func testProjectTransitionStatus(t *testing.T, repos Repositories) {
+	ctx := auth.WithSystem(context.Background())
+
+	// Setup
+	proj := saveProject(t, repos, "Apollo")
+
+	// Test: transition from the current status
+	change := &model.ProjectStatusChange{ProjectID: proj.ID, From: model.ProjectStatusActive, To: model.ProjectStatusOnHold}
+	require.NoError(t, repos.Projects.TransitionStatus(ctx, change))
+
+	// Test: a transition from a stale status conflicts
+	stale := &model.ProjectStatusChange{ProjectID: proj.ID, From: model.ProjectStatusActive, To: model.ProjectStatusCompleted}
+	conflictErr := repos.Projects.TransitionStatus(ctx, stale)
+
+	// Assert
+	assert.ErrorIs(t, conflictErr, database.ErrStatusConflict)
+	assert.NotEmpty(t, change.ID)
+	assert.NotEmpty(t, change.ChangedAt)
+
+	found, err := repos.Projects.FindByID(ctx, proj.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ProjectStatusOnHold, found.Status)
+
+	history, err := repos.Projects.FindStatusChanges(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, change.ID, history[0].ID)
+	assert.Equal(t, model.ProjectStatusActive, history[0].From)
+	assert.Equal(t, model.ProjectStatusOnHold, history[0].To)
+}
@@ -2,11 +2,19 @@ package testutil
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
+	"net/url"
 	"testing"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"gin-crud-api/internal/auth"
 	"gin-crud-api/internal/ent"
 	"gin-crud-api/internal/ent/enttest"
+	_ "gin-crud-api/internal/ent/runtime" // Schema defaults and hooks
+	"gin-crud-api/internal/fixture"
+	"gin-crud-api/internal/tenant"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3" // SQLite driver for in-memory tests
@@ -25,6 +33,30 @@ func NewTestEntClient(t *testing.T) *ent.Client {
 	return client
 }
 
+// NewTestEntDriver opens an in-memory SQLite driver for tests that need to
+// wrap the driver (e.g. with tracing) before creating the EntGo client.
+// Each call gets its own database, named after the test.
+func NewTestEntDriver(t *testing.T) *entsql.Driver {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", url.PathEscape(t.Name()))
+	drv, err := entsql.Open(dialect.SQLite, dsn)
+	if err != nil {
+		t.Fatalf("Failed to open test driver: %v", err)
+	}
+	return drv
+}
+
+// NewTestEntClientWithDriver creates an EntGo client on top of drv and runs migrations
+// The client is closed automatically when the test completes
+func NewTestEntClientWithDriver(t *testing.T, drv dialect.Driver) *ent.Client {
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("Failed to run test migrations: %v", err)
+	}
+	return client
+}
+
 // SeedTestDepartment creates a test department with given name
 // Returns the created department for use in tests
 func SeedTestDepartment(t *testing.T, client *ent.Client, name string) *ent.Department {
@@ -32,7 +64,7 @@ func SeedTestDepartment(t *testing.T, client *ent.Client, name string) *ent.Depa
 		Create().
 		SetID(uuid.New()).
 		SetName(name).
-		Save(context.Background())
+		Save(auth.WithSystem(context.Background()))
 	if err != nil {
 		t.Fatalf("Failed to seed test department: %v", err)
 	}
@@ -48,7 +80,7 @@ func SeedTestEmployee(t *testing.T, client *ent.Client, name, email string, dept
 		SetName(name).
 		SetEmail(email).
 		SetDepartmentID(deptID).
-		Save(context.Background())
+		Save(auth.WithSystem(context.Background()))
 	if err != nil {
 		t.Fatalf("Failed to seed test employee: %v", err)
 	}
@@ -77,3 +109,43 @@ func SeedMultipleEmployees(t *testing.T, client *ent.Client, deptID uuid.UUID, c
 	}
 	return employees
 }
+
+// NewFactory creates a fixture factory on client for builder-style seeding.
+// It is seeded from the test name, so a test gets the same data on every run,
+// and creates rows in the default tenant.
+//
+//	f := testutil.NewFactory(t, client)
+//	dept := testutil.Create(t, f.Department().Name("Engineering"))
+//	lead := testutil.Create(t, f.Employee(dept))
+//	proj := testutil.Create(t, f.Project().Lead(lead).Status(project.StatusPLANNED))
+func NewFactory(t *testing.T, client *ent.Client) *fixture.Factory {
+	h := fnv.New64a()
+	h.Write([]byte(t.Name()))
+	return fixture.New(client, tenant.Default, h.Sum64())
+}
+
+// Create saves the entity of a fixture builder and fails the test on error
+func Create[T any](t *testing.T, b interface {
+	Create(ctx context.Context) (T, error)
+}) T {
+	t.Helper()
+	v, err := b.Create(auth.WithSystem(context.Background()))
+	if err != nil {
+		t.Fatalf("Failed to create test fixture: %v", err)
+	}
+	return v
+}
+
+// LoadScenario creates the named fixture scenario (e.g. "org") in client
+// Returns the created rows for use in tests
+func LoadScenario(t *testing.T, client *ent.Client, name string) *fixture.Dataset {
+	scenario, err := fixture.LookupScenario(name)
+	if err != nil {
+		t.Fatalf("Failed to load scenario: %v", err)
+	}
+	data, err := scenario.Load(auth.WithSystem(context.Background()), NewFactory(t, client))
+	if err != nil {
+		t.Fatalf("Failed to load scenario %s: %v", name, err)
+	}
+	return data
+}
@@ -0,0 +1,148 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an Ent dialect.Driver and records a client span for every
+// statement. Query arguments are never recorded, only the parameterized SQL.
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps drv (typically the result of entsql.Open) with tracing
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// Exec implements dialect.ExecQuerier
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startSpan(ctx, d.Dialect(), query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// Query implements dialect.ExecQuerier
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startSpan(ctx, d.Dialect(), query)
+	err := d.Driver.Query(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// Tx starts a transaction whose statements are traced
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, dialect: d.Dialect(), ctx: ctx}, nil
+}
+
+// BeginTx starts a transaction with options when the wrapped driver supports it
+// Ent's Client.BeginTx relies on this method being present
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, dialect: d.Dialect(), ctx: ctx}, nil
+}
+
+// Tx wraps a dialect.Tx and records spans for its statements and completion
+type Tx struct {
+	dialect.Tx
+	dialect string
+	ctx     context.Context // context the transaction was started with
+}
+
+// Exec implements dialect.ExecQuerier
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startSpan(ctx, t.dialect, query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// Query implements dialect.ExecQuerier
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startSpan(ctx, t.dialect, query)
+	err := t.Tx.Query(ctx, query, args, v)
+	endSpan(span, err)
+	return err
+}
+
+// Commit implements driver.Tx
+func (t *Tx) Commit() error {
+	_, span := startSpan(t.ctx, t.dialect, "COMMIT")
+	err := t.Tx.Commit()
+	endSpan(span, err)
+	return err
+}
+
+// Rollback implements driver.Tx
+func (t *Tx) Rollback() error {
+	_, span := startSpan(t.ctx, t.dialect, "ROLLBACK")
+	err := t.Tx.Rollback()
+	endSpan(span, err)
+	return err
+}
+
+// startSpan starts a client span named after the SQL operation (SELECT, INSERT, ...)
+func startSpan(ctx context.Context, dialectName, query string) (context.Context, trace.Span) {
+	operation := sqlOperation(query)
+	return Tracer().Start(ctx, "db."+strings.ToLower(operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			dbSystem(dialectName),
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+// endSpan records err on the span (if any) and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// sqlOperation returns the leading keyword of a statement
+func sqlOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// dbSystem maps an Ent dialect name to the db.system.name attribute
+func dbSystem(dialectName string) attribute.KeyValue {
+	switch dialectName {
+	case dialect.Postgres:
+		return semconv.DBSystemNamePostgreSQL
+	case dialect.SQLite:
+		return semconv.DBSystemNameSQLite
+	default:
+		return semconv.DBSystemNameKey.String(dialectName)
+	}
+}
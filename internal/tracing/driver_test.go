@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"gin-crud-api/internal/auth"
+	"gin-crud-api/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key string) string {
+	for _, attr := range span.Attributes() {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestDriver_RecordsStatementSpans(t *testing.T) {
+	client := testutil.NewTestEntClientWithDriver(t, NewDriver(testutil.NewTestEntDriver(t)))
+	recorder := setupSpanRecorder(t)
+
+	// Start a parent span to verify statements are nested under the caller
+	ctx, parent := Tracer().Start(auth.WithSystem(context.Background()), "parent")
+	_, err := client.Department.Create().SetID(uuid.New()).SetName("Engineering").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Department.Query().All(ctx)
+	require.NoError(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	insert, selectSpan := spans[0], spans[1]
+	assert.Equal(t, "db.insert", insert.Name())
+	assert.Equal(t, "db.select", selectSpan.Name())
+	assert.Equal(t, "sqlite", spanAttribute(insert, "db.system.name"))
+	assert.Equal(t, "INSERT", spanAttribute(insert, "db.operation.name"))
+	assert.Contains(t, spanAttribute(selectSpan, "db.query.text"), "FROM `departments`")
+
+	// Statement spans are children of the caller's span
+	assert.Equal(t, parent.SpanContext().SpanID(), insert.Parent().SpanID())
+	assert.Equal(t, parent.SpanContext().TraceID(), selectSpan.SpanContext().TraceID())
+}
+
+func TestDriver_RecordsTransactionSpans(t *testing.T) {
+	client := testutil.NewTestEntClientWithDriver(t, NewDriver(testutil.NewTestEntDriver(t)))
+	recorder := setupSpanRecorder(t)
+
+	tx, err := client.Tx(auth.WithSystem(context.Background()))
+	require.NoError(t, err)
+	_, err = tx.Department.Create().SetID(uuid.New()).SetName("Engineering").Save(auth.WithSystem(context.Background()))
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		names = append(names, span.Name())
+	}
+	assert.Equal(t, []string{"db.insert", "db.commit"}, names)
+}
+
+func TestDriver_RecordsErrors(t *testing.T) {
+	client := testutil.NewTestEntClientWithDriver(t, NewDriver(testutil.NewTestEntDriver(t)))
+	recorder := setupSpanRecorder(t)
+
+	// Employee requires an existing department (foreign key)
+	_, err := client.Employee.Create().
+		SetID(uuid.New()).
+		SetName("John").
+		SetEmail("john@example.com").
+		SetDepartmentID(uuid.New()).
+		Save(auth.WithSystem(context.Background()))
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.NotEmpty(t, spans)
+	assert.Equal(t, "Error", spans[len(spans)-1].Status().Code.String())
+}
+
+func TestSQLOperation(t *testing.T) {
+	assert.Equal(t, "SELECT", sqlOperation("  select * from departments"))
+	assert.Equal(t, "INSERT", sqlOperation("INSERT INTO departments"))
+	assert.Equal(t, "UNKNOWN", sqlOperation(""))
+}
@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+)
+
+// emailRegex is the email format accepted by both APIs
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+// IsEmail validates email format using a regular expression
+func IsEmail(email string) bool {
+	return emailRegex.MatchString(email)
+}
+
+// emailDomain returns the lower-cased part after the last @
+func emailDomain(email string) string {
+	return strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+}
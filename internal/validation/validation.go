@@ -0,0 +1,262 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gin-crud-api/internal/config"
+)
+
+// ErrCodeValidationFailed is the error code returned with a list of violations
+const ErrCodeValidationFailed = "VALIDATION_FAILED"
+
+// Violation is a single failed rule
+type Violation struct {
+	Field   string `json:"field"`   // Path of the field, e.g. input.email
+	Rule    string `json:"rule"`    // Name of the failed rule, e.g. max_length
+	Message string `json:"message"` // Human-readable description
+}
+
+// Errors lists every violation found in an input
+type Errors []Violation
+
+// Error implements error
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Field + ": " + v.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DefaultRules are the checks applied when the configuration has no rule for a field
+func DefaultRules() map[string]map[string]config.ValidationRule {
+	return map[string]map[string]config.ValidationRule{
+		"department": {
+			"name": {Required: true},
+		},
+		"employee": {
+			"name":         {Required: true},
+			"email":        {Required: true, Email: true},
+			"departmentid": {Required: true},
+		},
+		"project": {
+			"name":   {Required: true},
+			"budget": {Positive: true},
+		},
+		"milestone": {
+			"title": {Required: true},
+		},
+		"task": {
+			"title":         {Required: true},
+			"estimatehours": {Min: new(float64)},
+		},
+		"skill": {
+			"name": {Required: true},
+		},
+		"webhook": {
+			"url":    {Required: true},
+			"secret": {Required: true, MinLength: 16},
+		},
+	}
+}
+
+// rule is a ValidationRule with its character set compiled
+type rule struct {
+	config.ValidationRule
+	charset *regexp.Regexp
+}
+
+// Validator checks inputs against declarative rules per input type
+// Input types are matched case-insensitively; field names are matched after
+// lower-casing and removing underscores, so departmentID (GraphQL) and
+// department_id (REST) share one rule.
+type Validator struct {
+	rules map[string]map[string]*rule
+}
+
+// New creates a validator from the default rules, with every field rule in
+// cfg replacing the default rule for that field
+func New(cfg config.ValidationConfig) (*Validator, error) {
+	v := &Validator{rules: map[string]map[string]*rule{}}
+	for _, rules := range []map[string]map[string]config.ValidationRule{DefaultRules(), cfg.Rules} {
+		for inputType, fields := range rules {
+			for field, r := range fields {
+				if err := v.add(inputType, field, r); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return v, nil
+}
+
+// Default creates a validator with only the default rules
+func Default() *Validator {
+	v, err := New(config.ValidationConfig{})
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (v *Validator) add(inputType, field string, r config.ValidationRule) error {
+	compiled := &rule{ValidationRule: r}
+	if r.Charset != "" {
+		re, err := regexp.Compile("^[" + r.Charset + "]*$")
+		if err != nil {
+			return fmt.Errorf("invalid charset for %s.%s: %w", inputType, field, err)
+		}
+		compiled.charset = re
+	}
+
+	inputType = strings.ToLower(inputType)
+	if v.rules[inputType] == nil {
+		v.rules[inputType] = map[string]*rule{}
+	}
+	v.rules[inputType][normalize(field)] = compiled
+	return nil
+}
+
+// Validate checks every field of input (a struct or pointer to struct) that
+// has a rule for inputType. Field paths are built from path and the json tag
+// of each field. It returns Errors listing all violations, or nil.
+func (v *Validator) Validate(inputType, path string, input any) error {
+	return v.validate(inputType, path, input, false)
+}
+
+// ValidatePartial is Validate for patch-style inputs: nil pointer fields were
+// not provided and are skipped, even when required
+func (v *Validator) ValidatePartial(inputType, path string, input any) error {
+	return v.validate(inputType, path, input, true)
+}
+
+func (v *Validator) validate(inputType, path string, input any, partial bool) error {
+	rules := v.rules[strings.ToLower(inputType)]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	val := reflect.Indirect(reflect.ValueOf(input))
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs Errors
+	typ := val.Type()
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := fieldName(sf)
+		r, ok := rules[normalize(name)]
+		if !ok {
+			continue
+		}
+
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		label := strings.ToLower(inputType) + " " + name
+
+		fv := val.Field(i)
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				if r.Required && !partial {
+					errs = append(errs, Violation{Field: fieldPath, Rule: "required", Message: label + " is required"})
+				}
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		errs = append(errs, r.check(fieldPath, label, fv)...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// check applies r to a present value
+func (r *rule) check(path, label string, fv reflect.Value) Errors {
+	var errs Errors
+	add := func(name, format string, args ...any) {
+		errs = append(errs, Violation{Field: path, Rule: name, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		s := fv.String()
+		if s == "" {
+			if r.Required {
+				add("required", "%s is required", label)
+			}
+			return errs
+		}
+
+		length := utf8.RuneCountInString(s)
+		if r.MinLength > 0 && length < r.MinLength {
+			add("min_length", "%s must be at least %d characters", label, r.MinLength)
+		}
+		if r.MaxLength > 0 && length > r.MaxLength {
+			add("max_length", "%s must be at most %d characters", label, r.MaxLength)
+		}
+		if r.charset != nil && !r.charset.MatchString(s) {
+			add("charset", "%s contains characters that are not allowed", label)
+		}
+		if r.Email {
+			if !IsEmail(s) {
+				add("email", "invalid email format")
+			} else if len(r.AllowedDomains) > 0 && !slices.ContainsFunc(r.AllowedDomains, func(d string) bool {
+				return strings.EqualFold(d, emailDomain(s))
+			}) {
+				add("allowed_domains", "email domain %s is not allowed", emailDomain(s))
+			}
+		}
+
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64:
+		var n float64
+		if fv.CanFloat() {
+			n = fv.Float()
+		} else {
+			n = float64(fv.Int())
+		}
+		if r.Positive && n <= 0 {
+			add("positive", "%s must be positive", label)
+		}
+		if r.Min != nil && n < *r.Min {
+			add("min", "%s must be at least %s", label, formatNumber(*r.Min))
+		}
+		if r.Max != nil && n > *r.Max {
+			add("max", "%s must be at most %s", label, formatNumber(*r.Max))
+		}
+	}
+
+	return errs
+}
+
+// fieldName returns the json name of a struct field, else its Go name
+func fieldName(sf reflect.StructField) string {
+	if tag, _, _ := strings.Cut(sf.Tag.Get("json"), ","); tag != "" && tag != "-" {
+		return tag
+	}
+	return sf.Name
+}
+
+// normalize makes departmentID, department_id and departmentid the same key
+func normalize(field string) string {
+	return strings.ReplaceAll(strings.ToLower(field), "_", "")
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"testing"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func float(f float64) *float64 { return &f }
+
+func newTestValidator(t *testing.T) *Validator {
+	v, err := New(config.ValidationConfig{
+		Rules: map[string]map[string]config.ValidationRule{
+			"employee": {
+				"name":  {Required: true, MaxLength: 10, Charset: `\p{L} '-`},
+				"email": {Required: true, Email: true, AllowedDomains: []string{"example.com"}},
+			},
+			"project": {
+				"name":   {Required: true, MinLength: 3},
+				"budget": {Positive: true, Max: float(1000)},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return v
+}
+
+func violations(t *testing.T, err error) Errors {
+	require.Error(t, err)
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	return errs
+}
+
+func TestValidate_Valid(t *testing.T) {
+	v := newTestValidator(t)
+
+	err := v.Validate("employee", "input", model.CreateEmployeeInput{
+		Name: "Zoë O'Neil", Email: "zoe@example.com", DepartmentID: "d1",
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidate_ReportsAllViolations(t *testing.T) {
+	v := newTestValidator(t)
+
+	errs := violations(t, v.Validate("employee", "input", model.CreateEmployeeInput{
+		Name:  "R2-D2 the droid",
+		Email: "r2@rebels.org",
+	}))
+
+	assert.Equal(t, Errors{
+		{Field: "input.name", Rule: "max_length", Message: "employee name must be at most 10 characters"},
+		{Field: "input.name", Rule: "charset", Message: "employee name contains characters that are not allowed"},
+		{Field: "input.email", Rule: "allowed_domains", Message: "email domain rebels.org is not allowed"},
+		{Field: "input.departmentID", Rule: "required", Message: "employee departmentID is required"},
+	}, errs)
+	assert.Contains(t, errs.Error(), "input.email: email domain rebels.org is not allowed")
+}
+
+func TestValidate_InvalidEmail(t *testing.T) {
+	v := newTestValidator(t)
+
+	errs := violations(t, v.Validate("employee", "", model.CreateEmployeeInput{
+		Name: "Ann", Email: "not-an-email", DepartmentID: "d1",
+	}))
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "email", errs[0].Field)
+	assert.Equal(t, "invalid email format", errs[0].Message)
+}
+
+func TestValidate_Numbers(t *testing.T) {
+	v := newTestValidator(t)
+
+	errs := violations(t, v.Validate("project", "input", model.CreateProjectInput{Name: "Apollo", Budget: 0}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "positive", errs[0].Rule)
+
+	errs = violations(t, v.Validate("project", "input", model.CreateProjectInput{Name: "Apollo", Budget: 5000.5}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "project budget must be at most 1000", errs[0].Message)
+}
+
+func TestValidatePartial_SkipsMissingFields(t *testing.T) {
+	v := newTestValidator(t)
+
+	assert.NoError(t, v.ValidatePartial("project", "input", model.UpdateProjectInput{}))
+	assert.Error(t, v.Validate("project", "input", model.UpdateProjectInput{}))
+
+	// Provided fields are still checked
+	name := "AB"
+	errs := violations(t, v.ValidatePartial("project", "input", model.UpdateProjectInput{Name: &name}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "min_length", errs[0].Rule)
+}
+
+func TestValidate_SharedWithRESTRequests(t *testing.T) {
+	v := Default()
+
+	// Shaped like the legacy REST DTOs
+	type employeeRequest struct {
+		Name         string `json:"name"`
+		Email        string `json:"email"`
+		DepartmentID string `json:"department_id"`
+	}
+	type departmentRequest struct {
+		Name string `json:"name"`
+	}
+
+	// department_id (REST) matches the departmentID rule
+	errs := violations(t, v.Validate("employee", "", employeeRequest{Name: "Ann", Email: "ann@example.com"}))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "department_id", errs[0].Field)
+
+	errs = violations(t, v.Validate("department", "", &departmentRequest{}))
+	assert.Equal(t, "department name is required", errs[0].Message)
+}
+
+func TestDefault_RulesWithoutConfig(t *testing.T) {
+	v := Default()
+
+	// Configured limits do not apply, only the built-in checks
+	assert.NoError(t, v.Validate("project", "input", model.CreateProjectInput{Name: "X", Budget: 1e12}))
+	assert.Error(t, v.Validate("project", "input", model.CreateProjectInput{Name: "X", Budget: -1}))
+	assert.NoError(t, v.Validate("unknown", "input", model.CreateProjectInput{}))
+}
+
+func TestNew_InvalidCharset(t *testing.T) {
+	_, err := New(config.ValidationConfig{
+		Rules: map[string]map[string]config.ValidationRule{
+			"employee": {"name": {Charset: `\p{Nope}`}},
+		},
+	})
+	assert.ErrorContains(t, err, "invalid charset for employee.name")
+}
@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/logger"
+	"gin-crud-api/internal/outbox"
+)
+
+// Delivery is a queued event for one subscription
+type Delivery struct {
+	ID        string
+	EventType string
+	URL       string
+	Secret    string
+	Payload   []byte // JSON Event
+	Attempts  int    // Attempts made before this one
+}
+
+// Store queues and tracks deliveries
+type Store interface {
+	// Enqueue queues a PENDING delivery of event for each active subscription
+	// of its tenant to eventType that existed when it occurred. Deliveries
+	// queued by an earlier call for the same event are kept, not duplicated.
+	Enqueue(ctx context.Context, event *outbox.Event, eventType model.WebhookEventType) error
+	// ClaimDue returns up to limit PENDING deliveries due at now and postpones
+	// them by lease so another poll does not send them again meanwhile
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*Delivery, error)
+	// MarkDelivered records a successful attempt
+	MarkDelivered(ctx context.Context, id string, statusCode int, at time.Time) error
+	// MarkFailed records a failed attempt (statusCode is nil when no response
+	// was received); a nil retryAt dead-letters the delivery
+	MarkFailed(ctx context.Context, id string, statusCode *int, errMsg string, retryAt *time.Time) error
+}
+
+// Dispatcher queues deliveries for the events of the outbox and posts them
+// to their subscriptions
+type Dispatcher struct {
+	store  Store
+	queue  *outbox.Relay // Reads the outbox as consumer cfg.Consumer
+	cfg    config.WebhookConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewDispatcher creates a dispatcher reading events with the outbox settings
+// under its own consumer name; zero config values fall back to defaults
+func NewDispatcher(store Store, events outbox.Store, cfg config.WebhookConfig, outboxCfg config.OutboxConfig) *Dispatcher {
+	if cfg.Consumer == "" {
+		cfg.Consumer = "webhooks"
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 5 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = 30 * time.Second
+	}
+	if cfg.MaxBackoff < cfg.InitialBackoff {
+		cfg.MaxBackoff = cfg.InitialBackoff
+	}
+
+	outboxCfg.Consumer = cfg.Consumer
+	outboxCfg.BatchSize = cfg.BatchSize
+
+	return &Dispatcher{
+		store:  store,
+		queue:  outbox.NewRelay(events, queueSink{store: store}, outboxCfg),
+		cfg:    cfg,
+		client: newClient(cfg),
+		now:    time.Now,
+	}
+}
+
+// newClient creates the HTTP client of deliveries. Unless private targets are
+// allowed it only connects to public addresses and ignores proxy settings,
+// which would hide the receiver's address from the check.
+func newClient(cfg config.WebhookConfig) *http.Client {
+	if cfg.AllowPrivateTargets {
+		return &http.Client{Timeout: cfg.Timeout}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublicOnly,
+	}).DialContext
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}
+}
+
+// Run queues new events and sends due deliveries every poll interval until
+// ctx is canceled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.drain(ctx, d.QueueEvents, "Failed to queue webhook deliveries")
+			d.drain(ctx, d.DispatchDue, "Failed to dispatch webhook deliveries")
+		}
+	}
+}
+
+// drain repeats step while it handles full batches
+func (d *Dispatcher) drain(ctx context.Context, step func(context.Context) (int, error), failure string) {
+	log := logger.WithComponent("WebhookDispatcher")
+	for {
+		n, err := step(ctx)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Msg(failure)
+			return
+		}
+		if n < d.cfg.BatchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// QueueEvents queues the deliveries of one batch of outbox events after the
+// dispatcher's offset and returns how many events were read
+func (d *Dispatcher) QueueEvents(ctx context.Context) (int, error) {
+	return d.queue.RelayOnce(ctx)
+}
+
+// DispatchDue sends one batch of due deliveries and returns how many were attempted
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	lease := d.cfg.Timeout + d.cfg.PollInterval
+	deliveries, err := d.store.ClaimDue(ctx, d.now(), d.cfg.BatchSize, lease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := d.deliver(ctx, delivery); err != nil {
+			return 0, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// deliver makes one attempt and records its outcome; only store errors are returned
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) error {
+	log := logger.WithComponent("WebhookDispatcher")
+
+	statusCode, sendErr := d.send(ctx, delivery)
+	if sendErr == nil {
+		log.Debug().
+			Str("delivery_id", delivery.ID).
+			Str("event_type", delivery.EventType).
+			Int("status_code", statusCode).
+			Msg("Webhook delivered")
+		return d.store.MarkDelivered(ctx, delivery.ID, statusCode, d.now())
+	}
+
+	var code *int
+	if statusCode != 0 {
+		code = &statusCode
+	}
+
+	// Retry with exponential backoff until the attempts are used up
+	attempt := delivery.Attempts + 1
+	var retryAt *time.Time
+	if attempt < d.cfg.MaxAttempts {
+		next := d.now().Add(Backoff(attempt, d.cfg.InitialBackoff, d.cfg.MaxBackoff))
+		retryAt = &next
+	}
+
+	event := log.Warn()
+	if retryAt == nil {
+		event = log.Error()
+	}
+	event.
+		Err(sendErr).
+		Str("delivery_id", delivery.ID).
+		Str("event_type", delivery.EventType).
+		Int("attempt", attempt).
+		Bool("dead_lettered", retryAt == nil).
+		Msg("Webhook delivery failed")
+
+	return d.store.MarkFailed(ctx, delivery.ID, code, sendErr.Error(), retryAt)
+}
+
+// send posts the signed payload; any non-2xx response is an error
+func (d *Dispatcher) send(ctx context.Context, delivery *Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("receiver responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// queueSink is the outbox sink of the dispatcher: it queues the deliveries
+// of the events sent to webhooks and skips the rest
+type queueSink struct {
+	store Store
+}
+
+// Publish queues the deliveries of every event of the batch
+func (s queueSink) Publish(ctx context.Context, events []*outbox.Event) error {
+	for _, event := range events {
+		eventType, ok := EventType(event.Type)
+		if !ok {
+			continue
+		}
+		if err := s.store.Enqueue(ctx, event, eventType); err != nil {
+			return fmt.Errorf("failed to queue deliveries of event %d: %w", event.Sequence, err)
+		}
+	}
+	return nil
+}
+
+// Close does nothing; the store outlives the dispatcher
+func (queueSink) Close() error {
+	return nil
+}
@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/outbox"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is a Store keeping deliveries in a map
+type memoryStore struct {
+	mu         sync.Mutex
+	deliveries map[string]*Delivery
+	dueAt      map[string]time.Time
+	delivered  map[string]int
+	dead       map[string]string
+	queued     []string // Event type and sequence of each Enqueue call
+}
+
+func newMemoryStore(deliveries ...*Delivery) *memoryStore {
+	s := &memoryStore{
+		deliveries: map[string]*Delivery{},
+		dueAt:      map[string]time.Time{},
+		delivered:  map[string]int{},
+		dead:       map[string]string{},
+	}
+	for _, d := range deliveries {
+		s.deliveries[d.ID] = d
+	}
+	return s
+}
+
+func (s *memoryStore) ClaimDue(_ context.Context, now time.Time, limit int, lease time.Duration) ([]*Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []*Delivery
+	for id, d := range s.deliveries {
+		if len(due) == limit {
+			break
+		}
+		if s.dueAt[id].After(now) {
+			continue
+		}
+		s.dueAt[id] = now.Add(lease)
+		copied := *d
+		due = append(due, &copied)
+	}
+	return due, nil
+}
+
+func (s *memoryStore) Enqueue(_ context.Context, event *outbox.Event, eventType model.WebhookEventType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queued = append(s.queued, string(eventType)+"#"+strconv.FormatInt(event.Sequence, 10))
+	return nil
+}
+
+// eventStore is an outbox.Store over a fixed list of events
+type eventStore struct {
+	events  []*outbox.Event
+	offsets map[string]int64
+}
+
+func (s *eventStore) Fetch(_ context.Context, after int64, limit int) ([]*outbox.Event, error) {
+	var events []*outbox.Event
+	for _, e := range s.events {
+		if e.Sequence > after && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *eventStore) FetchSequences(context.Context, []int64) ([]*outbox.Event, error) {
+	return nil, nil
+}
+
+func (s *eventStore) Offset(_ context.Context, consumer string) (int64, error) {
+	return s.offsets[consumer], nil
+}
+
+func (s *eventStore) CommitOffset(_ context.Context, consumer string, position int64) error {
+	s.offsets[consumer] = position
+	return nil
+}
+
+func (s *memoryStore) MarkDelivered(_ context.Context, id string, statusCode int, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries[id].Attempts++
+	s.delivered[id] = statusCode
+	delete(s.deliveries, id)
+	return nil
+}
+
+func (s *memoryStore) MarkFailed(_ context.Context, id string, _ *int, errMsg string, retryAt *time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deliveries[id].Attempts++
+	if retryAt == nil {
+		s.dead[id] = errMsg
+		delete(s.deliveries, id)
+		return nil
+	}
+	s.dueAt[id] = *retryAt
+	return nil
+}
+
+func TestDispatcher_QueuesOutboxEvents(t *testing.T) {
+	// Setup: employee events sent to webhooks and a department event that is not
+	events := &eventStore{
+		events: []*outbox.Event{
+			{Sequence: 1, Type: outbox.EmployeeHired},
+			{Sequence: 2, Type: outbox.DepartmentCreated},
+			{Sequence: 3, Type: outbox.EmployeeTransferred},
+		},
+		offsets: map[string]int64{"relay": 1},
+	}
+	store := newMemoryStore()
+	dispatcher := NewDispatcher(store, events, config.WebhookConfig{}, config.OutboxConfig{Consumer: "relay"})
+
+	// Test
+	n, err := dispatcher.QueueEvents(context.Background())
+	require.NoError(t, err)
+	again, err := dispatcher.QueueEvents(context.Background())
+	require.NoError(t, err)
+
+	// Assert: the dispatcher reads from the start under its own offset
+	assert.Equal(t, 3, n)
+	assert.Zero(t, again)
+	assert.Equal(t, []string{"EMPLOYEE_CREATED#1", "EMPLOYEE_MOVED#3"}, store.queued)
+	assert.Equal(t, int64(3), events.offsets["webhooks"])
+	assert.Equal(t, int64(1), events.offsets["relay"])
+}
+
+func TestDispatcher_DeliversSignedPayload(t *testing.T) {
+	// Setup: a receiver that verifies the signature headers
+	const secret = "0123456789abcdef"
+	payload := []byte(`{"id":"evt-1","type":"EMPLOYEE_CREATED"}`)
+
+	var gotHeaders http.Header
+	var verified bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotHeaders = r.Header.Clone()
+		ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		verified = Verify(secret, r.Header.Get(HeaderSignature), ts, body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	store := newMemoryStore(&Delivery{
+		ID: "d-1", EventType: "EMPLOYEE_CREATED", URL: receiver.URL, Secret: secret, Payload: payload,
+	})
+	dispatcher := NewDispatcher(store, nil, config.WebhookConfig{AllowPrivateTargets: true}, config.OutboxConfig{})
+
+	// Test
+	n, err := dispatcher.DispatchDue(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.True(t, verified)
+	assert.Equal(t, "EMPLOYEE_CREATED", gotHeaders.Get(HeaderEvent))
+	assert.Equal(t, "d-1", gotHeaders.Get(HeaderDelivery))
+	assert.Equal(t, http.StatusNoContent, store.delivered["d-1"])
+}
+
+func TestDispatcher_RetriesThenDeadLetters(t *testing.T) {
+	// Setup: a receiver that always fails and a clock we move forward
+	calls := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	store := newMemoryStore(&Delivery{ID: "d-1", EventType: "EMPLOYEE_DELETED", URL: receiver.URL, Payload: []byte(`{}`)})
+	dispatcher := NewDispatcher(store, nil, config.WebhookConfig{
+		MaxAttempts:         3,
+		InitialBackoff:      time.Minute,
+		MaxBackoff:          time.Hour,
+		AllowPrivateTargets: true,
+	}, config.OutboxConfig{})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dispatcher.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Test: first attempt schedules a retry a minute later
+	_, err := dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Minute), store.dueAt["d-1"])
+
+	// Not due yet
+	n, err := dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	// Second attempt doubles the backoff
+	now = now.Add(time.Minute)
+	_, err = dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(2*time.Minute), store.dueAt["d-1"])
+
+	// Third attempt uses up MaxAttempts
+	now = now.Add(2 * time.Minute)
+	_, err = dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 3, calls)
+	assert.Contains(t, store.dead["d-1"], "500")
+	assert.Empty(t, store.deliveries)
+}
+
+func TestDispatcher_RefusesPrivateTargets(t *testing.T) {
+	// Setup: a receiver on loopback, which the default config does not reach
+	calls := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	store := newMemoryStore(&Delivery{ID: "d-1", EventType: "EMPLOYEE_CREATED", URL: receiver.URL, Payload: []byte(`{}`)})
+	dispatcher := NewDispatcher(store, nil, config.WebhookConfig{MaxAttempts: 1}, config.OutboxConfig{})
+
+	// Test
+	_, err := dispatcher.DispatchDue(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Zero(t, calls)
+	assert.Contains(t, store.dead["d-1"], ErrForbiddenTarget.Error())
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, Backoff(1, time.Second, time.Minute))
+	assert.Equal(t, 4*time.Second, Backoff(3, time.Second, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(10, time.Second, time.Minute))
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false}, // Cloud metadata
+		{"100.100.100.200", false}, // Metadata in the shared address space
+		{"fe80::1", false},
+		{"fd00:ec2::254", false},
+		{"0.0.0.0", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.public, PublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestCheckTarget(t *testing.T) {
+	// Setup: names resolve from a fixed table
+	prev := lookupNetIP
+	t.Cleanup(func() { lookupNetIP = prev })
+	lookupNetIP = func(_ context.Context, _, host string) ([]netip.Addr, error) {
+		switch host {
+		case "hooks.example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34")}, nil
+		case "internal.example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{"https://hooks.example.com/hr", true},
+		{"https://not-yet-registered.example.com/hr", true}, // Checked again when dialing
+		{"https://93.184.216.34/hr", true},
+		{"https://internal.example.com/hr", false},
+		{"http://localhost:8080/hr", false},
+		{"http://api.localhost/hr", false},
+		{"http://127.0.0.1/hr", false},
+		{"http://[::1]/hr", false},
+		{"http://169.254.169.254/latest/meta-data", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := url.Parse(tt.url)
+			require.NoError(t, err)
+
+			err = CheckTarget(context.Background(), u)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrForbiddenTarget)
+			}
+		})
+	}
+}
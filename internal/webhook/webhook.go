@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"gin-crud-api/internal/graph/model"
+	"gin-crud-api/internal/outbox"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"     // Event type, e.g. EMPLOYEE_CREATED
+	HeaderDelivery  = "X-Webhook-Delivery"  // Delivery ID, stable across retries
+	HeaderTimestamp = "X-Webhook-Timestamp" // Unix seconds the request was signed at
+	HeaderSignature = "X-Webhook-Signature" // sha256=<hex HMAC of "<timestamp>.<body>">
+)
+
+// Event is the JSON body posted to receivers
+type Event struct {
+	ID         string                 `json:"id"` // ID of the outbox event
+	Type       model.WebhookEventType `json:"type"`
+	OccurredAt time.Time              `json:"occurredAt"`
+	Data       json.RawMessage        `json:"data"` // Data of the outbox event, e.g. outbox.Employee
+}
+
+// eventTypes maps the outbox events sent to webhooks to their webhook event type
+var eventTypes = map[string]model.WebhookEventType{
+	outbox.EmployeeHired:       model.WebhookEventTypeEmployeeCreated,
+	outbox.EmployeeTransferred: model.WebhookEventTypeEmployeeMoved,
+	outbox.EmployeeDeleted:     model.WebhookEventTypeEmployeeDeleted,
+}
+
+// EventType returns the webhook event type of an outbox event type, false
+// for events not sent to webhooks
+func EventType(outboxType string) (model.WebhookEventType, bool) {
+	t, ok := eventTypes[outboxType]
+	return t, ok
+}
+
+// NewEvent converts an outbox event to the body posted to receivers, false
+// for events not sent to webhooks
+func NewEvent(event *outbox.Event) (*Event, bool) {
+	t, ok := EventType(event.Type)
+	if !ok {
+		return nil, false
+	}
+	return &Event{
+		ID:         event.ID,
+		Type:       t,
+		OccurredAt: event.OccurredAt,
+		Data:       event.Data,
+	}, true
+}
+
+// Sign returns the signature header value for a body sent at timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches the body, for receivers
+func Verify(secret, signature string, timestamp int64, body []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
+
+// Backoff returns the delay before retry number attempt (1 for the first
+// retry): initial doubled on every further retry, capped at max
+func Backoff(attempt int, initial, max time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	return min(delay, max)
+}
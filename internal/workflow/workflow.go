@@ -0,0 +1,137 @@
+package workflow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+)
+
+// ErrCodeInvalidTransition is the error code returned for a transition the workflow does not allow
+const ErrCodeInvalidTransition = "INVALID_STATUS_TRANSITION"
+
+// DefaultProjectTransitions is the built-in project workflow:
+// COMPLETED and CANCELLED are final
+func DefaultProjectTransitions() map[model.ProjectStatus][]model.ProjectStatus {
+	return map[model.ProjectStatus][]model.ProjectStatus{
+		model.ProjectStatusPlanned: {model.ProjectStatusActive, model.ProjectStatusCancelled},
+		model.ProjectStatusActive:  {model.ProjectStatusOnHold, model.ProjectStatusCompleted, model.ProjectStatusCancelled},
+		model.ProjectStatusOnHold:  {model.ProjectStatusActive, model.ProjectStatusCancelled},
+	}
+}
+
+// DefaultInitialStatuses are the statuses a project may be created in with
+// the built-in workflow; new projects are PLANNED unless they say otherwise
+func DefaultInitialStatuses() []model.ProjectStatus {
+	return []model.ProjectStatus{model.ProjectStatusPlanned, model.ProjectStatusActive}
+}
+
+// ProjectWorkflow is the state machine for project statuses
+type ProjectWorkflow struct {
+	transitions    map[model.ProjectStatus][]model.ProjectStatus
+	reasonRequired []model.ProjectStatus
+	initial        []model.ProjectStatus // The first is the default
+}
+
+// New creates a project workflow from cfg. Status names are case-insensitive;
+// without configured transitions the built-in workflow is used.
+func New(cfg config.WorkflowConfig) (*ProjectWorkflow, error) {
+	w := &ProjectWorkflow{transitions: DefaultProjectTransitions(), initial: DefaultInitialStatuses()}
+
+	if len(cfg.ProjectTransitions) > 0 {
+		w.transitions = map[model.ProjectStatus][]model.ProjectStatus{}
+		for from, targets := range cfg.ProjectTransitions {
+			fromStatus, err := parseStatus(from)
+			if err != nil {
+				return nil, err
+			}
+			for _, to := range targets {
+				toStatus, err := parseStatus(to)
+				if err != nil {
+					return nil, err
+				}
+				if toStatus != fromStatus && !slices.Contains(w.transitions[fromStatus], toStatus) {
+					w.transitions[fromStatus] = append(w.transitions[fromStatus], toStatus)
+				}
+			}
+		}
+	}
+
+	for _, s := range cfg.ReasonRequired {
+		status, err := parseStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		w.reasonRequired = append(w.reasonRequired, status)
+	}
+
+	if len(cfg.InitialStatuses) > 0 {
+		w.initial = nil
+		for _, s := range cfg.InitialStatuses {
+			status, err := parseStatus(s)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(w.initial, status) {
+				w.initial = append(w.initial, status)
+			}
+		}
+	}
+
+	return w, nil
+}
+
+// Default creates the built-in workflow with no reasons required
+func Default() *ProjectWorkflow {
+	w, err := New(config.WorkflowConfig{})
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// Allowed returns the statuses a project in status from may move to, in enum order
+func (w *ProjectWorkflow) Allowed(from model.ProjectStatus) []model.ProjectStatus {
+	allowed := []model.ProjectStatus{}
+	for _, s := range model.AllProjectStatus {
+		if slices.Contains(w.transitions[from], s) {
+			allowed = append(allowed, s)
+		}
+	}
+	return allowed
+}
+
+// CanTransition reports whether a project may move from one status to another
+func (w *ProjectWorkflow) CanTransition(from, to model.ProjectStatus) bool {
+	return slices.Contains(w.transitions[from], to)
+}
+
+// InitialStatus returns the status of a project created without one
+func (w *ProjectWorkflow) InitialStatus() model.ProjectStatus {
+	return w.initial[0]
+}
+
+// InitialStatuses returns the statuses a project may be created in
+func (w *ProjectWorkflow) InitialStatuses() []model.ProjectStatus {
+	return slices.Clone(w.initial)
+}
+
+// CanStartIn reports whether a project may be created in status
+func (w *ProjectWorkflow) CanStartIn(status model.ProjectStatus) bool {
+	return slices.Contains(w.initial, status)
+}
+
+// ReasonRequired reports whether moving to status needs a reason
+func (w *ProjectWorkflow) ReasonRequired(to model.ProjectStatus) bool {
+	return slices.Contains(w.reasonRequired, to)
+}
+
+func parseStatus(s string) (model.ProjectStatus, error) {
+	status := model.ProjectStatus(strings.ToUpper(s))
+	if !status.IsValid() {
+		return "", fmt.Errorf("invalid project status %q in workflow", s)
+	}
+	return status, nil
+}
@@ -0,0 +1,61 @@
+package workflow
+
+import (
+	"testing"
+
+	"gin-crud-api/internal/config"
+	"gin-crud-api/internal/graph/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefault_FinalStatuses(t *testing.T) {
+	w := Default()
+
+	assert.True(t, w.CanTransition(model.ProjectStatusPlanned, model.ProjectStatusActive))
+	assert.True(t, w.CanTransition(model.ProjectStatusOnHold, model.ProjectStatusActive))
+	assert.False(t, w.CanTransition(model.ProjectStatusCompleted, model.ProjectStatusActive))
+	assert.False(t, w.CanTransition(model.ProjectStatusActive, model.ProjectStatusActive))
+	assert.Empty(t, w.Allowed(model.ProjectStatusCancelled))
+	assert.False(t, w.ReasonRequired(model.ProjectStatusCancelled))
+	assert.Equal(t, model.ProjectStatusPlanned, w.InitialStatus())
+	assert.True(t, w.CanStartIn(model.ProjectStatusActive))
+	assert.False(t, w.CanStartIn(model.ProjectStatusCompleted))
+
+	// Allowed is listed in enum order
+	assert.Equal(t, []model.ProjectStatus{
+		model.ProjectStatusCompleted, model.ProjectStatusOnHold, model.ProjectStatusCancelled,
+	}, w.Allowed(model.ProjectStatusActive))
+}
+
+func TestNew_FromConfig(t *testing.T) {
+	// Keys arrive lower-cased from Viper
+	w, err := New(config.WorkflowConfig{
+		ProjectTransitions: map[string][]string{
+			"completed": {"ACTIVE"},
+			"active":    {"completed", "active"},
+		},
+		ReasonRequired:  []string{"active"},
+		InitialStatuses: []string{"active", "PLANNED", "active"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, w.CanTransition(model.ProjectStatusCompleted, model.ProjectStatusActive))
+	assert.Equal(t, []model.ProjectStatus{model.ProjectStatusCompleted}, w.Allowed(model.ProjectStatusActive))
+	assert.False(t, w.CanTransition(model.ProjectStatusPlanned, model.ProjectStatusActive)) // Configured transitions replace the defaults
+	assert.True(t, w.ReasonRequired(model.ProjectStatusActive))
+	assert.Equal(t, model.ProjectStatusActive, w.InitialStatus())
+	assert.Equal(t, []model.ProjectStatus{model.ProjectStatusActive, model.ProjectStatusPlanned}, w.InitialStatuses())
+}
+
+func TestNew_InvalidStatus(t *testing.T) {
+	_, err := New(config.WorkflowConfig{ProjectTransitions: map[string][]string{"active": {"ARCHIVED"}}})
+	assert.ErrorContains(t, err, `invalid project status "ARCHIVED"`)
+
+	_, err = New(config.WorkflowConfig{ReasonRequired: []string{"gone"}})
+	assert.Error(t, err)
+
+	_, err = New(config.WorkflowConfig{InitialStatuses: []string{"draft"}})
+	assert.Error(t, err)
+}